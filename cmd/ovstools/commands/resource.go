@@ -2,6 +2,8 @@ package commands
 
 import (
 	"fmt"
+	"os"
+	"strconv"
 
 	"github.com/przebro/overseer/common/types/date"
 	"github.com/przebro/overseer/common/validator"
@@ -14,6 +16,8 @@ var validStsates = map[string]int32{"SHR": 0, "EXL": 1}
 
 func createAddCmd(client *ovscli.OverseerClient) *cobra.Command {
 
+	var keep bool
+
 	cmd := &cobra.Command{
 
 		Use:   "ADD",
@@ -22,10 +26,49 @@ func createAddCmd(client *ovscli.OverseerClient) *cobra.Command {
 		Run: func(c *cobra.Command, args []string) {
 
 			n, o := unfold(args)
-			clientAddTicket(client, c, n, o)
+			clientAddTicket(client, c, n, o, keep)
 
 		},
 	}
+
+	cmd.Flags().BoolVar(&keep, "keep", false, "the ticket is never removed when it expires")
+	return cmd
+}
+
+func createAddFileCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+
+		Use:     "ADDFILE",
+		Short:   "ADDFILE - adds tickets listed in a file at once",
+		Example: "ADDFILE <path>, each line of a file: <name> [odate] [KEEP]",
+		Args:    cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+
+			clientAddTicketsFromFile(client, c, args[0])
+		},
+	}
+	return cmd
+}
+
+func createDelManyCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	var confirm string
+
+	cmd := &cobra.Command{
+
+		Use:     "DELMANY",
+		Short:   "DELMANY - deletes tickets that match name and odate patterns",
+		Example: "DELMANY <name pattern> [odate pattern] [--confirm <token>]",
+		Args:    cobra.RangeArgs(1, 2),
+		Run: func(c *cobra.Command, args []string) {
+
+			n, o := unfold(args)
+			clientDeleteTickets(client, c, n, o, confirm)
+		},
+	}
+
+	cmd.Flags().StringVar(&confirm, "confirm", "", "token of matching tickets returned by a preview, without this flag only a preview is shown")
 	return cmd
 }
 
@@ -79,6 +122,22 @@ func createListCmd(client *ovscli.OverseerClient) *cobra.Command {
 	return cmd
 }
 
+func createTicketAuditCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+
+		Use:   "TAUDIT",
+		Short: "TAUDIT - Shows additions and removals of tickets",
+		Args:  cobra.RangeArgs(1, 2),
+		Run: func(c *cobra.Command, args []string) {
+
+			n, o := unfold(args)
+			clientTicketAudit(client, c, n, o)
+		},
+	}
+	return cmd
+}
+
 func createSetCmd(client *ovscli.OverseerClient) *cobra.Command {
 
 	cmd := &cobra.Command{
@@ -110,9 +169,145 @@ func createRemoveCmd(client *ovscli.OverseerClient) *cobra.Command {
 	return cmd
 }
 
-func clientAddTicket(client *ovscli.OverseerClient, cmd *cobra.Command, name, odate string) {
+func createReleaseFlagCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+
+		Use:     "RELFLAG",
+		Short:   "RELFLAG - forcefully releases a flag held by a task",
+		Example: "RELFLAG SMPL-FLAG 0A3F1",
+		Args:    cobra.ExactArgs(2),
+		Run: func(c *cobra.Command, args []string) {
+
+			clientReleaseFlag(client, args[0], args[1])
+		},
+	}
+	return cmd
+}
+
+func createSetQuantityCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+
+		Use:     "SETQNT",
+		Short:   "SETQNT - creates a quantitative resource or changes its capacity",
+		Example: "SETQNT DB2_CONNECTIONS 10",
+		Args:    cobra.ExactArgs(2),
+		Run: func(c *cobra.Command, args []string) {
+
+			clientSetQuantity(client, args[0], args[1])
+		},
+	}
+	return cmd
+}
+
+func createDelQuantityCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+
+		Use:     "DELQNT",
+		Short:   "DELQNT - removes a quantitative resource",
+		Example: "DELQNT DB2_CONNECTIONS",
+		Args:    cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+
+			clientDeleteQuantity(client, args[0])
+		},
+	}
+	return cmd
+}
+
+func createQuantitiesCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+
+		Use:     "QNTS",
+		Short:   "QNTS - lists quantitative resources",
+		Example: "QNTS DB2*",
+		Args:    cobra.RangeArgs(0, 1),
+		Run: func(c *cobra.Command, args []string) {
+
+			name, _ := unfold(args)
+			clientListQuantities(client, name)
+		},
+	}
+	return cmd
+}
+
+func createSetVarCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	var group, task string
+
+	cmd := &cobra.Command{
+
+		Use:     "SETVAR",
+		Short:   "SETVAR - sets a variable, the scope of a variable depends on given group and task",
+		Example: "SETVAR HOME /home/overseer --group group --task name",
+		Args:    cobra.ExactArgs(2),
+		Run: func(c *cobra.Command, args []string) {
+
+			clientSetVariable(client, group, task, args[0], args[1])
+			group, task = "", ""
+		},
+	}
+
+	cmd.Flags().StringVar(&group, "group", "", "sets a variable in the group scope")
+	cmd.Flags().StringVar(&task, "task", "", "sets a variable in the task scope, requires a group")
+
+	return cmd
+}
+
+func createDelVarCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	var group, task string
+
+	cmd := &cobra.Command{
+
+		Use:     "DELVAR",
+		Short:   "DELVAR - removes a variable, the scope of a variable depends on given group and task",
+		Example: "DELVAR HOME --group group",
+		Args:    cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+
+			clientDeleteVariable(client, group, task, args[0])
+			group, task = "", ""
+		},
+	}
+
+	cmd.Flags().StringVar(&group, "group", "", "removes a variable from the group scope")
+	cmd.Flags().StringVar(&task, "task", "", "removes a variable from the task scope, requires a group")
+
+	return cmd
+}
+
+func createVarsCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	var scope, group, task string
+
+	cmd := &cobra.Command{
+
+		Use:     "VARS",
+		Short:   "VARS - lists variables",
+		Example: "VARS HOME* --scope group --group grp*",
+		Args:    cobra.RangeArgs(0, 1),
+		Run: func(c *cobra.Command, args []string) {
+
+			name, _ := unfold(args)
+			clientListVariables(client, scope, group, task, name)
+			scope, group, task = "", "", ""
+		},
+	}
+
+	cmd.Flags().StringVar(&scope, "scope", "", "restricts a list to a scope: global | group | task")
+	cmd.Flags().StringVar(&group, "group", "", "restricts a list to groups")
+	cmd.Flags().StringVar(&task, "task", "", "restricts a list to tasks")
+
+	return cmd
+}
+
+func clientAddTicket(client *ovscli.OverseerClient, cmd *cobra.Command, name, odate string, keep bool) {
 
-	if err := validator.Valid.ValidateTag(name, "resvalue,max=32"); err != nil {
+	if err := validateScopedName(name); err != nil {
 		fmt.Println(err)
 		return
 	}
@@ -122,7 +317,7 @@ func clientAddTicket(client *ovscli.OverseerClient, cmd *cobra.Command, name, od
 		return
 	}
 
-	result, err := client.AddTicket(name, odate)
+	result, err := client.AddTicket(name, odate, keep)
 	if err != nil {
 		fmt.Println(err)
 		return
@@ -132,9 +327,74 @@ func clientAddTicket(client *ovscli.OverseerClient, cmd *cobra.Command, name, od
 
 }
 
+func clientAddTicketsFromFile(client *ovscli.OverseerClient, cmd *cobra.Command, path string) {
+
+	f, err := os.Open(path)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer f.Close()
+
+	tickets, err := ovscli.ReadTickets(f)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, t := range tickets {
+		if err := validateScopedName(t.Name); err != nil {
+			fmt.Println(t.Name, err)
+			return
+		}
+
+		if err := validator.Valid.ValidateTag(date.Odate(t.Odate), "odate"); err != nil {
+			fmt.Println(t.Name, err)
+			return
+		}
+	}
+
+	result, err := client.AddTickets(tickets)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(result)
+}
+
+func clientDeleteTickets(client *ovscli.OverseerClient, cmd *cobra.Command, name, odate string, confirm string) {
+
+	if err := validator.Valid.ValidateTag(name, "required,max=32"); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	if err := validator.Valid.ValidateTag(odate, "max=8"); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	dryRun := confirm == ""
+
+	result, token, message, err := client.DeleteTickets(name, odate, dryRun, confirm)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, x := range result {
+		fmt.Printf("Name:%s ODAT:%s\n", x.Name, x.Odate)
+	}
+	fmt.Println(message)
+
+	if dryRun && len(result) != 0 {
+		fmt.Printf("to delete these tickets run: DELMANY %s %s --confirm %s\n", name, odate, token)
+	}
+}
+
 func clientRemoveTicket(client *ovscli.OverseerClient, cmd *cobra.Command, name, odate string) {
 
-	if err := validator.Valid.ValidateTag(name, "resvalue,max=32"); err != nil {
+	if err := validateScopedName(name); err != nil {
 		fmt.Println(err)
 		return
 	}
@@ -155,7 +415,7 @@ func clientRemoveTicket(client *ovscli.OverseerClient, cmd *cobra.Command, name,
 
 func clientCheckTicket(client *ovscli.OverseerClient, cmd *cobra.Command, name, odate string) {
 
-	if err := validator.Valid.ValidateTag(name, "resvalue,max=32"); err != nil {
+	if err := validateScopedName(name); err != nil {
 		fmt.Println(err)
 		return
 	}
@@ -175,7 +435,7 @@ func clientCheckTicket(client *ovscli.OverseerClient, cmd *cobra.Command, name,
 
 func clientListTickets(client *ovscli.OverseerClient, cmd *cobra.Command, name, odate string) {
 
-	if err := validator.Valid.ValidateTag(name, "resvalue,max=32"); err != nil {
+	if err := validateScopedName(name); err != nil {
 		fmt.Println(err)
 		return
 	}
@@ -192,13 +452,40 @@ func clientListTickets(client *ovscli.OverseerClient, cmd *cobra.Command, name,
 	}
 
 	for _, x := range result {
-		fmt.Printf("Name:%s ODAT:%s\n", x.Name, x.Odate)
+		keep := ""
+		if x.Keep {
+			keep = " KEEP"
+		}
+		fmt.Printf("Name:%s ODAT:%s%s ADDED BY:%s %s\n", x.Name, x.Odate, keep, x.Origin, x.Created)
+	}
+}
+
+func clientTicketAudit(client *ovscli.OverseerClient, cmd *cobra.Command, name, odate string) {
+
+	if err := validateScopedName(name); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	if err := validator.Valid.ValidateTag(date.Odate(odate), "odate"); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	result, err := client.TicketAudit(name, odate)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, x := range result {
+		fmt.Printf("%s %-5s Name:%s ODAT:%s BY:%s\n", x.Time, x.Action, x.Name, x.Odate, x.Origin)
 	}
 }
 
 func clientSetFlag(client *ovscli.OverseerClient, cmd *cobra.Command, name, state string) {
 
-	if err := validator.Valid.ValidateTag(name, "resvalue,max=32"); err != nil {
+	if err := validateScopedName(name); err != nil {
 		fmt.Println(err)
 		return
 	}
@@ -221,7 +508,7 @@ func clientSetFlag(client *ovscli.OverseerClient, cmd *cobra.Command, name, stat
 
 func clientRemoveFlag(client *ovscli.OverseerClient, cmd *cobra.Command, name string) {
 
-	if err := validator.Valid.ValidateTag(name, "resvalue,max=32"); err != nil {
+	if err := validateScopedName(name); err != nil {
 		fmt.Println(err)
 		return
 	}
@@ -235,6 +522,111 @@ func clientRemoveFlag(client *ovscli.OverseerClient, cmd *cobra.Command, name st
 	fmt.Println(result)
 }
 
+func clientReleaseFlag(client *ovscli.OverseerClient, name, holder string) {
+
+	result, err := client.ReleaseFlagHolder(name, holder)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(result)
+}
+
+func clientSetQuantity(client *ovscli.OverseerClient, name, capacity string) {
+
+	value, err := strconv.Atoi(capacity)
+	if err != nil {
+		fmt.Println("invalid capacity:", capacity)
+		return
+	}
+
+	result, err := client.SetQuantity(name, int32(value))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(result)
+}
+
+func clientDeleteQuantity(client *ovscli.OverseerClient, name string) {
+
+	result, err := client.DeleteQuantity(name)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(result)
+}
+
+func clientListQuantities(client *ovscli.OverseerClient, name string) {
+
+	if name == "" {
+		name = "*"
+	}
+
+	result, err := client.ListQuantities(name)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, x := range result {
+		fmt.Printf("Name:%s Capacity:%d Used:%d\n", x.Name, x.Capacity, x.Used)
+	}
+}
+
+func clientSetVariable(client *ovscli.OverseerClient, group, task, name, value string) {
+
+	result, err := client.SetVariable(variableScope(group, task), group, task, name, value)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(result)
+}
+
+func clientDeleteVariable(client *ovscli.OverseerClient, group, task, name string) {
+
+	result, err := client.DeleteVariable(variableScope(group, task), group, task, name)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(result)
+}
+
+func clientListVariables(client *ovscli.OverseerClient, scope, group, task, name string) {
+
+	result, err := client.ListVariables(scope, group, task, name)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, x := range result {
+		fmt.Printf("Scope:%s Group:%s Task:%s %s=%s\n", x.Scope, x.Group, x.Task, x.Name, x.Value)
+	}
+}
+
+//variableScope - returns the scope of a variable for given group and task
+func variableScope(group, task string) string {
+
+	if task != "" {
+		return "task"
+	}
+
+	if group != "" {
+		return "group"
+	}
+
+	return "global"
+}
+
 func unfold(args []string) (string, string) {
 	var name string
 	var val string
@@ -248,3 +640,15 @@ func unfold(args []string) (string, string) {
 
 	return name, val
 }
+
+//validateScopedName - validates a name of a ticket or a flag that can be qualified with a namespace: NAMESPACE:NAME
+func validateScopedName(name string) error {
+
+	scope, name := ovscli.SplitScope(name)
+
+	if err := validator.Valid.ValidateTag(scope, "omitempty,resname,max=32"); err != nil {
+		return err
+	}
+
+	return validator.Valid.ValidateTag(name, "resvalue,max=32")
+}
@@ -20,14 +20,35 @@ func Setup(client *ovscli.OverseerClient) {
 	rootCmd.AddCommand(createSetsrvCommand(client))
 	rootCmd.AddCommand(createLoginCommand(client))
 	rootCmd.AddCommand(createAddCmd(client))
+	rootCmd.AddCommand(createAddFileCmd(client))
+	rootCmd.AddCommand(createDelManyCmd(client))
 	rootCmd.AddCommand(createDelCmd(client))
 	rootCmd.AddCommand(createCheckCmd(client))
 	rootCmd.AddCommand(createListCmd(client))
+	rootCmd.AddCommand(createTicketAuditCmd(client))
 	rootCmd.AddCommand(createSetCmd(client))
 	rootCmd.AddCommand(createRemoveCmd(client))
+	rootCmd.AddCommand(createSetQuantityCmd(client))
+	rootCmd.AddCommand(createDelQuantityCmd(client))
+	rootCmd.AddCommand(createQuantitiesCmd(client))
+	rootCmd.AddCommand(createReleaseFlagCmd(client))
+	rootCmd.AddCommand(createSetVarCmd(client))
+	rootCmd.AddCommand(createDelVarCmd(client))
+	rootCmd.AddCommand(createVarsCmd(client))
 	rootCmd.AddCommand(createOrderCmd(client))
 	rootCmd.AddCommand(createTaskCmd(client))
 	rootCmd.AddCommand(createPoolCmd(client))
+	rootCmd.AddCommand(createNewDayCmd(client))
+	rootCmd.AddCommand(createSetSecretCmd(client))
+	rootCmd.AddCommand(createDelSecretCmd(client))
+	rootCmd.AddCommand(createSecretsCmd(client))
+	rootCmd.AddCommand(createReconcileCmd(client))
+	rootCmd.AddCommand(createWorkersCmd(client))
+	rootCmd.AddCommand(createDrainCmd(client))
+	rootCmd.AddCommand(createUndrainCmd(client))
+	rootCmd.AddCommand(createRemoveWorkerCmd(client))
+	rootCmd.AddCommand(createLimitsCmd(client))
+	rootCmd.AddCommand(createSetLimitCmd(client))
 }
 
 //Execute - executes commands
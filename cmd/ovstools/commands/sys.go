@@ -3,8 +3,12 @@ package commands
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/przebro/overseer/common/types"
+	"github.com/przebro/overseer/common/types/date"
+	"github.com/przebro/overseer/common/validator"
 	"github.com/przebro/overseer/ovscli"
 
 	"github.com/manifoldco/promptui"
@@ -54,6 +58,191 @@ func createLoginCommand(client *ovscli.OverseerClient) *cobra.Command {
 	return cmd
 }
 
+func createNewDayCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:     "NEWDAY",
+		Short:   "NEWDAY - runs the new day procedure for a given odate",
+		Example: "NEWDAY 20210105",
+		Args:    cobra.RangeArgs(0, 1),
+		Run: func(c *cobra.Command, args []string) {
+
+			var odate string
+			if len(args) == 1 {
+				odate = args[0]
+			}
+			clientNewDayProcedure(client, odate)
+		},
+	}
+
+	return cmd
+}
+
+func createSetSecretCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:     "SETSECRET",
+		Short:   "SETSECRET - stores a secret, the value of the secret is read from a prompt",
+		Example: "SETSECRET DBPASS",
+		Args:    cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			clientSetSecret(client, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func createDelSecretCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:     "DELSECRET",
+		Short:   "DELSECRET - removes a secret",
+		Example: "DELSECRET DBPASS",
+		Args:    cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			clientDeleteSecret(client, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func createSecretsCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:     "SECRETS",
+		Short:   "SECRETS - lists names of secrets",
+		Example: "SECRETS DB*",
+		Args:    cobra.RangeArgs(0, 1),
+		Run: func(c *cobra.Command, args []string) {
+
+			var filter string
+			if len(args) == 1 {
+				filter = args[0]
+			}
+			clientListSecrets(client, filter)
+		},
+	}
+
+	return cmd
+}
+
+func createReconcileCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:     "RECONCILE",
+		Short:   "RECONCILE - recomputes the state of flags and quantitative resources from running tasks",
+		Example: "RECONCILE",
+		Args:    cobra.NoArgs,
+		Run: func(c *cobra.Command, args []string) {
+			clientReconcileResources(client)
+		},
+	}
+
+	return cmd
+}
+
+func createWorkersCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:     "WORKERS",
+		Short:   "WORKERS - lists workers with their state, tasks and limits",
+		Example: "WORKERS worker_0*",
+		Args:    cobra.RangeArgs(0, 1),
+		Run: func(c *cobra.Command, args []string) {
+
+			var filter string
+			if len(args) == 1 {
+				filter = args[0]
+			}
+			clientListWorkers(client, filter)
+		},
+	}
+
+	return cmd
+}
+
+func createDrainCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:     "DRAIN",
+		Short:   "DRAIN - stops sending new tasks to a worker",
+		Example: "DRAIN worker_01",
+		Args:    cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			clientDrainWorker(client, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func createUndrainCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:     "UNDRAIN",
+		Short:   "UNDRAIN - resumes sending new tasks to a drained worker",
+		Example: "UNDRAIN worker_01",
+		Args:    cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			clientUndrainWorker(client, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func createRemoveWorkerCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:     "RMWORKER",
+		Short:   "RMWORKER - removes a worker that does not execute any task",
+		Example: "RMWORKER worker_01",
+		Args:    cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			clientRemoveWorker(client, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func createLimitsCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:     "LIMITS",
+		Short:   "LIMITS - lists concurrency limits with numbers of executed tasks",
+		Example: "LIMITS",
+		Args:    cobra.NoArgs,
+		Run: func(c *cobra.Command, args []string) {
+			clientListLimits(client)
+		},
+	}
+
+	return cmd
+}
+
+func createSetLimitCmd(client *ovscli.OverseerClient) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:     "SETLIMIT",
+		Short:   "SETLIMIT - sets a maximum number of tasks executed at the same time: globally, in a group or of a type, 0 removes a limit",
+		Example: "SETLIMIT global 20 | SETLIMIT group billing 3 | SETLIMIT type aws 5",
+		Args:    cobra.RangeArgs(2, 3),
+		Run: func(c *cobra.Command, args []string) {
+
+			var name string
+			if len(args) == 3 {
+				name = args[1]
+			}
+			clientSetLimit(client, args[0], name, args[len(args)-1])
+		},
+	}
+
+	return cmd
+}
+
 func setupConnection(client *ovscli.OverseerClient, cmd *cobra.Command, args []string, serverCA, clientCertPath, clientKeyPath string) {
 
 	fmt.Println(serverCA)
@@ -108,3 +297,162 @@ func loginCommand(client *ovscli.OverseerClient, cmd *cobra.Command, args []stri
 	fmt.Println(token)
 
 }
+
+func clientNewDayProcedure(client *ovscli.OverseerClient, odate string) {
+
+	if err := validator.Valid.ValidateTag(date.Odate(odate), "odate"); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	result, err := client.NewDayProcedure(odate)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(result)
+}
+
+func clientSetSecret(client *ovscli.OverseerClient, name string) {
+
+	vprompt := promptui.Prompt{Label: "value", Mask: '*'}
+
+	value, err := vprompt.Run()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	result, err := client.SetSecret(name, value)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(result)
+}
+
+func clientDeleteSecret(client *ovscli.OverseerClient, name string) {
+
+	result, err := client.DeleteSecret(name)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(result)
+}
+
+func clientListSecrets(client *ovscli.OverseerClient, filter string) {
+
+	names, err := client.ListSecrets(filter)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, n := range names {
+		fmt.Println(n)
+	}
+}
+
+func clientReconcileResources(client *ovscli.OverseerClient) {
+
+	fixes, err := client.ReconcileResources()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	if len(fixes) == 0 {
+		fmt.Println("resources are consistent")
+		return
+	}
+
+	for _, f := range fixes {
+		fmt.Println(f)
+	}
+}
+
+func clientListWorkers(client *ovscli.OverseerClient, filter string) {
+
+	workers, err := client.ListWorkers(filter)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, w := range workers {
+		kind := "static"
+		if w.Registered {
+			kind = "registered"
+		}
+		fmt.Printf("Name:%s Address:%s State:%s Tasks:%d/%d Executions:%d Pool:%s Tags:%s Types:%s %s LastSeen:%s\n", w.Name, w.Address, w.State, w.Tasks, w.TasksLimit,
+			w.Executions, w.Pool, strings.Join(w.Tags, ","), strings.Join(w.Capabilities, ","), kind, w.LastSeen)
+		fmt.Printf("  CPU:%d%% Memory:%d/%dMB DiskFree:%dMB Weight:%d\n", w.CPU, w.MemUsed, w.MemTotal, w.DiskFree, w.Weight)
+	}
+}
+
+func clientDrainWorker(client *ovscli.OverseerClient, name string) {
+
+	result, err := client.DrainWorker(name)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(result)
+}
+
+func clientUndrainWorker(client *ovscli.OverseerClient, name string) {
+
+	result, err := client.UndrainWorker(name)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(result)
+}
+
+func clientRemoveWorker(client *ovscli.OverseerClient, name string) {
+
+	result, err := client.RemoveWorker(name)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(result)
+}
+
+func clientListLimits(client *ovscli.OverseerClient) {
+
+	limits, err := client.ListConcurrencyLimits()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, l := range limits {
+		fmt.Printf("Kind:%s Name:%s Running:%d Limit:%d\n", l.Kind, l.Name, l.Running, l.Limit)
+	}
+}
+
+func clientSetLimit(client *ovscli.OverseerClient, kind, name, limit string) {
+
+	value, err := strconv.Atoi(limit)
+	if err != nil {
+		fmt.Println("invalid limit:", limit)
+		return
+	}
+
+	result, err := client.SetConcurrencyLimit(kind, name, int32(value))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(result)
+}
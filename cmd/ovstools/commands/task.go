@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/przebro/overseer/common/types/date"
 	"github.com/przebro/overseer/common/validator"
 	"github.com/przebro/overseer/ovscli"
 
@@ -24,19 +25,26 @@ const (
 func createOrderCmd(client *ovscli.OverseerClient) *cobra.Command {
 
 	var force bool
+	var priority int32
+	var variables = map[string]string{}
 
 	cmd := &cobra.Command{
 
-		Use:   "ORDER",
-		Short: "ORDER - Puts a definition into active task pool",
-		Args:  cobra.ExactArgs(3),
+		Use:     "ORDER",
+		Short:   "ORDER - Puts a definition into active task pool",
+		Example: "ORDER group name 20210105 --var FILENAME=data.csv --var CUSTOMER=1234 --priority 50",
+		Args:    cobra.ExactArgs(3),
 		Run: func(c *cobra.Command, args []string) {
 
-			clientOrderDefinition(client, args[0], args[1], args[2], force)
+			clientOrderDefinition(client, args[0], args[1], args[2], force, variables, priority)
+			variables = map[string]string{}
+			priority = 0
 		},
 	}
 
 	cmd.Flags().BoolVar(&force, "force", false, "forcefully order task")
+	cmd.Flags().StringToStringVar(&variables, "var", map[string]string{}, "overrides a variable from the definition, NAME=VALUE")
+	cmd.Flags().Int32Var(&priority, "priority", 0, "overrides a priority from the definition, from 1 to 99")
 
 	return cmd
 }
@@ -141,7 +149,7 @@ func createPoolCmd(client *ovscli.OverseerClient) *cobra.Command {
 	return cmd
 }
 
-func clientOrderDefinition(client *ovscli.OverseerClient, group, name, odate string, force bool) {
+func clientOrderDefinition(client *ovscli.OverseerClient, group, name, odate string, force bool, variables map[string]string, priority int32) {
 
 	if err := validator.Valid.ValidateTag(group, "required,max=20,resname"); err != nil {
 		fmt.Println(err)
@@ -153,12 +161,23 @@ func clientOrderDefinition(client *ovscli.OverseerClient, group, name, odate str
 		return
 	}
 
-	if err := validator.Valid.ValidateTag(name, "required,odate"); err != nil {
+	if err := validator.Valid.ValidateTag(date.Odate(odate), "required,odate"); err != nil {
 		fmt.Println(err)
 		return
 	}
 
-	client.OrderTask(group, name, odate, force)
+	if err := validator.Valid.ValidateTag(priority, "min=0,max=99"); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	result, err := client.OrderTask(group, name, odate, force, variables, priority)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(result)
 }
 
 func clientTaskAction(client *ovscli.OverseerClient, orderID string, action taskAction) {
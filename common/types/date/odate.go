@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -27,8 +28,25 @@ const (
 	OdateValueAny OdateValue = "*"
 	//OdateValueNone - Expect a ticket without specific odate
 	OdateValueNone OdateValue = ""
+	//OdateValuePrevWeek PWEEK - Expect a ticket with the same day of a week in the previous week
+	OdateValuePrevWeek OdateValue = "PWEEK"
+	//OdateValuePrevMonthFirst PMFIRST - Expect a ticket with the first business day of the previous month
+	OdateValuePrevMonthFirst OdateValue = "PMFIRST"
+	//OdateValuePrevMonthLast PMLAST - Expect a ticket with the last business day of the previous month
+	OdateValuePrevMonthLast OdateValue = "PMLAST"
+	//OdateValueLast LASTnnn - Expect a ticket with any odate within the last nnn days, including the current order date
+	OdateValueLast OdateValue = "LAST"
 )
 
+//rangeSeparator - separates the beginning and the end of a range of order dates
+const rangeSeparator = "-"
+
+//OdateRange - a range of order dates, both ends of a range are included
+type OdateRange struct {
+	From Odate
+	To   Odate
+}
+
 var (
 	errOdateInvalidYear  = errors.New("Odate invalid year")
 	errOdateInvalidLen   = errors.New("Odate invalid length")
@@ -37,6 +55,59 @@ var (
 	errOdateInvalidDay   = errors.New("Odate invalid day of month")
 )
 
+//LastDays - returns a number of days for the LASTnnn value
+func (oval OdateValue) LastDays() (int, bool) {
+
+	value := string(oval)
+	if len(value) != len(OdateValueLast)+3 || !strings.HasPrefix(value, string(OdateValueLast)) {
+		return 0, false
+	}
+
+	days := 0
+	for _, c := range value[len(OdateValueLast):] {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		days = days*10 + int(c-'0')
+	}
+
+	return days, true
+}
+
+//String - returns a range in the format YYYYMMDD-YYYYMMDD
+func (r OdateRange) String() string {
+	return string(r.From) + rangeSeparator + string(r.To)
+}
+
+//Contains - checks if a given odate is within a range
+func (r OdateRange) Contains(odate Odate) bool {
+	return odate != OdateNone && odate >= r.From && odate <= r.To
+}
+
+//ParseRange - parses a range in the format YYYYMMDD-YYYYMMDD
+func ParseRange(value string) (OdateRange, bool) {
+
+	parts := strings.Split(value, rangeSeparator)
+	if len(parts) != 2 {
+		return OdateRange{}, false
+	}
+
+	r := OdateRange{From: Odate(parts[0]), To: Odate(parts[1])}
+	if r.From == OdateNone || r.To == OdateNone {
+		return OdateRange{}, false
+	}
+
+	if ok, _ := r.From.validateValue(); !ok {
+		return OdateRange{}, false
+	}
+
+	if ok, _ := r.To.validateValue(); !ok {
+		return OdateRange{}, false
+	}
+
+	return r, r.From <= r.To
+}
+
 //ODATE -returns odate in format YYMMDD
 func (date Odate) ODATE() string {
 
@@ -167,9 +238,7 @@ func IsInDayOfMonth(odate Odate, values []int) bool {
 //IsInFromEnd - check if day of execution is in odate
 func IsInFromEnd(odate Odate, values []int) bool {
 
-	y, m, _ := odate.Ymd()
-	t := time.Date(y, time.Month(m), 1, 0, 0, 0, 0, time.Local)
-	day := t.AddDate(0, 1, 0).AddDate(0, 0, -1).Day()
+	day := MonthEnd(odate).Day()
 	for _, val := range values {
 		if odate.Day() == day-(val-1) {
 			return true
@@ -232,6 +301,31 @@ func AddDays(odate Odate, num int) Odate {
 
 }
 
+//MonthEnd - returns the last day of the month of a given odate
+func MonthEnd(odate Odate) Odate {
+
+	y, m, _ := odate.Ymd()
+	return FromTime(time.Date(y, time.Month(m)+1, 0, 0, 0, 0, 0, time.Local))
+}
+
+//PrevMonthBusinessDay - returns the first or the last business day, from Monday to Friday, of the month before a given odate
+func PrevMonthBusinessDay(odate Odate, first bool) Odate {
+
+	y, m, _ := odate.Ymd()
+	begin := time.Date(y, time.Month(m), 1, 0, 0, 0, 0, time.Local).AddDate(0, -1, 0)
+
+	day, step := begin, 1
+	if !first {
+		day, step = begin.AddDate(0, 1, -1), -1
+	}
+
+	for day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		day = day.AddDate(0, 0, step)
+	}
+
+	return FromTime(day)
+}
+
 //FromDateString - convert string date in format YYYY-MM-DD to Odate
 func FromDateString(date string) Odate {
 
@@ -134,6 +134,72 @@ func TestDateRange(t *testing.T) {
 
 }
 
+func TestOdateRange(t *testing.T) {
+
+	r, ok := ParseRange("20201101-20201107")
+	if !ok || r.From != "20201101" || r.To != "20201107" || r.String() != "20201101-20201107" {
+		t.Error("unexpected result:", r, ok)
+	}
+
+	if !r.Contains("20201101") || !r.Contains("20201107") || r.Contains("20201108") || r.Contains(OdateNone) {
+		t.Error("unexpected result")
+	}
+
+	for _, v := range []string{"", "20201101", "20201107-20201101", "20201101-", "20201101-20201131", "-001"} {
+		if _, ok := ParseRange(v); ok {
+			t.Error("unexpected result:", v)
+		}
+	}
+
+	if days, ok := OdateValue("LAST007").LastDays(); !ok || days != 7 {
+		t.Error("unexpected result:", days, ok)
+	}
+
+	for _, v := range []OdateValue{"LAST", "LAST07", "LAST+01", "LAST-01", "LASTABC", "ODATE"} {
+		if _, ok := v.LastDays(); ok {
+			t.Error("unexpected result:", v)
+		}
+	}
+}
+
+func TestPrevMonthBusinessDay(t *testing.T) {
+
+	tdata := []struct {
+		odate Odate
+		first Odate
+		last  Odate
+	}{
+		{"20201101", "20201001", "20201030"},
+		{"20210615", "20210503", "20210531"},
+		{"20210110", "20201201", "20201231"},
+	}
+
+	for _, d := range tdata {
+		if r := PrevMonthBusinessDay(d.odate, true); r != d.first {
+			t.Error("unexpected result:", r, "expected:", d.first)
+		}
+		if r := PrevMonthBusinessDay(d.odate, false); r != d.last {
+			t.Error("unexpected result:", r, "expected:", d.last)
+		}
+	}
+}
+
+func TestMonthEnd(t *testing.T) {
+
+	tdata := map[Odate]Odate{
+		"20200215": "20200229",
+		"20210201": "20210228",
+		"20201231": "20201231",
+		"20210410": "20210430",
+	}
+
+	for in, expected := range tdata {
+		if r := MonthEnd(in); r != expected {
+			t.Error("unexpected result:", r, "expected:", expected)
+		}
+	}
+}
+
 func TestValidate(t *testing.T) {
 
 	ok, err := Odate("").validateValue()
@@ -12,6 +12,7 @@ func init() {
 
 	validator.Valid.RegisterTypeValidator("Odate", "odate", OdateValidator)
 	validator.Valid.RegisterTypeValidator("OdateValue", "odateval", OdateValueValidator)
+	validator.Valid.RegisterValidatorRule("odatesingle", OdateSingleValueValidator)
 }
 
 //OdateValidator - validator function for a Odate type
@@ -32,6 +33,17 @@ func OdateValueValidator(fl vl.FieldLevel) bool {
 
 }
 
+//OdateSingleValueValidator - validator function for a OdateValue type that resolves to a single odate, not to a range
+func OdateSingleValueValidator(fl vl.FieldLevel) bool {
+
+	if actual, ok := fl.Field().Interface().(OdateValue); ok {
+		_, isRange := actual.LastDays()
+		return !isRange
+	}
+
+	return false
+}
+
 //ValidateValue - Validates if given odate is a valid date
 func (date Odate) validateValue() (bool, error) {
 
@@ -93,6 +105,14 @@ func (oval OdateValue) validateValue() (bool, error) {
 		return true, nil
 	}
 
+	if oval == OdateValuePrevWeek || oval == OdateValuePrevMonthFirst || oval == OdateValuePrevMonthLast {
+		return true, nil
+	}
+
+	if _, ok := oval.LastDays(); ok {
+		return true, nil
+	}
+
 	ok, _ := regexp.MatchString(`^[\-|\+]{1}\d{3}$`, string(oval))
 
 	if ok {
@@ -114,4 +114,24 @@ func TestOdateValueValidator(t *testing.T) {
 		t.Error("Unexpected value")
 	}
 
+	for _, value = range []OdateValue{"PWEEK", "PMFIRST", "PMLAST", "LAST007"} {
+		if err = validator.Valid.Validate(value); err != nil {
+			t.Error("Unexpected value:", value)
+		}
+	}
+
+	for _, value = range []OdateValue{"LAST07", "LAST+01", "PMONTH"} {
+		if err = validator.Valid.Validate(value); err == nil {
+			t.Error("Unexpected value:", value)
+		}
+	}
+
+	if err = validator.Valid.ValidateTag(OdateValue("LAST007"), "odatesingle"); err == nil {
+		t.Error("Unexpected value")
+	}
+
+	if err = validator.Valid.ValidateTag(OdateValue("PMLAST"), "odatesingle"); err != nil {
+		t.Error("Unexpected value")
+	}
+
 }
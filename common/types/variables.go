@@ -13,3 +13,26 @@ func (data EnvironmentVariable) Expand() string {
 }
 
 type EnvironmentVariableList []EnvironmentVariable
+
+//Merge - returns a new list that contains variables from the list overridden and extended by variables from other list
+func (list EnvironmentVariableList) Merge(other EnvironmentVariableList) EnvironmentVariableList {
+
+	result := make(EnvironmentVariableList, len(list))
+	copy(result, list)
+
+	for _, o := range other {
+		found := false
+		for i := range result {
+			if result[i].Name == o.Name {
+				result[i].Value = o.Value
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, o)
+		}
+	}
+
+	return result
+}
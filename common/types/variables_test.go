@@ -0,0 +1,34 @@
+package types
+
+import "testing"
+
+func TestExpand(t *testing.T) {
+
+	v := EnvironmentVariable{Name: "%%VALUE", Value: "data"}
+	if v.Expand() != "OVS_VALUE" {
+		t.Error("unexpected result:", v.Expand())
+	}
+}
+
+func TestMerge(t *testing.T) {
+
+	base := EnvironmentVariableList{{Name: "%%A", Value: "1"}, {Name: "%%B", Value: "2"}}
+	other := EnvironmentVariableList{{Name: "%%B", Value: "3"}, {Name: "%%C", Value: "4"}}
+
+	result := base.Merge(other)
+	if len(result) != 3 {
+		t.Fatal("unexpected result:", result)
+	}
+
+	if result[0].Value != "1" || result[1].Value != "3" || result[2].Value != "4" {
+		t.Error("unexpected result:", result)
+	}
+
+	if base[1].Value != "2" {
+		t.Error("unexpected result, base list modified:", base)
+	}
+
+	if result = base.Merge(nil); len(result) != 2 {
+		t.Error("unexpected result:", result)
+	}
+}
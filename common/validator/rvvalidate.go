@@ -21,6 +21,21 @@ func ResourceNameValidator(fl vl.FieldLevel) bool {
 	return false
 }
 
+//ResourceScopeValidator - validator function for a namespace of a ticket or a flag,
+//the namespace is a resource name or @group that refers to the group of a task
+func ResourceScopeValidator(fl vl.FieldLevel) bool {
+
+	if val, ok := fl.Field().Interface().(string); ok {
+		if val == "@group" {
+			return true
+		}
+		result, _ := validateValueResource(val)
+		return result
+	}
+
+	return false
+}
+
 //ResourceValueValidator - validator function  for resource name like task, flag or ticket,
 // this one is used for search strings and accepts additional * ands ?
 func ResourceValueValidator(fl vl.FieldLevel) bool {
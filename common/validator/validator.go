@@ -22,6 +22,7 @@ func init() {
 
 	Valid.RegisterValidatorRule("resname", ResourceNameValidator)
 	Valid.RegisterValidatorRule("resvalue", ResourceValueValidator)
+	Valid.RegisterValidatorRule("resscope", ResourceScopeValidator)
 	Valid.RegisterValidatorRule("varname", VariableNameValidator)
 }
 
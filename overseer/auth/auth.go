@@ -38,8 +38,12 @@ const (
 	ActionOrder
 	ActionForce
 	ActionDefinition
+	ActionVariables
 )
 
+//GlobalNamespace - refers to the global namespace of tickets in a list of namespaces of a role
+const GlobalNamespace = "@global"
+
 var (
 	//ErrUnableFindAction - returned when an action is not found
 	ErrUnableFindAction = errors.New("unable to find action")
@@ -75,24 +79,9 @@ func NewAuthorizationManager(conf config.SecurityConfiguration, provider *datast
 //VerifyAction - verifies if a given user is eligible to perform a specified action
 func (m *AuthorizationManager) VerifyAction(ctx context.Context, action UserAction, username string) (bool, error) {
 
-	if ctx == nil {
-		ctx = context.Background()
-	}
-	model := dsRoleAssociationModel{}
-	if err := m.col.Get(ctx, idFormatter(assocNamespace, username), &model); err != nil {
-		return false, errors.New("unable to get role association for given user")
-	}
-
-	roles := []RoleModel{}
-
-	for x := range model.Roles {
-
-		rmodel := RoleModel{}
-		if err := m.col.Get(ctx, idFormatter(rolesNamespace, model.Roles[x]), &rmodel); err != nil {
-			return false, fmt.Errorf("unable to verify action, role %s does not exists", model.Roles[x])
-		}
-
-		roles = append(roles, rmodel)
+	roles, err := m.userRoles(ctx, username)
+	if err != nil {
+		return false, err
 	}
 
 	finalRole := m.getEffectiveRights(roles)
@@ -124,6 +113,8 @@ func (m *AuthorizationManager) VerifyAction(ctx context.Context, action UserActi
 		return finalRole.Hold, nil
 	case ActionFree:
 		return finalRole.Free, nil
+	case ActionVariables:
+		return finalRole.Variables, nil
 		//a virtual action for every user that has enabled account
 	case ActionBrowse:
 		return true, nil
@@ -131,6 +122,57 @@ func (m *AuthorizationManager) VerifyAction(ctx context.Context, action UserActi
 	return false, ErrUnableFindAction
 }
 
+//AllowedNamespaces - returns namespaces in which a given user can add or remove tickets,
+//nil means that the user is not restricted to any namespace.
+func (m *AuthorizationManager) AllowedNamespaces(ctx context.Context, username string) ([]string, error) {
+
+	roles, err := m.userRoles(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := []string{}
+
+	for x := range roles {
+		if !roles[x].AddTicket && !roles[x].RemoveTicket {
+			continue
+		}
+		//a role that manages tickets without restrictions gives access to all namespaces
+		if len(roles[x].Namespaces) == 0 {
+			return nil, nil
+		}
+		namespaces = append(namespaces, roles[x].Namespaces...)
+	}
+
+	return namespaces, nil
+}
+
+//userRoles - returns roles associated with a given user
+func (m *AuthorizationManager) userRoles(ctx context.Context, username string) ([]RoleModel, error) {
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	model := dsRoleAssociationModel{}
+	if err := m.col.Get(ctx, idFormatter(assocNamespace, username), &model); err != nil {
+		return nil, errors.New("unable to get role association for given user")
+	}
+
+	roles := []RoleModel{}
+
+	for x := range model.Roles {
+
+		rmodel := RoleModel{}
+		if err := m.col.Get(ctx, idFormatter(rolesNamespace, model.Roles[x]), &rmodel); err != nil {
+			return nil, fmt.Errorf("unable to verify action, role %s does not exists", model.Roles[x])
+		}
+
+		roles = append(roles, rmodel)
+	}
+
+	return roles, nil
+}
+
 //getEffectiveRights - returns a sum of rights from all roles
 func (m *AuthorizationManager) getEffectiveRights(roles []RoleModel) RoleModel {
 
@@ -151,6 +193,7 @@ func (m *AuthorizationManager) getEffectiveRights(roles []RoleModel) RoleModel {
 		finalModel.Bypass = roles[x].Bypass || finalModel.Bypass
 		finalModel.Hold = roles[x].Hold || finalModel.Hold
 		finalModel.Free = roles[x].Free || finalModel.Free
+		finalModel.Variables = roles[x].Variables || finalModel.Variables
 
 	}
 
@@ -74,6 +74,29 @@ var testSecurity = map[string]interface{}{
 			Free:           true,
 		},
 	},
+	"assoc@testuser4": dsRoleAssociationModel{
+		ID: "assoc@testuser4",
+		RoleAssociationModel: RoleAssociationModel{
+			UserID: "testuser4",
+			Roles:  []string{"testrole2", "testrole3", "testrole4"},
+		},
+	},
+	"role@testrole3": dsRoleModel{
+		ID: "role@testrole3",
+		RoleModel: RoleModel{
+			Name:       "testrole3",
+			AddTicket:  true,
+			Namespaces: []string{"ns1"},
+		},
+	},
+	"role@testrole4": dsRoleModel{
+		ID: "role@testrole4",
+		RoleModel: RoleModel{
+			Name:         "testrole4",
+			RemoveTicket: true,
+			Namespaces:   []string{"ns2", GlobalNamespace},
+		},
+	},
 	"user@testuser1": dsUserModel{
 		ID: "user@testuser1",
 		UserModel: UserModel{
@@ -262,6 +285,43 @@ func TestVerifyAction(t *testing.T) {
 
 }
 
+func TestAllowedNamespaces(t *testing.T) {
+
+	m, err := NewAuthorizationManager(prvconf, prvprovider)
+	if err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+
+	if _, err = m.AllowedNamespaces(context.Background(), "testuser3"); err == nil {
+		t.Error("unexpected result:", err)
+	}
+
+	ns, err := m.AllowedNamespaces(context.Background(), "testuser2")
+	if err != nil {
+		t.Error("unexpected result:", err)
+	}
+
+	if ns != nil {
+		t.Error("unexpected result:", ns, "expected: nil")
+	}
+
+	ns, err = m.AllowedNamespaces(context.Background(), "testuser4")
+	if err != nil {
+		t.Error("unexpected result:", err)
+	}
+
+	if len(ns) != 3 || ns[0] == ns[1] {
+		t.Error("unexpected result:", ns)
+	}
+
+	expect := map[string]bool{"ns1": true, "ns2": true, GlobalNamespace: true}
+	for _, n := range ns {
+		if !expect[n] {
+			t.Error("unexpected namespace:", n)
+		}
+	}
+}
+
 func TestAuthenticationManager(t *testing.T) {
 
 	amanager, err := NewAuthenticationManager("", prvprovider)
@@ -49,6 +49,10 @@ type RoleModel struct {
 	Order      bool `json:"order"`
 	Force      bool `json:"force"`
 	Definition bool `json:"definition"`
+	//Variable Management
+	Variables bool `json:"variables"`
+	//Namespaces in which tickets can be added or removed, an empty list means all namespaces
+	Namespaces []string `json:"namespaces,omitempty" validate:"omitempty,dive,max=32"`
 }
 
 type dsRoleModel struct {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"time"
 
 	"github.com/przebro/overseer/common/logger"
 	"github.com/przebro/overseer/common/types"
@@ -12,27 +13,77 @@ import (
 
 //WorkerConfiguration - configuration
 type WorkerConfiguration struct {
-	WorkerName string `json:"name" validate:"required"`
-	WorkerHost string `json:"workerHost" validate:"ipv4,required"`
-	WorkerPort int    `json:"workerPort" validate:"min=1024,max=65535,required"`
-	WorkerCA   string `json:"workerCA"`
+	WorkerName   string   `json:"name" validate:"required"`
+	WorkerHost   string   `json:"workerHost" validate:"ipv4,required"`
+	WorkerPort   int      `json:"workerPort" validate:"min=1024,max=65535,required"`
+	WorkerCA     string   `json:"workerCA"`
+	WorkerPool   string   `json:"pool,omitempty"`
+	WorkerTags   []string `json:"tags,omitempty"`
+	WorkerWeight int      `json:"weight,omitempty" validate:"min=0,max=100"`
+}
+
+//WorkerPoolConfiguration - a named group of workers, tags of a pool are shared by all its workers
+type WorkerPoolConfiguration struct {
+	Name string   `json:"name" validate:"required,max=32"`
+	Tags []string `json:"tags,omitempty"`
 }
 
 //WorkerManagerConfiguration - setting for worker manager
 type WorkerManagerConfiguration struct {
-	Timeout           int                   `json:"timeout"`
-	WorkerInterval    int                   `json:"interval"`
-	WorkerMaxAttempts int                   `json:"attempts"`
-	Workers           []WorkerConfiguration `json:"workers"`
+	Timeout           int                       `json:"timeout"`
+	WorkerInterval    int                       `json:"interval"`
+	WorkerMaxAttempts int                       `json:"attempts"`
+	Workers           []WorkerConfiguration     `json:"workers"`
+	Pools             []WorkerPoolConfiguration `json:"pools,omitempty"`
+	JoinToken         string                    `json:"joinToken,omitempty"`
+	Heartbeat         int                       `json:"heartbeat,omitempty" validate:"min=0,max=300"`
+	Placement         string                    `json:"placement,omitempty" validate:"omitempty,max=32"`
+	LostWorker        LostWorkerConfiguration   `json:"lostWorker"`
+	//RefreshInterval - interval in milliseconds between refreshes of workers, it overrides the interval in seconds
+	RefreshInterval int `json:"refreshInterval,omitempty" validate:"omitempty,min=100,max=3600000"`
 }
 
+//LostWorkerConfiguration - defines what happens with tasks executed by a worker that missed heartbeats.
+//Possible policies are: wait,fail,redispatch, only idempotent tasks are re-dispatched, other tasks fail.
+type LostWorkerConfiguration struct {
+	Policy           string `json:"policy" validate:"omitempty,oneof=wait fail redispatch"`
+	MissedHeartbeats int    `json:"missedHeartbeats" validate:"min=0,max=100"`
+}
+
+//CatchUpPolicy - defines how missed new day procedures are processed on startup
+//Possible values are: all,latest,none
+type CatchUpPolicy string
+
+const (
+	//CatchUpPolicyAll - orders tasks for each missed ODATE
+	CatchUpPolicyAll CatchUpPolicy = "all"
+	//CatchUpPolicyLatest - orders tasks only for the latest missed ODATE
+	CatchUpPolicyLatest CatchUpPolicy = "latest"
+	//CatchUpPolicyNone - missed ODATEs are skipped
+	CatchUpPolicyNone CatchUpPolicy = "none"
+)
+
 //ActivePoolConfiguration - Active Pool Configuration section
 type ActivePoolConfiguration struct {
-	MaxOkReturnCode int32             `json:"maxOkReturnCode"`
-	NewDayProc      types.HourMinTime `json:"newDayProc" validate:"hmtime,required"`
-	ForceNewDayProc bool              `json:"forceNewDayProc"`
-	Collection      string            `json:"collection"`
-	SyncTime        int               `json:"syncTime" validate:"min=0,max=60"`
+	MaxOkReturnCode int32                          `json:"maxOkReturnCode"`
+	NewDayProc      types.HourMinTime              `json:"newDayProc" validate:"hmtime,required"`
+	ForceNewDayProc bool                           `json:"forceNewDayProc"`
+	CatchUpPolicy   CatchUpPolicy                  `json:"catchUpPolicy" validate:"omitempty,oneof=all latest none"`
+	Collection      string                         `json:"collection"`
+	SyncTime        int                            `json:"syncTime" validate:"min=0,max=60"`
+	Limits          ConcurrencyLimitsConfiguration `json:"limits"`
+	//EvaluationInterval, DailyCheckInterval - intervals in milliseconds between evaluations of tasks and checks
+	//whether the new day procedure should start, by default both activities use the timeInterval
+	EvaluationInterval int `json:"evaluationInterval,omitempty" validate:"omitempty,min=100,max=3600000"`
+	DailyCheckInterval int `json:"dailyCheckInterval,omitempty" validate:"omitempty,min=100,max=3600000"`
+}
+
+//ConcurrencyLimitsConfiguration - maximum numbers of tasks executed at the same time: by all tasks,
+//by tasks from a group and by tasks of a type. Zero means no limit.
+type ConcurrencyLimitsConfiguration struct {
+	Global int            `json:"global" validate:"min=0"`
+	Groups map[string]int `json:"groups,omitempty" validate:"omitempty,dive,min=0"`
+	Types  map[string]int `json:"types,omitempty" validate:"omitempty,dive,min=0"`
 }
 
 //ResourceEntry - resource configuration
@@ -41,10 +92,23 @@ type ResourceEntry struct {
 	Sync       int    `json:"sync"`
 }
 
-//ResourcesConfigurartion - configuration section for tickets and flags
+//SecretEntry - secret store configuration, the key is a base64 encoded key that encrypts secrets,
+//if the key is omitted, the server secret is used
+type SecretEntry struct {
+	Collection string `json:"collectionName"`
+	Sync       int    `json:"sync"`
+	Key        string `json:"key"`
+}
+
+//ResourcesConfigurartion - configuration section for tickets, flags, quantitative resources, variables and secrets
 type ResourcesConfigurartion struct {
-	TicketSource ResourceEntry `json:"tickets"`
-	FlagSource   ResourceEntry `json:"flags"`
+	TicketSource   ResourceEntry `json:"tickets"`
+	FlagSource     ResourceEntry `json:"flags"`
+	QuantitySource ResourceEntry `json:"quantities"`
+	VariableSource ResourceEntry `json:"variables"`
+	SecretSource   SecretEntry   `json:"secrets"`
+	//TicketRetention - number of days after which tickets are removed by the new day procedure, 0 means that tickets never expire
+	TicketRetention int `json:"ticketRetention" validate:"min=0"`
 }
 
 //IntervalValue - represents limited interval value
@@ -113,6 +177,18 @@ type SecurityConfiguration struct {
 type JournalConfiguration struct {
 	LogCollection string `json:"logs"`
 	SyncTime      int    `json:"syncTime" validate:"min=0,max=60"`
+	//SyncInterval - interval in milliseconds between writes of the journal to the datastore, it overrides the syncTime
+	SyncInterval int `json:"syncInterval,omitempty" validate:"omitempty,min=100,max=3600000"`
+}
+
+//Interval - returns an interval given in milliseconds, or in seconds if milliseconds are not set
+func Interval(milliseconds, seconds int) time.Duration {
+
+	if milliseconds > 0 {
+		return time.Duration(milliseconds) * time.Millisecond
+	}
+
+	return time.Duration(seconds) * time.Second
 }
 
 //Load - Loads configuration from a file
@@ -308,6 +308,46 @@ func (r *flagActionReciever) WaitForResult() (RouteFlagActionResponse, error) {
 	return result, err
 }
 
+//FlagReconcileReceiver - Receiver for RouteFlagReconcile
+type FlagReconcileReceiver interface {
+	EventReceiver
+	WaitForResult() (RouteFlagReconcileResponse, error)
+}
+
+type flagReconcileReceiver struct {
+	eventReceiver
+}
+
+//NewFlagReconcileReceiver - Creates a new FlagReconcileReceiver
+func NewFlagReconcileReceiver() FlagReconcileReceiver {
+	l := &flagReconcileReceiver{}
+	l.done = make(chan interface{})
+	return l
+}
+
+func (r *flagReconcileReceiver) WaitForResult() (RouteFlagReconcileResponse, error) {
+
+	var result RouteFlagReconcileResponse
+	var err error = nil
+
+	x := <-r.done
+	switch val := x.(type) {
+	case RouteFlagReconcileResponse:
+		{
+			result = val
+		}
+	case error:
+		{
+			err = val
+		}
+	default:
+		{
+			err = ErrUnrecognizedMsgFormat
+		}
+	}
+	return result, err
+}
+
 //ResponseToReceiver - Helper function, sends response to receiver. If receiver is nil this function does nothing
 func ResponseToReceiver(r EventReceiver, data interface{}) {
 
@@ -329,6 +329,7 @@ func TestTicketInReceiver(t *testing.T) {
 			Name   string
 			Odate  date.Odate
 			Action taskdef.OutAction
+			Keep   bool
 		}{
 			{Name: "TICKET_01", Action: taskdef.OutActionAdd},
 		}}
@@ -476,3 +477,33 @@ func TestFlagActionReciever(t *testing.T) {
 	}
 
 }
+
+func TestFlagReconcileReceiver(t *testing.T) {
+
+	frr := NewFlagReconcileReceiver()
+
+	go func() {
+
+		ResponseToReceiver(frr, RouteFlagReconcileResponse{Fixes: []FlagReconcileData{{OrderID: "0A3F", Name: "FLAG"}}})
+	}()
+
+	data, err := frr.WaitForResult()
+
+	if err != nil {
+		t.Error("Unexpected result", err)
+	}
+	if len(data.Fixes) != 1 {
+		t.Error("Unexpected result", data)
+	}
+
+	go func() {
+
+		ResponseToReceiver(frr, "invsalid value")
+	}()
+
+	_, err = frr.WaitForResult()
+
+	if err != ErrUnrecognizedMsgFormat {
+		t.Error("Unexpected result", err, "expected:", ErrUnrecognizedMsgFormat)
+	}
+}
@@ -20,8 +20,10 @@ type RouteTimeOutMsgFormat struct {
 	Sec   int
 }
 
-//RouteTicketCheckMsgFormat - Status of a tickets requested by a task.
+//RouteTicketCheckMsgFormat - Status of a tickets requested by a task. If OrderID is set, the task
+//is notified with RouteTicketNotifyMsg when any of checked tickets is added or removed.
 type RouteTicketCheckMsgFormat struct {
+	OrderID unique.TaskOrderID
 	Tickets []struct {
 		Name      string
 		Odate     string
@@ -30,33 +32,85 @@ type RouteTicketCheckMsgFormat struct {
 	}
 }
 
+//RouteTicketNotifyMsg - Informs that tickets watched by tasks were added or removed
+type RouteTicketNotifyMsg struct {
+	OrderIDs []unique.TaskOrderID
+}
+
+//RouteWorkNotifyMsg - Informs a task that its execution ended
+type RouteWorkNotifyMsg struct {
+	OrderID     unique.TaskOrderID
+	ExecutionID string
+}
+
+//RouteFlagNotifyMsg - Informs that flags or quantitative resources were released
+type RouteFlagNotifyMsg struct {
+	Names []string
+}
+
+//RouteTicketUnwatchMsg - Task no longer waits for tickets
+type RouteTicketUnwatchMsg struct {
+	OrderID unique.TaskOrderID
+}
+
 //FlagActionData - struct for acquire flag message
 type FlagActionData struct {
 	Name   string
 	Policy int8
 }
 
-//RouteFlagAcquireMsg - acquires flags
+//QuantityActionData - struct for acquire quantitative resource message
+type QuantityActionData struct {
+	Name     string
+	Quantity int
+}
+
+//RouteFlagAcquireMsg - acquires flags and quantitative resources on behalf of a task
 type RouteFlagAcquireMsg struct {
-	Flags []FlagActionData
+	OrderID    unique.TaskOrderID
+	Flags      []FlagActionData
+	Quantities []QuantityActionData
 }
 
 /*RouteFlagActionResponse - response for both RouteFlagAcquireMsg and RouteFlagReleaseMsg messages.
-Contains information if all required flags were acquired; if Success is false, then the Names field will contain
-the name of the flag that was not acquired
+Contains information if all required flags and quantitative resources were acquired; if Success is false, then the Names field will contain
+the name of the flag or the resource that was not acquired and the Reasons field will describe why the resource is not available
 if it is a response for a RouteFlagReleaseMsg then the Names filed will contain all flags that were not unset
 */
 type RouteFlagActionResponse struct {
 	Success bool
 	Names   []string
+	Reasons []string
 }
 
-//RouteTicketInMsgFormat - Basing on this structure, tickets are added or removed from the resources manager
+//RouteFlagReconcileMsg - contains resources held by tasks that are starting or executing,
+//the state of flags and quantitative resources is recomputed from these holdings
+type RouteFlagReconcileMsg struct {
+	Holdings []RouteFlagAcquireMsg
+}
+
+//FlagReconcileData - describes a single change made by the reconciliation
+type FlagReconcileData struct {
+	OrderID unique.TaskOrderID
+	Name    string
+	Message string
+}
+
+//RouteFlagReconcileResponse - response for RouteFlagReconcileMsg, contains changes that were made
+type RouteFlagReconcileResponse struct {
+	Fixes []FlagReconcileData
+}
+
+//RouteTicketInMsgFormat - Basing on this structure, tickets are added or removed from the resources manager,
+//OrderID and ExecutionID identify a task that issued tickets.
 type RouteTicketInMsgFormat struct {
-	Tickets []struct {
+	OrderID     unique.TaskOrderID
+	ExecutionID string
+	Tickets     []struct {
 		Name   string
 		Odate  date.Odate
 		Action task.OutAction
+		Keep   bool
 	}
 }
 
@@ -92,11 +146,17 @@ type TaskDetailResultMsg struct {
 	EndTime     string
 	Worker      string
 	Description string
+	Variables   types.EnvironmentVariableList
 	Tickets     []struct {
 		Name      string
 		Odate     date.Odate
 		Fulfilled bool
 	}
+	Resources []struct {
+		Type    string
+		Name    string
+		Blocked bool
+	}
 }
 
 //RouteTaskActionMsgFormat - Request for a task order
@@ -138,6 +198,8 @@ type RouteTaskExecutionMsg struct {
 	Type        types.TaskType
 	Variables   types.EnvironmentVariableList
 	Command     json.RawMessage
+	Worker      task.WorkerSelector
+	Idempotent  bool
 }
 
 //RouteWorkResponseMsg - Contains information about the status of executing work.
@@ -148,6 +210,7 @@ type RouteWorkResponseMsg struct {
 	WorkerName  string
 	ReturnCode  int32
 	StatusCode  int32
+	Reason      string
 }
 
 //RouteChangeStateMsg - Request for setting a task into a specific state.
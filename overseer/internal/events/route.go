@@ -22,6 +22,12 @@ const (
 	RoutTaskJournal      RouteName = "TASK_JOURNAL"
 	RouteFlagAcquire     RouteName = "FLAG_ACQUIRE"
 	RouteFlagRelase      RouteName = "FLAG_RELEASE"
+	RouteFlagReconcile   RouteName = "FLAG_RECONCILE"
+	RouteTicketNotify    RouteName = "COND_NOTIFY"
+	RouteTicketUnwatch   RouteName = "COND_UNWATCH"
+	RouteWorkNotify      RouteName = "WORK_NOTIFY"
+	RouteFlagNotify      RouteName = "FLAG_NOTIFY"
+	RouteDailyCheck      RouteName = "DAILY_CHECK"
 )
 
 //messageRoute - holds participants of route
@@ -17,24 +17,33 @@ import (
 
 //Enumeration of task's messages that will be sent to journal when a specific event occurs
 const (
-	TaskHeld              = "TASK HELD, user:%s"
-	TaskFreed             = "TASK FREED, user:%s"
-	TaskFulfill           = "TASK PRECONDITIONS OK"
-	TaskEnforce           = "TASK ENFORCED, user:%s"
-	TaskRerun             = "TASK RERUN, user:%s"
-	TaskSetOK             = "TASK SETOK, user:%s"
-	TaskConfirmed         = "TASK CONFIRMED, user:%s"
-	TaskForced            = "TASK FORCED, user:%s ODATE:%s"
-	TaskOrdered           = "TASK ORDERED, user:%s ODATE:%s"
-	TaskStartingRN        = "TASK STARTING RN:%d"
-	TaskStartingFailedErr = "TASK STARTING FAILED worker error"
-	TaskStartingFailed    = "TASK STARTING FAILED invalid worker status:%d"
-	TaskStarting          = "TASK STARTING worker:%s"
-	TaskComplete          = "TASK EXECUTION COMPLETE  %s"
-	TaskFailed            = "TASK FAILED worker failure"
-	TaskEndedNOK          = "ENDED NOT OK, RC:%d, STATUS:%d"
-	TaskEndedOK           = "ENDED OK, RC:%d, STATUS:%d"
-	TaskPostProc          = "TASK POST PROCESSING ends"
+	TaskHeld                 = "TASK HELD, user:%s"
+	TaskFreed                = "TASK FREED, user:%s"
+	TaskFulfill              = "TASK PRECONDITIONS OK"
+	TaskEnforce              = "TASK ENFORCED, user:%s"
+	TaskRerun                = "TASK RERUN, user:%s"
+	TaskSetOK                = "TASK SETOK, user:%s"
+	TaskConfirmed            = "TASK CONFIRMED, user:%s"
+	TaskForced               = "TASK FORCED, user:%s ODATE:%s"
+	TaskOrdered              = "TASK ORDERED, user:%s ODATE:%s"
+	TaskStartingRN           = "TASK STARTING RN:%d"
+	TaskStartingFailedErr    = "TASK STARTING FAILED worker error"
+	TaskStartingFailed       = "TASK STARTING FAILED invalid worker status:%d"
+	TaskStartingVariablesErr = "TASK STARTING FAILED variables error:%s"
+	TaskStarting             = "TASK STARTING worker:%s"
+	TaskComplete             = "TASK EXECUTION COMPLETE  %s"
+	TaskFailed               = "TASK FAILED worker failure"
+	TaskEndedNOK             = "ENDED NOT OK, RC:%d, STATUS:%d"
+	TaskEndedOK              = "ENDED OK, RC:%d, STATUS:%d"
+	TaskPostProc             = "TASK POST PROCESSING ends"
+	TaskFlagReconciled       = "FLAG %s RELEASED BY RECONCILIATION"
+	TaskFlagRecovered        = "FLAG %s ACQUIRED BY RECONCILIATION"
+	TaskFlagForceReleased    = "FLAG %s FORCE RELEASED, user:%s"
+	TaskWorkerLost           = "TASK WORKER LOST worker:%s"
+	TaskWorkerLostFailed     = "TASK FAILED worker lost"
+	TaskWorkerLostRedispatch = "TASK RE-DISPATCHED worker:%s"
+	TaskWorkerLostWaiting    = "TASK WAITING FOR RE-DISPATCH %s"
+	TaskWorkerRecovered      = "TASK WORKER AVAILABLE AGAIN worker:%s"
 )
 
 type mLogModel struct {
@@ -150,7 +159,7 @@ func (journal *taskLogJournal) WriteLog(id unique.TaskOrderID, entry LogEntry) {
 	journal.store[id] = logs
 }
 
-func (journal *taskLogJournal) watch(interval int, shutdown <-chan struct{}) <-chan struct{} {
+func (journal *taskLogJournal) watch(interval time.Duration, shutdown <-chan struct{}) <-chan struct{} {
 
 	inform := make(chan struct{})
 
@@ -171,7 +180,7 @@ func (journal *taskLogJournal) watch(interval int, shutdown <-chan struct{}) <-c
 
 		for {
 			select {
-			case t := <-time.After(time.Duration(interval) * time.Second):
+			case t := <-time.After(interval):
 				{
 
 					journal.sync(interval, t)
@@ -191,12 +200,12 @@ func (journal *taskLogJournal) watch(interval int, shutdown <-chan struct{}) <-c
 
 }
 
-func (journal *taskLogJournal) sync(interval int, t time.Time) {
+func (journal *taskLogJournal) sync(interval time.Duration, t time.Time) {
 
 	journal.lock.Lock()
 
 	for _, n := range journal.store {
-		if n.Tstamp.Add(time.Duration(interval) * time.Second).Before(t) {
+		if n.Tstamp.Add(interval).Before(t) {
 
 			journal.col.Update(context.Background(), &n)
 		}
@@ -233,7 +242,7 @@ func (journal *taskLogJournal) Process(receiver events.EventReceiver, routename
 
 func (journal *taskLogJournal) Start() error {
 
-	journal.done = journal.watch(journal.conf.SyncTime, journal.shutdown)
+	journal.done = journal.watch(config.Interval(journal.conf.SyncInterval, journal.conf.SyncTime), journal.shutdown)
 
 	return nil
 }
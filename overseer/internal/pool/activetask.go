@@ -6,6 +6,7 @@ import (
 
 	"github.com/przebro/overseer/common/types"
 	"github.com/przebro/overseer/common/types/date"
+	"github.com/przebro/overseer/overseer/internal/resources"
 	"github.com/przebro/overseer/overseer/internal/taskdef"
 	"github.com/przebro/overseer/overseer/internal/unique"
 )
@@ -34,9 +35,13 @@ type taskInTicket struct {
 type taskExecution struct {
 	ExecutionID string
 	Worker      string
+	Created     time.Time
 	Start       time.Time
 	End         time.Time
 	state       TaskState
+	Ended       bool
+	ReturnCode  int32
+	StatusCode  int32
 }
 
 type taskCycle struct {
@@ -57,8 +62,15 @@ type activeTask struct {
 	tickets    []taskInTicket
 	executions []taskExecution
 	cycle      taskCycle
+	variables  types.EnvironmentVariableList
 	lock       sync.RWMutex
 	collected  []taskInTicket
+	blocked    []string
+	waiting    []string
+	ticketGen  uint64
+	cachedGen  uint64
+	cached     bool
+	priority   int
 }
 
 //ActiveDefinitionReader - reads definitions
@@ -92,11 +104,13 @@ type ActiveDefinitionReadWriterRemover interface {
 func newActiveTask(orderID unique.TaskOrderID, odate date.Odate, definition taskdef.TaskDefinition, refID unique.MsgID) *activeTask {
 
 	tickets := make([]taskInTicket, 0)
+	_, group, _ := definition.GetInfo()
 
 	for _, e := range definition.TicketsIn() {
 
-		realOdat := calcRealOdate(odate, e.Odate, definition.Calendar())
-		tickets = append(tickets, taskInTicket{odate: string(realOdat), name: e.Name, label: e.Label, fulfilled: false})
+		realOdat := calcTicketOdate(odate, e.Odate, definition.Calendar())
+		name := resources.ScopedName(taskdef.ResolveScope(e.Scope, group), e.Name)
+		tickets = append(tickets, taskInTicket{odate: realOdat, name: name, label: e.Label, fulfilled: false})
 	}
 
 	isconfirmed := !definition.Confirm()
@@ -111,11 +125,12 @@ func newActiveTask(orderID unique.TaskOrderID, odate date.Odate, definition task
 		TaskDefinition: definition,
 		reference:      refID.Hex(),
 		orderDate:      odate,
-		executions:     []taskExecution{{ExecutionID: unique.NewID().Hex(), state: TaskStateWaiting}},
+		executions:     []taskExecution{{ExecutionID: unique.NewID().Hex(), Created: time.Now(), state: TaskStateWaiting}},
 		tickets:        tickets,
 		cycle:          cycle,
 		lock:           sync.RWMutex{},
 		confirmed:      isconfirmed,
+		priority:       definition.Priority(),
 	}
 
 	return task
@@ -138,7 +153,9 @@ func fromModel(model activeTaskModel, rdr ActiveDefinitionReader) (*activeTask,
 
 	execs := []taskExecution{}
 	for _, n := range model.Executions {
-		execs = append(execs, taskExecution{ExecutionID: n.ID, Start: n.StartTime, End: n.EndTime, Worker: n.Worker, state: n.State})
+		execs = append(execs, taskExecution{ExecutionID: n.ID, Created: n.Created, Start: n.StartTime, End: n.EndTime, Worker: n.Worker, state: n.State,
+			Ended: n.Ended, ReturnCode: n.ReturnCode, StatusCode: n.StatusCode,
+		})
 	}
 
 	cycle := taskCycle{IsCyclic: model.Cycle.IsCyclic,
@@ -158,7 +175,9 @@ func fromModel(model activeTaskModel, rdr ActiveDefinitionReader) (*activeTask,
 		confirmed:      model.Confirmed,
 		holded:         model.Holded,
 		cycle:          cycle,
+		variables:      model.Variables,
 		collected:      []taskInTicket{},
+		priority:       model.Priority,
 	}
 
 	return task, nil
@@ -180,7 +199,21 @@ func (task *activeTask) SetExecutionID() {
 	defer task.lock.Unlock()
 	task.lock.Lock()
 	id := unique.NewID().Hex()
-	task.executions = append(task.executions, taskExecution{ExecutionID: id, state: TaskStateWaiting})
+	task.executions = append(task.executions, taskExecution{ExecutionID: id, Created: time.Now(), state: TaskStateWaiting})
+}
+
+//Priority - returns a priority of a task, it can be overridden when the task is ordered
+func (task *activeTask) Priority() int {
+	defer task.lock.RUnlock()
+	task.lock.RLock()
+	return task.priority
+}
+
+//WaitingSince - returns a time when the current execution of a task was created
+func (task *activeTask) WaitingSince() time.Time {
+	defer task.lock.RUnlock()
+	task.lock.RLock()
+	return task.executions[len(task.executions)-1].Created
 }
 
 func (task *activeTask) Tickets() []taskInTicket {
@@ -266,6 +299,23 @@ func (task *activeTask) EndTime() time.Time {
 	return task.executions[len(task.executions)-1].End
 }
 
+//SetResult - stores a return code and a status code reported by a worker for the current execution
+func (task *activeTask) SetResult(rc, sc int32) {
+	defer task.lock.Unlock()
+	task.lock.Lock()
+	task.executions[len(task.executions)-1].Ended = true
+	task.executions[len(task.executions)-1].ReturnCode = rc
+	task.executions[len(task.executions)-1].StatusCode = sc
+}
+
+//Result - returns a return code and a status code of the current execution, ended is false if the task did not report them
+func (task *activeTask) Result() (rc, sc int32, ended bool) {
+	defer task.lock.RUnlock()
+	task.lock.RLock()
+	exec := task.executions[len(task.executions)-1]
+	return exec.ReturnCode, exec.StatusCode, exec.Ended
+}
+
 func (task *activeTask) SetWorkerName(name string) {
 	defer task.lock.Unlock()
 	task.lock.Lock()
@@ -302,6 +352,92 @@ func (task *activeTask) IsCyclic() bool {
 	return task.cycle.IsCyclic
 }
 
+//Variables - returns variables of a definition overridden and extended by variables given at order time
+func (task *activeTask) Variables() types.EnvironmentVariableList {
+	defer task.lock.RUnlock()
+	task.lock.RLock()
+	return task.TaskDefinition.Variables().Merge(task.variables)
+}
+
+//OrderVariables - returns variables given at order time
+func (task *activeTask) OrderVariables() types.EnvironmentVariableList {
+	defer task.lock.RUnlock()
+	task.lock.RLock()
+	return task.variables
+}
+
+//ScopedFlags - returns flags required by a task with names qualified with their namespaces
+func (task *activeTask) ScopedFlags() []taskdef.FlagData {
+
+	_, group, _ := task.GetInfo()
+	flags := make([]taskdef.FlagData, 0, len(task.Flags()))
+
+	for _, f := range task.Flags() {
+		flags = append(flags, taskdef.FlagData{Name: resources.ScopedName(taskdef.ResolveScope(f.Scope, group), f.Name), Type: f.Type})
+	}
+
+	return flags
+}
+
+//NotifyTickets - informs a task that tickets it waits for were changed, cached tickets are no longer valid
+func (task *activeTask) NotifyTickets() {
+	defer task.lock.Unlock()
+	task.lock.Lock()
+	task.ticketGen++
+}
+
+//TicketGeneration - returns the number of notifications about changed tickets received by a task
+func (task *activeTask) TicketGeneration() uint64 {
+	defer task.lock.RUnlock()
+	task.lock.RLock()
+	return task.ticketGen
+}
+
+//CacheTickets - stores collected tickets, they are valid until the next notification after a given generation
+func (task *activeTask) CacheTickets(collected []taskInTicket, gen uint64) {
+	defer task.lock.Unlock()
+	task.lock.Lock()
+	task.collected = collected
+	task.cachedGen = gen
+	task.cached = true
+}
+
+//CachedTickets - returns collected tickets if they were not changed since they were checked
+func (task *activeTask) CachedTickets() ([]taskInTicket, bool) {
+	defer task.lock.RUnlock()
+	task.lock.RLock()
+	return task.collected, task.cached && task.cachedGen == task.ticketGen
+}
+
+//InvalidateTickets - forces a task to check tickets again
+func (task *activeTask) InvalidateTickets() {
+	defer task.lock.Unlock()
+	task.lock.Lock()
+	task.cached = false
+}
+
+//SetWaiting - sets names of resources that block a task and descriptions why they are not available
+func (task *activeTask) SetWaiting(blocked []string, reasons []string) {
+	defer task.lock.Unlock()
+	task.lock.Lock()
+	task.blocked = blocked
+	task.waiting = reasons
+}
+
+//Blocked - returns names of resources that block a task
+func (task *activeTask) Blocked() []string {
+	defer task.lock.RUnlock()
+	task.lock.RLock()
+	return task.blocked
+}
+
+//Waiting - returns descriptions why a task waits for resources
+func (task *activeTask) Waiting() []string {
+	defer task.lock.RUnlock()
+	task.lock.RLock()
+	return task.waiting
+}
+
 func (task *activeTask) CycleData() taskCycle {
 	defer task.lock.RUnlock()
 	task.lock.RLock()
@@ -369,13 +505,17 @@ func (task *activeTask) getModel() activeTaskModel {
 		Tickets:    []taskInTicketModel{},
 		Executions: []taskExecutionModel{},
 		Cycle:      cycle,
+		Variables:  task.variables,
+		Priority:   task.priority,
 	}
 	for _, n := range task.tickets {
 		t.Tickets = append(t.Tickets, taskInTicketModel{Name: n.name, Odate: n.odate, Fulfilled: n.fulfilled})
 	}
 
 	for _, n := range task.executions {
-		t.Executions = append(t.Executions, taskExecutionModel{ID: n.ExecutionID, Worker: n.Worker, StartTime: n.Start, EndTime: n.End, State: n.state})
+		t.Executions = append(t.Executions, taskExecutionModel{ID: n.ExecutionID, Worker: n.Worker, Created: n.Created, StartTime: n.Start, EndTime: n.End, State: n.state,
+			Ended: n.Ended, ReturnCode: n.ReturnCode, StatusCode: n.StatusCode,
+		})
 	}
 
 	return t
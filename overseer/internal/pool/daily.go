@@ -1,35 +1,106 @@
 package pool
 
 import (
+	"context"
+	"errors"
+	"sync"
 	"time"
 
 	"github.com/przebro/overseer/common/logger"
 	"github.com/przebro/overseer/common/types/date"
+	"github.com/przebro/overseer/datastore"
+	"github.com/przebro/overseer/overseer/config"
 	"github.com/przebro/overseer/overseer/internal/events"
+
+	"github.com/przebro/databazaar/collection"
 )
 
+const dailyProcedureKey = "daily_procedure"
+const dailyProcedureUser = "daily procedure"
+const catchUpProcedureUser = "catch-up procedure"
+
+//maxDailyAuditEntries - number of the most recent procedure executions kept in the audit trail
+const maxDailyAuditEntries = 64
+
+//ErrFutureOdate - occurs when the new day procedure is requested for an ODATE from the future
+var ErrFutureOdate = errors.New("unable to run new day procedure for a future odate")
+
+//dailyAuditEntry - represents a single execution of the new day procedure
+type dailyAuditEntry struct {
+	Time     time.Time  `json:"time" bson:"time"`
+	Odate    date.Odate `json:"odate" bson:"odate"`
+	Username string     `json:"user" bson:"user"`
+	Deleted  int        `json:"deleted" bson:"deleted"`
+	Ordered  int        `json:"ordered" bson:"ordered"`
+	Purged   int        `json:"purged" bson:"purged"`
+}
+
+//ProcedureResult - summary of the new day procedure
+type ProcedureResult struct {
+	Deleted int
+	Ordered int
+	Purged  int
+}
+
+//TicketPurger - removes expired tickets
+type TicketPurger interface {
+	PurgeTickets(odate date.Odate) int
+}
+
+type dailyModel struct {
+	Key   string            `json:"_id" bson:"_id"`
+	Odate date.Odate        `json:"odate" bson:"odate"`
+	Audit []dailyAuditEntry `json:"audit" bson:"audit"`
+}
+
 //DailyExecutor - Executes New Day Procedure
 type DailyExecutor struct {
 	pool              *ActiveTaskPool
 	manager           *ActiveTaskPoolManager
+	purger            TicketPurger
 	log               logger.AppLogger
 	lastExecutionDate date.Odate
+	col               collection.DataCollection
+	stored            bool
+	audit             []dailyAuditEntry
+	lock              sync.Mutex
 }
 
-//NewDailyExecutor - Creates new DailyExecutor
-func NewDailyExecutor(dispatcher events.Dispatcher, manager *ActiveTaskPoolManager, pool *ActiveTaskPool, log logger.AppLogger) *DailyExecutor {
+//NewDailyExecutor - Creates new DailyExecutor, the last executed ODATE is restored from the datastore
+func NewDailyExecutor(dispatcher events.Dispatcher, manager *ActiveTaskPoolManager, pool *ActiveTaskPool, purger TicketPurger, provider *datastore.Provider, log logger.AppLogger) (*DailyExecutor, error) {
+
+	var col collection.DataCollection
+	var err error
+
+	if col, err = provider.GetCollection("sequence"); err != nil {
+		return nil, err
+	}
+
+	daily := &DailyExecutor{pool: pool, manager: manager, purger: purger, log: log, lastExecutionDate: date.CurrentOdate(), col: col, audit: []dailyAuditEntry{}}
+
+	model := dailyModel{}
+	if err = col.Get(context.Background(), dailyProcedureKey, &model); err == nil {
+		daily.stored = true
+		daily.lastExecutionDate = model.Odate
+		if model.Audit != nil {
+			daily.audit = model.Audit
+		}
+		log.Info("Last new day procedure executed for odate:", model.Odate)
+	}
 
-	daily := &DailyExecutor{pool: pool, manager: manager, log: log, lastExecutionDate: date.CurrentOdate()}
 	if dispatcher != nil {
-		dispatcher.Subscribe(events.RouteTimeOut, daily)
+		dispatcher.Subscribe(events.RouteDailyCheck, daily)
 	}
 
-	return daily
+	return daily, nil
 }
 
 //CheckDailyProcedure - Check if it is time to start daily procedure
 func (exec *DailyExecutor) CheckDailyProcedure(tm time.Time) bool {
 
+	exec.lock.Lock()
+	defer exec.lock.Unlock()
+
 	h, m := exec.pool.config.NewDayProc.AsTime()
 	y, mth, d := date.CurrentOdate().Ymd()
 
@@ -39,25 +110,123 @@ func (exec *DailyExecutor) CheckDailyProcedure(tm time.Time) bool {
 }
 
 //DailyProcedure - Cleanups and place new tasks in the Active Pool
-func (exec *DailyExecutor) DailyProcedure() (int, int) {
+func (exec *DailyExecutor) DailyProcedure() ProcedureResult {
+
+	return exec.execute(date.CurrentOdate(), dailyProcedureUser)
+}
+
+//RunProcedure - Runs the new day procedure for a given ODATE on demand
+func (exec *DailyExecutor) RunProcedure(odate date.Odate, username string) (ProcedureResult, error) {
+
+	if date.IsBeforeCurrent(date.CurrentOdate(), odate) {
+		return ProcedureResult{}, ErrFutureOdate
+	}
+
+	return exec.execute(odate, username), nil
+}
+
+//CatchUp - Runs the new day procedure for ODATEs that were missed since the last execution,
+//missed ODATEs are processed according to the catch-up policy
+func (exec *DailyExecutor) CatchUp() []date.Odate {
+
+	exec.lock.Lock()
+	missed := missedOdates(exec.lastExecutionDate, date.CurrentOdate())
+	exec.lock.Unlock()
+
+	if len(missed) == 0 {
+		return missed
+	}
+
+	switch exec.pool.config.CatchUpPolicy {
+	case config.CatchUpPolicyAll:
+		//each missed odate is processed
+	case config.CatchUpPolicyLatest:
+		{
+			missed = missed[len(missed)-1:]
+		}
+	default:
+		{
+			exec.log.Info("Catch-up policy:", exec.pool.config.CatchUpPolicy, " skipping missed odates:", missed)
+			return []date.Odate{}
+		}
+	}
+
+	for _, odate := range missed {
+		exec.log.Info("Catch-up of a missed new day procedure, odate:", odate)
+		exec.execute(odate, catchUpProcedureUser)
+	}
+
+	return missed
+}
 
-	exec.log.Info("Starting new day procedure")
-	exec.lastExecutionDate = date.CurrentOdate()
+func (exec *DailyExecutor) execute(odate date.Odate, username string) ProcedureResult {
+
+	exec.lock.Lock()
+	defer exec.lock.Unlock()
+
+	exec.log.Info("Starting new day procedure, odate:", odate, " user:", username)
 
 	deleted := exec.pool.cleanupCompletedTasks()
-	ordered := exec.manager.orderNewTasks()
+	ordered := exec.manager.orderNewTasks(odate, username)
+
+	purged := 0
+	if exec.purger != nil {
+		purged = exec.purger.PurgeTickets(odate)
+	}
+
+	if date.IsBeforeCurrent(exec.lastExecutionDate, odate) {
+		exec.lastExecutionDate = odate
+	}
+
+	exec.audit = append(exec.audit, dailyAuditEntry{Time: time.Now(), Odate: odate, Username: username, Deleted: deleted, Ordered: ordered, Purged: purged})
+	if len(exec.audit) > maxDailyAuditEntries {
+		exec.audit = exec.audit[len(exec.audit)-maxDailyAuditEntries:]
+	}
 
-	return deleted, ordered
+	if err := exec.write(); err != nil {
+		exec.log.Error("unable to store new day procedure state:", err)
+	}
+
+	exec.log.Info("New day procedure completed, odate:", odate, " deleted:", deleted, " ordered:", ordered, " purged tickets:", purged)
+
+	return ProcedureResult{Deleted: deleted, Ordered: ordered, Purged: purged}
+}
+
+func (exec *DailyExecutor) write() error {
+
+	var err error
+	model := dailyModel{Key: dailyProcedureKey, Odate: exec.lastExecutionDate, Audit: exec.audit}
+
+	if exec.stored {
+		return exec.col.Update(context.Background(), &model)
+	}
+
+	if _, err = exec.col.Create(context.Background(), &model); err == nil {
+		exec.stored = true
+	}
+
+	return err
+}
+
+//missedOdates - returns ODATEs between the last execution and the current ODATE, both exclusive
+func missedOdates(last, current date.Odate) []date.Odate {
+
+	result := []date.Odate{}
+
+	for odate := date.AddDays(last, 1); date.IsBeforeCurrent(odate, current); odate = date.AddDays(odate, 1) {
+		result = append(result, odate)
+	}
 
+	return result
 }
 
 //Process - receive notification from dispatcher
 func (exec *DailyExecutor) Process(receiver events.EventReceiver, routename events.RouteName, msg events.DispatchedMessage) {
 
 	switch routename {
-	case events.RouteTimeOut:
+	case events.RouteTimeOut, events.RouteDailyCheck:
 		{
-			exec.log.Debug("task action message, route:", events.RouteTimeOut, "id:", msg.MsgID())
+			exec.log.Debug("task action message, route:", routename, "id:", msg.MsgID())
 
 			msgdata, istype := msg.Message().(events.RouteTimeOutMsgFormat)
 			if !istype {
@@ -9,6 +9,7 @@ import (
 	"github.com/przebro/overseer/common/logger"
 	"github.com/przebro/overseer/common/types"
 	"github.com/przebro/overseer/common/types/date"
+	"github.com/przebro/overseer/overseer/config"
 	"github.com/przebro/overseer/overseer/internal/events"
 )
 
@@ -24,7 +25,7 @@ func init() {
 func TestProcessDaily(t *testing.T) {
 	var rcverr error
 
-	daily = NewDailyExecutor(mDispatcher, activeTaskManagerT, taskPoolT, log)
+	daily, _ = NewDailyExecutor(mDispatcher, activeTaskManagerT, taskPoolT, nil, provider, log)
 	if daily == nil {
 		t.Error("Daily executor not initialized")
 	}
@@ -60,7 +61,7 @@ func TestProcessDaily(t *testing.T) {
 
 func TestCheckDailyProcedure(t *testing.T) {
 
-	daily = NewDailyExecutor(mDispatcher, activeTaskManagerT, taskPoolT, log)
+	daily, _ = NewDailyExecutor(mDispatcher, activeTaskManagerT, taskPoolT, nil, provider, log)
 	if daily == nil {
 		t.Error("Daily executor not initialized")
 	}
@@ -92,15 +93,130 @@ func TestCheckDailyProcedure(t *testing.T) {
 
 func TestDailyProc(t *testing.T) {
 
-	daily = NewDailyExecutor(mDispatcher, activeTaskManagerT, taskPoolT, log)
+	daily, _ = NewDailyExecutor(mDispatcher, activeTaskManagerT, taskPoolT, nil, provider, log)
 	if daily == nil {
 		t.Error("Daily executor not initialized")
 	}
 
-	del, ord := daily.DailyProcedure()
+	result := daily.DailyProcedure()
+	del, ord := result.Deleted, result.Ordered
 	if del != 0 || ord != 0 {
 		//t.Error("Unexpected values")
 		fmt.Println("potential error")
 	}
 
 }
+
+func TestMissedOdates(t *testing.T) {
+
+	current := date.CurrentOdate()
+
+	if result := missedOdates(current, current); len(result) != 0 {
+		t.Error("Unexpected value:", result)
+	}
+
+	if result := missedOdates(date.AddDays(current, -1), current); len(result) != 0 {
+		t.Error("Unexpected value:", result)
+	}
+
+	result := missedOdates(date.AddDays(current, -4), current)
+	if len(result) != 3 {
+		t.Fatal("Unexpected value:", result)
+	}
+
+	if result[0] != date.AddDays(current, -3) || result[2] != date.AddDays(current, -1) {
+		t.Error("Unexpected value:", result)
+	}
+}
+
+func TestRunProcedure(t *testing.T) {
+
+	daily, _ = NewDailyExecutor(mDispatcher, activeTaskManagerT, taskPoolT, nil, provider, log)
+	if daily == nil {
+		t.Fatal("Daily executor not initialized")
+	}
+
+	current := date.CurrentOdate()
+
+	if _, err := daily.RunProcedure(date.AddDays(current, 1), "testuser"); err != ErrFutureOdate {
+		t.Error("Unexpected result:", err, "expected:", ErrFutureOdate)
+	}
+
+	if _, err := daily.RunProcedure(date.AddDays(current, -1), "testuser"); err != nil {
+		t.Error("Unexpected result:", err)
+	}
+
+	entry := daily.audit[len(daily.audit)-1]
+	if entry.Username != "testuser" || entry.Odate != date.AddDays(current, -1) {
+		t.Error("Unexpected audit entry:", entry)
+	}
+}
+
+type mockPurger struct {
+	odate date.Odate
+}
+
+func (m *mockPurger) PurgeTickets(odate date.Odate) int {
+	m.odate = odate
+	return 2
+}
+
+func TestRunProcedure_Purge(t *testing.T) {
+
+	purger := &mockPurger{}
+	daily, _ = NewDailyExecutor(mDispatcher, activeTaskManagerT, taskPoolT, purger, provider, log)
+	if daily == nil {
+		t.Fatal("Daily executor not initialized")
+	}
+
+	current := date.AddDays(date.CurrentOdate(), -1)
+
+	result, err := daily.RunProcedure(current, "testuser")
+	if err != nil {
+		t.Fatal("Unexpected result:", err)
+	}
+
+	if result.Purged != 2 || purger.odate != current {
+		t.Error("Unexpected result:", result, purger.odate)
+	}
+
+	if entry := daily.audit[len(daily.audit)-1]; entry.Purged != 2 {
+		t.Error("Unexpected audit entry:", entry)
+	}
+}
+
+func TestCatchUp(t *testing.T) {
+
+	daily, _ = NewDailyExecutor(mDispatcher, activeTaskManagerT, taskPoolT, nil, provider, log)
+	if daily == nil {
+		t.Fatal("Daily executor not initialized")
+	}
+
+	defer func() { taskPoolT.config.CatchUpPolicy = "" }()
+	current := date.CurrentOdate()
+
+	taskPoolT.config.CatchUpPolicy = config.CatchUpPolicyNone
+	daily.lastExecutionDate = date.AddDays(current, -3)
+
+	if result := daily.CatchUp(); len(result) != 0 {
+		t.Error("Unexpected value:", result)
+	}
+
+	taskPoolT.config.CatchUpPolicy = config.CatchUpPolicyLatest
+
+	result := daily.CatchUp()
+	if len(result) != 1 || result[0] != date.AddDays(current, -1) {
+		t.Error("Unexpected value:", result)
+	}
+
+	if daily.lastExecutionDate != date.AddDays(current, -1) {
+		t.Error("Unexpected value:", daily.lastExecutionDate)
+	}
+
+	taskPoolT.config.CatchUpPolicy = config.CatchUpPolicyAll
+	daily.lastExecutionDate = date.AddDays(current, -3)
+
+	if result = daily.CatchUp(); len(result) != 2 {
+		t.Error("Unexpected value:", result)
+	}
+}
@@ -0,0 +1,208 @@
+package pool
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/przebro/overseer/common/types"
+	"github.com/przebro/overseer/overseer/config"
+	"github.com/przebro/overseer/overseer/internal/unique"
+)
+
+//LimitKind - describes what tasks are restricted by a concurrency limit
+type LimitKind string
+
+const (
+	//LimitGlobal - restricts all tasks
+	LimitGlobal LimitKind = "global"
+	//LimitGroup - restricts tasks from a group
+	LimitGroup LimitKind = "group"
+	//LimitType - restricts tasks of a type
+	LimitType LimitKind = "type"
+)
+
+var (
+	//ErrInvalidLimitKind - a kind of a limit is not global, group or type
+	ErrInvalidLimitKind = errors.New("invalid limit kind, expected: global, group or type")
+	//ErrInvalidLimit - a limit is negative or a name of a group or a type is missing
+	ErrInvalidLimit = errors.New("invalid limit, a limit can't be negative and a group or a type requires a name")
+)
+
+//ConcurrencyLimit - a maximum number of tasks executed at the same time and a number of currently executed tasks
+type ConcurrencyLimit struct {
+	Kind    LimitKind
+	Name    string
+	Limit   int
+	Running int
+}
+
+//ConcurrencyLimits - manages limits of tasks executed at the same time, zero means no limit
+type ConcurrencyLimits interface {
+	SetLimit(kind LimitKind, name string, limit int) error
+	Limits() []ConcurrencyLimit
+}
+
+type runningTask struct {
+	group string
+	ttype types.TaskType
+}
+
+//concurrencyLimiter - counts executed tasks, a task is counted from the moment it is sent to a worker until it ends
+type concurrencyLimiter struct {
+	lock    sync.Mutex
+	global  int
+	groups  map[string]int
+	types   map[string]int
+	running map[unique.TaskOrderID]runningTask
+}
+
+func newConcurrencyLimiter(conf config.ConcurrencyLimitsConfiguration) *concurrencyLimiter {
+
+	l := &concurrencyLimiter{
+		global:  conf.Global,
+		groups:  map[string]int{},
+		types:   map[string]int{},
+		running: map[unique.TaskOrderID]runningTask{},
+	}
+
+	for k, v := range conf.Groups {
+		if v > 0 {
+			l.groups[k] = v
+		}
+	}
+
+	for k, v := range conf.Types {
+		if v > 0 {
+			l.types[k] = v
+		}
+	}
+
+	return l
+}
+
+//acquire - counts a task as executed if no limit is reached, otherwise returns a reason why the task has to wait
+func (l *concurrencyLimiter) acquire(orderID unique.TaskOrderID, group string, ttype types.TaskType) (bool, string) {
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if _, exists := l.running[orderID]; exists {
+		return true, ""
+	}
+
+	if l.global > 0 && len(l.running) >= l.global {
+		return false, fmt.Sprintf("waiting for a concurrency limit, %d of %d tasks are executed", len(l.running), l.global)
+	}
+
+	ingroup, intype := l.count(group, ttype)
+
+	if limit, ok := l.groups[group]; ok && ingroup >= limit {
+		return false, fmt.Sprintf("waiting for a concurrency limit of group %s, %d of %d tasks are executed", group, ingroup, limit)
+	}
+
+	if limit, ok := l.types[string(ttype)]; ok && intype >= limit {
+		return false, fmt.Sprintf("waiting for a concurrency limit of type %s, %d of %d tasks are executed", ttype, intype, limit)
+	}
+
+	l.running[orderID] = runningTask{group: group, ttype: ttype}
+
+	return true, ""
+}
+
+//release - stops counting a task
+func (l *concurrencyLimiter) release(orderID unique.TaskOrderID) {
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	delete(l.running, orderID)
+}
+
+//reset - replaces counted tasks with tasks that are actually executed
+func (l *concurrencyLimiter) reset(running map[unique.TaskOrderID]runningTask) {
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.running = running
+}
+
+func (l *concurrencyLimiter) count(group string, ttype types.TaskType) (int, int) {
+
+	ingroup, intype := 0, 0
+	for _, t := range l.running {
+		if t.group == group {
+			ingroup++
+		}
+		if t.ttype == ttype {
+			intype++
+		}
+	}
+
+	return ingroup, intype
+}
+
+//SetLimit - sets a limit, zero removes the limit
+func (l *concurrencyLimiter) SetLimit(kind LimitKind, name string, limit int) error {
+
+	if limit < 0 {
+		return ErrInvalidLimit
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	var limits map[string]int
+
+	switch kind {
+	case LimitGlobal:
+		l.global = limit
+		return nil
+	case LimitGroup:
+		limits = l.groups
+	case LimitType:
+		limits = l.types
+	default:
+		return ErrInvalidLimitKind
+	}
+
+	if name == "" {
+		return ErrInvalidLimit
+	}
+
+	if limit == 0 {
+		delete(limits, name)
+	} else {
+		limits[name] = limit
+	}
+
+	return nil
+}
+
+//Limits - returns limits along with a number of tasks restricted by them
+func (l *concurrencyLimiter) Limits() []ConcurrencyLimit {
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	result := []ConcurrencyLimit{{Kind: LimitGlobal, Limit: l.global, Running: len(l.running)}}
+
+	groups := []ConcurrencyLimit{}
+	for name, limit := range l.groups {
+		ingroup, _ := l.count(name, "")
+		groups = append(groups, ConcurrencyLimit{Kind: LimitGroup, Name: name, Limit: limit, Running: ingroup})
+	}
+
+	ltypes := []ConcurrencyLimit{}
+	for name, limit := range l.types {
+		_, intype := l.count("", types.TaskType(name))
+		ltypes = append(ltypes, ConcurrencyLimit{Kind: LimitType, Name: name, Limit: limit, Running: intype})
+	}
+
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+	sort.Slice(ltypes, func(i, j int) bool { return ltypes[i].Name < ltypes[j].Name })
+
+	return append(append(result, groups...), ltypes...)
+}
@@ -0,0 +1,154 @@
+package pool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/przebro/overseer/common/types"
+	"github.com/przebro/overseer/common/types/date"
+	"github.com/przebro/overseer/overseer/config"
+	"github.com/przebro/overseer/overseer/internal/taskdef"
+	"github.com/przebro/overseer/overseer/internal/unique"
+)
+
+func TestConcurrencyLimiter(t *testing.T) {
+
+	l := newConcurrencyLimiter(config.ConcurrencyLimitsConfiguration{
+		Global: 3,
+		Groups: map[string]int{"billing": 1},
+		Types:  map[string]int{"aws": 2, "os": 0},
+	})
+
+	if ok, _ := l.acquire("00001", "billing", types.TypeOs); !ok {
+		t.Error("expected task to start")
+	}
+
+	if ok, _ := l.acquire("00001", "billing", types.TypeOs); !ok {
+		t.Error("expected task to start, it is already counted")
+	}
+
+	if ok, reason := l.acquire("00002", "billing", types.TypeDummy); ok || reason == "" {
+		t.Error("expected group limit, actual:", ok, reason)
+	}
+
+	if ok, _ := l.acquire("00003", "test", types.TypeAws); !ok {
+		t.Error("expected task to start")
+	}
+
+	if ok, reason := l.acquire("00004", "test", types.TypeAws); !ok {
+		t.Error("expected task to start", reason)
+	}
+
+	if ok, reason := l.acquire("00005", "test", types.TypeDummy); ok || reason == "" {
+		t.Error("expected global limit, actual:", ok, reason)
+	}
+
+	l.release("00004")
+	if ok, reason := l.acquire("00005", "test", types.TypeDummy); !ok {
+		t.Error("expected task to start", reason)
+	}
+
+	if err := l.SetLimit(LimitGlobal, "", 0); err != nil {
+		t.Error("unexpected error:", err)
+	}
+
+	if ok, reason := l.acquire("00006", "test", types.TypeAws); !ok {
+		t.Error("expected task to start", reason)
+	}
+
+	if ok, reason := l.acquire("00007", "test", types.TypeAws); ok || reason == "" {
+		t.Error("expected type limit, actual:", ok, reason)
+	}
+
+	l.reset(map[unique.TaskOrderID]runningTask{})
+	if ok, reason := l.acquire("00002", "billing", types.TypeDummy); !ok {
+		t.Error("expected task to start", reason)
+	}
+}
+
+func TestConcurrencyLimiter_SetLimit(t *testing.T) {
+
+	l := newConcurrencyLimiter(config.ConcurrencyLimitsConfiguration{})
+
+	if err := l.SetLimit("worker", "test", 1); err != ErrInvalidLimitKind {
+		t.Error("unexpected result:", err)
+	}
+
+	if err := l.SetLimit(LimitGroup, "", 1); err != ErrInvalidLimit {
+		t.Error("unexpected result:", err)
+	}
+
+	if err := l.SetLimit(LimitType, "os", -1); err != ErrInvalidLimit {
+		t.Error("unexpected result:", err)
+	}
+
+	l.SetLimit(LimitGroup, "billing", 3)
+	l.SetLimit(LimitType, "aws", 5)
+	l.SetLimit(LimitGlobal, "", 20)
+	l.acquire("00001", "billing", types.TypeAws)
+
+	expected := []ConcurrencyLimit{
+		{Kind: LimitGlobal, Limit: 20, Running: 1},
+		{Kind: LimitGroup, Name: "billing", Limit: 3, Running: 1},
+		{Kind: LimitType, Name: "aws", Limit: 5, Running: 1},
+	}
+
+	limits := l.Limits()
+	if len(limits) != len(expected) {
+		t.Fatal("unexpected result:", limits)
+	}
+
+	for i := range expected {
+		if limits[i] != expected[i] {
+			t.Error("unexpected result:", limits[i], "expected:", expected[i])
+		}
+	}
+
+	l.SetLimit(LimitGroup, "billing", 0)
+	if limits = l.Limits(); len(limits) != 2 {
+		t.Error("unexpected result:", limits)
+	}
+}
+
+func TestStateStarting_Limit(t *testing.T) {
+
+	builder := taskdef.DummyTaskBuilder{}
+	definition, err := builder.WithBase("billing", "dummy_limit", "test task").
+		WithSchedule(taskdef.SchedulingData{OrderType: taskdef.OrderingManual}).
+		Build()
+
+	if err != nil {
+		t.Fatal("Unable to construct task")
+	}
+
+	limiter := newConcurrencyLimiter(config.ConcurrencyLimitsConfiguration{Groups: map[string]int{"billing": 1}})
+	limiter.acquire("00001", "billing", types.TypeDummy)
+
+	ctx := TaskExecutionContext{
+		log:        log,
+		odate:      date.CurrentOdate(),
+		dispatcher: mDispatcher,
+		time:       time.Now(),
+		limiter:    limiter,
+	}
+
+	ctx.task = newActiveTask(seq.Next(), date.CurrentOdate(), definition, unique.NewID())
+
+	state := &ostateStarting{}
+	if result := state.processState(&ctx); result != false {
+		t.Error("expected result: ", false, " actual:", result)
+	}
+
+	if ctx.task.State() != TaskStateWaiting || len(ctx.task.Waiting()) != 1 {
+		t.Error("unexpected result:", ctx.task.State(), ctx.task.Waiting())
+	}
+
+	limiter.release("00001")
+	if result := state.processState(&ctx); result != true {
+		t.Error("expected result: ", true, " actual:", result)
+	}
+
+	if limits := limiter.Limits(); limits[0].Running != 1 {
+		t.Error("unexpected result:", limits)
+	}
+}
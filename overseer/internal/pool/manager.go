@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/przebro/overseer/common/logger"
+	"github.com/przebro/overseer/common/types"
 	"github.com/przebro/overseer/common/types/date"
 	"github.com/przebro/overseer/datastore"
 	"github.com/przebro/overseer/overseer/internal/events"
@@ -25,6 +26,8 @@ var (
 	ErrUnableFindGroup = errors.New("unable to find group")
 	//ErrUnableFindTask 0 task was not found
 	ErrUnableFindTask = errors.New("unable to find task")
+	//ErrReconcileUnavailable - resources cannot be reconciled because the pool is not connected to a dispatcher
+	ErrReconcileUnavailable = errors.New("reconciliation is not available")
 )
 
 //ActiveTaskPoolManager - Manages tasks in Active task pool
@@ -60,8 +63,10 @@ func NewActiveTaskPoolManager(dispatcher events.Dispatcher,
 	return manager, nil
 }
 
-//Order - Orders a new task, this method checks if all precoditions are met before it adds a new task
-func (manager *ActiveTaskPoolManager) Order(task taskdata.GroupNameData, odate date.Odate, username string) (string, error) {
+//Order - Orders a new task, this method checks if all precoditions are met before it adds a new task.
+//Variables override or extend variables from the task definition for this order only, a priority greater than zero
+//overrides a priority from the task definition.
+func (manager *ActiveTaskPoolManager) Order(task taskdata.GroupNameData, odate date.Odate, username string, variables types.EnvironmentVariableList, priority int) (string, error) {
 
 	var err error
 	var definition taskdef.TaskDefinition
@@ -69,7 +74,7 @@ func (manager *ActiveTaskPoolManager) Order(task taskdata.GroupNameData, odate d
 		return "", ErrUnableFindDef
 	}
 
-	orderID, descr := manager.orderDefinition(definition, odate, false, username)
+	orderID, descr := manager.orderDefinition(definition, odate, false, username, variables, priority)
 	if descr != "" {
 		return descr, errors.New("failed order task")
 	}
@@ -96,7 +101,7 @@ func (manager *ActiveTaskPoolManager) OrderGroup(groupdata taskdata.GroupData, o
 			continue
 		}
 
-		orderID, descr := manager.orderDefinition(definition, odate, false, username)
+		orderID, descr := manager.orderDefinition(definition, odate, false, username, nil, 0)
 		if descr != "" {
 			result = append(result, descr)
 			continue
@@ -123,7 +128,7 @@ func (manager *ActiveTaskPoolManager) ForceGroup(groupdata taskdata.GroupData, o
 
 		definition, _ = manager.tdm.GetTask(d)
 
-		orderID, descr := manager.orderDefinition(definition, odate, true, username)
+		orderID, descr := manager.orderDefinition(definition, odate, true, username, nil, 0)
 		if descr != "" {
 			result = append(result, descr)
 			continue
@@ -134,8 +139,10 @@ func (manager *ActiveTaskPoolManager) ForceGroup(groupdata taskdata.GroupData, o
 	return result, nil
 }
 
-//Force - forcefully orders a new task, this method does not check for precondtions
-func (manager *ActiveTaskPoolManager) Force(task taskdata.GroupNameData, odate date.Odate, username string) (string, error) {
+//Force - forcefully orders a new task, this method does not check for precondtions.
+//Variables override or extend variables from the task definition for this order only, a priority greater than zero
+//overrides a priority from the task definition.
+func (manager *ActiveTaskPoolManager) Force(task taskdata.GroupNameData, odate date.Odate, username string, variables types.EnvironmentVariableList, priority int) (string, error) {
 
 	var err error
 	var definition taskdef.TaskDefinition
@@ -143,7 +150,7 @@ func (manager *ActiveTaskPoolManager) Force(task taskdata.GroupNameData, odate d
 		return "", ErrUnableFindDef
 	}
 
-	orderID, descr := manager.orderDefinition(definition, odate, true, username)
+	orderID, descr := manager.orderDefinition(definition, odate, true, username, variables, priority)
 	if descr != "" {
 		return descr, errors.New("failed force task")
 	}
@@ -264,10 +271,10 @@ func (manager *ActiveTaskPoolManager) Confirm(id unique.TaskOrderID, username st
 	return fmt.Sprintf("confirm task:%s ok", id), nil
 }
 
-func (manager *ActiveTaskPoolManager) orderNewTasks() int {
+func (manager *ActiveTaskPoolManager) orderNewTasks(odate date.Odate, username string) int {
 
 	ordered := 0
-	manager.log.Info("Ordering new tasks")
+	manager.log.Info("Ordering new tasks, odate:", odate)
 
 	groups, _ := manager.tdm.GetGroups()
 
@@ -279,7 +286,7 @@ func (manager *ActiveTaskPoolManager) orderNewTasks() int {
 
 		//It is a new day procedure so skip tasks that are ordered manually
 		if t.OrderType() != taskdef.OrderingManual {
-			manager.orderDefinition(t, date.CurrentOdate(), false, "daily procedure")
+			manager.orderDefinition(t, odate, false, username, nil, 0)
 			ordered++
 		}
 	}
@@ -288,7 +295,7 @@ func (manager *ActiveTaskPoolManager) orderNewTasks() int {
 
 //orderDefinition - Adds a new task to the Active Task Pool
 //this method performs all checks
-func (manager *ActiveTaskPoolManager) orderDefinition(def taskdef.TaskDefinition, odate date.Odate, force bool, username string) (unique.TaskOrderID, string) {
+func (manager *ActiveTaskPoolManager) orderDefinition(def taskdef.TaskDefinition, odate date.Odate, force bool, username string, variables types.EnvironmentVariableList, priority int) (unique.TaskOrderID, string) {
 
 	manager.log.Info("order:", def, ":", odate)
 
@@ -312,13 +319,23 @@ func (manager *ActiveTaskPoolManager) orderDefinition(def taskdef.TaskDefinition
 	}
 
 	refID := unique.NewID()
+	task := newActiveTask("", odate, def, refID)
+	task.variables = variables
+	if priority > 0 {
+		task.priority = priority
+	}
+
+	if _, err := prepareVaribles(task, odate, manager.pool.variables); err != nil {
+		manager.log.Error("order task failed:", err)
+		return "", err.Error()
+	}
 
 	if err := manager.tdm.WriteActiveDefinition(def, refID); err != nil {
 		manager.log.Error("push definition to pool failed:", err.Error())
 	}
 
 	orderID := manager.sequence.Next()
-	task := newActiveTask(orderID, odate, def, refID)
+	task.orderID = orderID
 	manager.pool.addTask(orderID, task)
 
 	if force {
@@ -432,7 +449,7 @@ func (manager *ActiveTaskPoolManager) processAddToActivePool(msg events.RouteTas
 
 	}
 
-	id, rmsg = manager.orderDefinition(definition, date.Odate(msg.Odate), msg.Force, msg.Username)
+	id, rmsg = manager.orderDefinition(definition, date.Odate(msg.Odate), msg.Force, msg.Username, nil, 0)
 
 	result.Data = make([]events.TaskInfoResultMsg, 1)
 	result.Data[0].TaskID = id
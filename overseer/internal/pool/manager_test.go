@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/przebro/overseer/common/types"
 	"github.com/przebro/overseer/common/types/date"
 	"github.com/przebro/overseer/overseer/internal/events"
 	"github.com/przebro/overseer/overseer/internal/unique"
@@ -27,7 +28,7 @@ func TestNewManager(t *testing.T) {
 
 func TestOrder(t *testing.T) {
 
-	_, err := activeTaskManagerT.Order(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "noexists"}, date.CurrentOdate(), "user")
+	_, err := activeTaskManagerT.Order(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "noexists"}, date.CurrentOdate(), "user", nil, 0)
 	if err == nil {
 		t.Error("Unexpected result")
 	}
@@ -36,7 +37,7 @@ func TestOrder(t *testing.T) {
 	testUser := "testUserT1"
 	w := mockJournalT.Collect(1, time.Now())
 
-	id, err := activeTaskManagerT.Order(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), testUser)
+	id, err := activeTaskManagerT.Order(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), testUser, nil, 0)
 	if err != nil {
 		t.Error("Unexpected result:", err)
 	}
@@ -54,13 +55,82 @@ func TestOrder(t *testing.T) {
 
 }
 
+func TestOrderWithVariables(t *testing.T) {
+
+	w := mockJournalT.Collect(1, time.Now())
+	vars := types.EnvironmentVariableList{{Name: "%%FILENAME", Value: "data.csv"}}
+
+	id, err := activeTaskManagerT.Order(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), "user", vars, 0)
+	if err != nil {
+		t.Fatal("Unexpected result:", err)
+	}
+	<-w
+
+	task, err := taskPoolT.task(unique.TaskOrderID(id))
+	if err != nil {
+		t.Fatal("Unexpected result:", err)
+	}
+
+	result := task.Variables()
+	if len(result) != 1 || result[0].Name != "%%FILENAME" || result[0].Value != "data.csv" {
+		t.Error("Unexpected result:", result)
+	}
+
+	if model := task.getModel(); len(model.Variables) != 1 {
+		t.Error("Unexpected result:", model.Variables)
+	}
+
+	detail, err := taskPoolT.Detail(unique.TaskOrderID(id))
+	if err != nil || len(detail.Variables) != 1 {
+		t.Error("Unexpected result:", detail.Variables, err)
+	}
+}
+
+func TestOrderWithPriority(t *testing.T) {
+
+	w := mockJournalT.Collect(1, time.Now())
+
+	id, err := activeTaskManagerT.Order(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), "user", nil, 70)
+	if err != nil {
+		t.Fatal("Unexpected result:", err)
+	}
+	<-w
+
+	task, err := taskPoolT.task(unique.TaskOrderID(id))
+	if err != nil {
+		t.Fatal("Unexpected result:", err)
+	}
+
+	if task.Priority() != 70 {
+		t.Error("Unexpected result:", task.Priority())
+	}
+
+	if model := task.getModel(); model.Priority != 70 {
+		t.Error("Unexpected result:", model.Priority)
+	}
+}
+
+func TestOrderWithUndefinedVariables(t *testing.T) {
+
+	vars := types.EnvironmentVariableList{{Name: "%%FILENAME", Value: "%%DIRECTORY/data.csv"}}
+
+	descr, err := activeTaskManagerT.Order(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), "user", vars, 0)
+	if err == nil {
+		t.Fatal("Unexpected result, task ordered:", descr)
+	}
+
+	if !strings.Contains(descr, "%%DIRECTORY") {
+		t.Error("Unexpected result:", descr)
+	}
+}
+
 func TestHoldFree(t *testing.T) {
 
 	var jrnalMsg []events.RouteJournalMsg
 	testUser := "testUserT1"
 	w := mockJournalT.Collect(1, time.Now())
 
-	orderid, err := activeTaskManagerT.Order(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), testUser)
+	orderid, err := activeTaskManagerT.Order(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), testUser, nil, 0)
 	if err != nil {
 		t.Error("Unexpected result:", err)
 	}
@@ -122,7 +192,7 @@ func TestHoldFree(t *testing.T) {
 }
 func TestConfirm(t *testing.T) {
 
-	orderid, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_04"}, date.CurrentOdate(), "user")
+	orderid, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_04"}, date.CurrentOdate(), "user", nil, 0)
 	if err != nil {
 		t.Error("Unexpected result:", err)
 	}
@@ -163,7 +233,7 @@ func TestSetOK(t *testing.T) {
 
 	testUser := "testUserT1"
 
-	orderid, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), testUser)
+	orderid, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), testUser, nil, 0)
 	if err != nil {
 		t.Error("Unexpected result:", err)
 	}
@@ -198,7 +268,7 @@ func TestSetOK(t *testing.T) {
 
 func TestSetWorkerName(t *testing.T) {
 
-	id, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), "user")
+	id, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), "user", nil, 0)
 	if err != nil {
 		t.Error("Unexpected result:", err)
 	}
@@ -214,7 +284,7 @@ func TestSetWorkerName(t *testing.T) {
 
 func TestStartEndTime(t *testing.T) {
 
-	id, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), "user")
+	id, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), "user", nil, 0)
 	if err != nil {
 		t.Error("Unexpected result:", err)
 	}
@@ -237,7 +307,7 @@ func TestStartEndTime(t *testing.T) {
 
 func TestGetModel(t *testing.T) {
 
-	id, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), "user")
+	id, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), "user", nil, 0)
 	if err != nil {
 		t.Error("Unexpected result:", err)
 	}
@@ -295,7 +365,7 @@ func TestForce(t *testing.T) {
 	var jrnalMsg []events.RouteJournalMsg
 	testUser := "testUserT1"
 
-	_, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "noexists"}, date.CurrentOdate(), testUser)
+	_, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "noexists"}, date.CurrentOdate(), testUser, nil, 0)
 
 	if err == nil {
 		t.Error("Unexpected result")
@@ -303,7 +373,7 @@ func TestForce(t *testing.T) {
 
 	w := mockJournalT.Collect(1, time.Now())
 
-	id, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), testUser)
+	id, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), testUser, nil, 0)
 	if err != nil {
 		t.Error("Unexpected result:", err)
 	}
@@ -339,7 +409,7 @@ func TestAtmProcess(t *testing.T) {
 
 func TestChangeState(t *testing.T) {
 
-	id, _ := activeTaskManagerT.Order(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), "user")
+	id, _ := activeTaskManagerT.Order(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_03"}, date.CurrentOdate(), "user", nil, 0)
 	orderID := unique.TaskOrderID(id)
 
 	receiver := events.NewChangeTaskStateReceiver()
@@ -588,7 +658,7 @@ func TestForceGroup(t *testing.T) {
 
 func TestEnforceTask(t *testing.T) {
 
-	id, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_05"}, date.CurrentOdate(), "user")
+	id, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_05"}, date.CurrentOdate(), "user", nil, 0)
 
 	if err != nil {
 		t.Error("unepected result")
@@ -630,7 +700,7 @@ func TestEnforceTask(t *testing.T) {
 
 func TestOrderNewTasks(t *testing.T) {
 
-	r := activeTaskManagerT.orderNewTasks()
+	r := activeTaskManagerT.orderNewTasks(date.CurrentOdate(), "daily procedure")
 	if r == 0 {
 		t.Error("unexpected result")
 	}
@@ -3,23 +3,26 @@ package pool
 import (
 	"time"
 
+	"github.com/przebro/overseer/common/types"
 	"github.com/przebro/overseer/common/types/date"
 )
 
 type activeTaskModel struct {
-	OrderID    string               `json:"_id" bson:"_id"`
-	Name       string               `json:"name" bson:"name"`
-	Group      string               `json:"group" bson:"group"`
-	Reference  string               `json:"_ref" bson:"_ref"`
-	State      TaskState            `json:"state" bson:"state"`
-	Holded     bool                 `json:"holded" bson:"holded"`
-	Confirmed  bool                 `json:"confirm" bson:"confirm"`
-	OrderDate  date.Odate           `json:"odate" bson:"odate"`
-	Tickets    []taskInTicketModel  `json:"tickets" bson:"tickets"`
-	RunNumber  int32                `json:"rn" bson:"rn"`
-	Executions []taskExecutionModel `json:"exec" bson:"exec"`
-	Cycle      taskCycleModel       `json:"cycle" bson:"cycle"`
-	Waiting    string               `json:"waiting" bson:"waiting"`
+	OrderID    string                        `json:"_id" bson:"_id"`
+	Name       string                        `json:"name" bson:"name"`
+	Group      string                        `json:"group" bson:"group"`
+	Reference  string                        `json:"_ref" bson:"_ref"`
+	State      TaskState                     `json:"state" bson:"state"`
+	Holded     bool                          `json:"holded" bson:"holded"`
+	Confirmed  bool                          `json:"confirm" bson:"confirm"`
+	OrderDate  date.Odate                    `json:"odate" bson:"odate"`
+	Tickets    []taskInTicketModel           `json:"tickets" bson:"tickets"`
+	RunNumber  int32                         `json:"rn" bson:"rn"`
+	Executions []taskExecutionModel          `json:"exec" bson:"exec"`
+	Cycle      taskCycleModel                `json:"cycle" bson:"cycle"`
+	Waiting    string                        `json:"waiting" bson:"waiting"`
+	Variables  types.EnvironmentVariableList `json:"vars,omitempty" bson:"vars,omitempty"`
+	Priority   int                           `json:"priority,omitempty" bson:"priority,omitempty"`
 }
 
 type taskInTicketModel struct {
@@ -29,11 +32,15 @@ type taskInTicketModel struct {
 }
 
 type taskExecutionModel struct {
-	ID        string    `json:"_id" bson:"_id"`
-	Worker    string    `json:"worker,omitempty" bson:"worker,omitempty"`
-	StartTime time.Time `json:"start,omitempty" bson:"start,omitempty"`
-	EndTime   time.Time `json:"end,omitempty" bson:"end,omitempty"`
-	State     TaskState `json:"state" bson:"state"`
+	ID         string    `json:"_id" bson:"_id"`
+	Worker     string    `json:"worker,omitempty" bson:"worker,omitempty"`
+	Created    time.Time `json:"created,omitempty" bson:"created,omitempty"`
+	StartTime  time.Time `json:"start,omitempty" bson:"start,omitempty"`
+	EndTime    time.Time `json:"end,omitempty" bson:"end,omitempty"`
+	State      TaskState `json:"state" bson:"state"`
+	Ended      bool      `json:"ended,omitempty" bson:"ended,omitempty"`
+	ReturnCode int32     `json:"rc,omitempty" bson:"rc,omitempty"`
+	StatusCode int32     `json:"sc,omitempty" bson:"sc,omitempty"`
 }
 
 type taskPoolModel struct {
@@ -90,6 +90,15 @@ func (m *mockDispatcher) PushEvent(receiver events.EventReceiver, route events.R
 			mockJournalT.Push(r)
 		}
 
+		if route == events.RouteFlagReconcile {
+			data := msg.Message().(events.RouteFlagReconcileMsg)
+			fixes := []events.FlagReconcileData{}
+			for _, h := range data.Holdings {
+				fixes = append(fixes, events.FlagReconcileData{OrderID: h.OrderID, Message: "FLAG RECOVERED"})
+			}
+			events.ResponseToReceiver(receiver, events.RouteFlagReconcileResponse{Fixes: fixes})
+		}
+
 		if route == events.RouteWorkCheck {
 
 			if m.withError {
@@ -222,5 +231,5 @@ func setupEnv() {
 }
 func initTaskPool(prov *datastore.Provider) {
 
-	taskPoolT, _ = NewTaskPool(mDispatcher, taskPoolConfig, prov, true, log, definitionManagerT)
+	taskPoolT, _ = NewTaskPool(mDispatcher, taskPoolConfig, prov, true, log, definitionManagerT, nil)
 }
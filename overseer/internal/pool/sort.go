@@ -12,3 +12,22 @@ func (s taskInfoSorter) Swap(i, j int) { s.list[i], s.list[j] = s.list[j], s.lis
 func (s taskInfoSorter) Less(i, j int) bool {
 	return s.list[i].TaskID < s.list[j].TaskID
 }
+
+//taskPrioritySorter - sorts tasks by a priority from the highest, then by an order date and a time since a task waits from the oldest
+type taskPrioritySorter struct{ list []*activeTask }
+
+func (s taskPrioritySorter) Len() int      { return len(s.list) }
+func (s taskPrioritySorter) Swap(i, j int) { s.list[i], s.list[j] = s.list[j], s.list[i] }
+
+func (s taskPrioritySorter) Less(i, j int) bool {
+
+	if pi, pj := s.list[i].Priority(), s.list[j].Priority(); pi != pj {
+		return pi > pj
+	}
+
+	if oi, oj := s.list[i].OrderDate(), s.list[j].OrderDate(); oi != oj {
+		return oi < oj
+	}
+
+	return s.list[i].WaitingSince().Before(s.list[j].WaitingSince())
+}
@@ -1,9 +1,12 @@
 package pool
 
 import (
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/przebro/overseer/overseer/internal/events"
+	"github.com/przebro/overseer/overseer/internal/taskdef"
 	"github.com/przebro/overseer/overseer/internal/unique"
 )
 
@@ -42,3 +45,31 @@ func TestSortLess(t *testing.T) {
 	}
 
 }
+
+func TestSortPriority(t *testing.T) {
+
+	builder := taskdef.DummyTaskBuilder{}
+	low, _ := builder.WithBase("test", "low", "test task").WithSchedule(taskdef.SchedulingData{OrderType: taskdef.OrderingManual}).Build()
+	builder = taskdef.DummyTaskBuilder{}
+	high, _ := builder.WithBase("test", "high", "test task").WithSchedule(taskdef.SchedulingData{OrderType: taskdef.OrderingManual}).WithPriority(90).Build()
+
+	now := time.Now()
+	tasks := []*activeTask{
+		newActiveTask("00001", "20210102", low, unique.NewID()),
+		newActiveTask("00002", "20210102", low, unique.NewID()),
+		newActiveTask("00003", "20210101", low, unique.NewID()),
+		newActiveTask("00004", "20210102", high, unique.NewID()),
+	}
+
+	tasks[0].executions[0].Created = now
+	tasks[1].executions[0].Created = now.Add(-time.Minute)
+
+	sort.Sort(taskPrioritySorter{list: tasks})
+
+	expected := []unique.TaskOrderID{"00004", "00003", "00002", "00001"}
+	for i := range expected {
+		if tasks[i].OrderID() != expected[i] {
+			t.Error("unexpected result:", i, tasks[i].OrderID(), "expected:", expected[i])
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/przebro/overseer/datastore"
 	"github.com/przebro/overseer/overseer/config"
 	"github.com/przebro/overseer/overseer/internal/events"
+	"github.com/przebro/overseer/overseer/internal/resources"
 	"github.com/przebro/overseer/overseer/internal/unique"
 )
 
@@ -26,10 +28,22 @@ type ActiveTaskPool struct {
 	processing          chan *activeTask
 	enforcedTasks       map[unique.TaskOrderID]bool
 	lock                sync.RWMutex
+	cycle               sync.Mutex
+	elock               sync.Mutex
+	cycling             bool
+	evaluating          bool
+	pending             map[unique.TaskOrderID]struct{}
 	shutdown            chan struct{}
 	activate            chan bool
 	done                <-chan struct{}
 	activeDefinitionRWC ActiveDefinitionReadWriterRemover
+	variables           VariableReader
+	limiter             *concurrencyLimiter
+}
+
+//VariableReader - Provides variables defined in the variable store for a given scope
+type VariableReader interface {
+	ScopeVariables(scope resources.VariableScope, group, task string) types.EnvironmentVariableList
 }
 
 //TaskViewer - Provides a view for an active tasks in pool
@@ -44,7 +58,8 @@ func NewTaskPool(dispatcher events.Dispatcher,
 	provider *datastore.Provider,
 	isProcActive bool,
 	log logger.AppLogger,
-	activeDefinitionRWC ActiveDefinitionReadWriterRemover) (*ActiveTaskPool, error) {
+	activeDefinitionRWC ActiveDefinitionReadWriterRemover,
+	variables VariableReader) (*ActiveTaskPool, error) {
 
 	var store *Store
 	var err error
@@ -62,13 +77,19 @@ func NewTaskPool(dispatcher events.Dispatcher,
 		processing:          make(chan *activeTask, 8),
 		enforcedTasks:       map[unique.TaskOrderID]bool{},
 		lock:                sync.RWMutex{},
+		pending:             map[unique.TaskOrderID]struct{}{},
 		activate:            make(chan bool),
 		shutdown:            make(chan struct{}),
 		activeDefinitionRWC: activeDefinitionRWC,
+		variables:           variables,
+		limiter:             newConcurrencyLimiter(cfg.Limits),
 	}
 
 	if dispatcher != nil {
 		dispatcher.Subscribe(events.RouteTimeOut, pool)
+		dispatcher.Subscribe(events.RouteTicketNotify, pool)
+		dispatcher.Subscribe(events.RouteWorkNotify, pool)
+		dispatcher.Subscribe(events.RouteFlagNotify, pool)
 	}
 
 	if pool.isProcActive {
@@ -134,31 +155,199 @@ func (pool *ActiveTaskPool) task(orderID unique.TaskOrderID) (*activeTask, error
 
 }
 
+//Limits - returns concurrency limits of the pool
+func (pool *ActiveTaskPool) Limits() ConcurrencyLimits {
+	return pool.limiter
+}
+
+//runningTasks - returns tasks that are executed, they are counted by concurrency limits
+func (pool *ActiveTaskPool) runningTasks() map[unique.TaskOrderID]runningTask {
+
+	result := map[unique.TaskOrderID]runningTask{}
+
+	pool.tasks.Over(func(k unique.TaskOrderID, v *activeTask) {
+		if v.State() == TaskStateExecuting {
+			_, group, _ := v.GetInfo()
+			result[k] = runningTask{group: group, ttype: v.TypeName()}
+		}
+	})
+
+	return result
+}
+
+//cycleTasks - processes tasks in the order of a priority, an order date and a time since a task waits.
+//Tasks with a higher priority are processed before tasks with a lower priority, so under contention
+//for workers, flags or limits, they are started first.
 func (pool *ActiveTaskPool) cycleTasks(t time.Time) {
 
+	//a cycle slower than the interval would pile up ticks waiting for the lock, a tick is skipped instead
+	pool.elock.Lock()
+	if pool.cycling {
+		pool.elock.Unlock()
+		pool.log.Debug("cycle in progress, tick skipped")
+		return
+	}
+	pool.cycling = true
+	pool.elock.Unlock()
+
+	defer func() {
+		pool.elock.Lock()
+		pool.cycling = false
+		pool.elock.Unlock()
+	}()
+
+	defer pool.cycle.Unlock()
+	pool.cycle.Lock()
+
 	tsart := time.Now()
+
+	pool.limiter.reset(pool.runningTasks())
+
+	tasks := make([]*activeTask, 0, pool.tasks.len())
+	pool.tasks.Over(func(k unique.TaskOrderID, v *activeTask) { tasks = append(tasks, v) })
+	pool.processByPriority(tasks, t)
+
+	pool.log.Info(time.Since(tsart))
+}
+
+//processByPriority - sorts tasks and processes them in batches of the same priority,
+//returns the number of tasks that released their resources
+func (pool *ActiveTaskPool) processByPriority(tasks []*activeTask, t time.Time) int {
+
+	released := 0
+	sort.Sort(taskPrioritySorter{list: tasks})
+
+	for from := 0; from < len(tasks); {
+		to := from + 1
+		for to < len(tasks) && tasks[to].Priority() == tasks[from].Priority() {
+			to++
+		}
+		released += pool.processTasks(tasks[from:to], t)
+		from = to
+	}
+
+	return released
+}
+
+//evaluateTasks - processes tasks out of the cycle when something relevant to them changed, e.g. a worker reported
+//the end of an execution or tickets that tasks wait for were added. If evaluated tasks released flags, limits or
+//worker slots, tasks that wait for these resources are evaluated too.
+func (pool *ActiveTaskPool) evaluateTasks(orderIDs []unique.TaskOrderID) {
+
+	defer pool.cycle.Unlock()
+	pool.cycle.Lock()
+
+	evaluated := map[unique.TaskOrderID]bool{}
+	tasks := []*activeTask{}
+
+	for _, id := range orderIDs {
+		task, exists := pool.tasks.get(id)
+		if !exists || evaluated[id] {
+			continue
+		}
+		if state := task.State(); state != TaskStateWaiting && state != TaskStateExecuting {
+			continue
+		}
+		evaluated[id] = true
+		tasks = append(tasks, task)
+	}
+
+	if len(tasks) == 0 {
+		return
+	}
+
+	pool.log.Debug("evaluate tasks:", len(tasks))
+	if pool.processByPriority(tasks, time.Now()) == 0 {
+		return
+	}
+
+	//tasks that did not start because of missing resources have the reason of waiting set
+	starved := []*activeTask{}
+	pool.tasks.Over(func(k unique.TaskOrderID, v *activeTask) {
+		if !evaluated[k] && v.State() == TaskStateWaiting && !v.IsHeld() && len(v.Waiting()) > 0 {
+			starved = append(starved, v)
+		}
+	})
+
+	pool.log.Debug("evaluate waiting tasks:", len(starved))
+	pool.processByPriority(starved, time.Now())
+}
+
+//requestEvaluation - adds tasks to pending evaluations, tasks requested while an evaluation is in progress
+//are evaluated together by the next evaluation, so requests do not pile up waiting for the cycle
+func (pool *ActiveTaskPool) requestEvaluation(orderIDs []unique.TaskOrderID) {
+
+	pool.elock.Lock()
+	defer pool.elock.Unlock()
+
+	for _, id := range orderIDs {
+		pool.pending[id] = struct{}{}
+	}
+
+	if pool.evaluating || len(pool.pending) == 0 {
+		return
+	}
+
+	pool.evaluating = true
+	go pool.evaluatePending()
+}
+
+//evaluatePending - evaluates pending tasks until there are no more requests
+func (pool *ActiveTaskPool) evaluatePending() {
+
+	for {
+		pool.elock.Lock()
+		if len(pool.pending) == 0 {
+			pool.evaluating = false
+			pool.elock.Unlock()
+			return
+		}
+
+		orderIDs := make([]unique.TaskOrderID, 0, len(pool.pending))
+		for id := range pool.pending {
+			orderIDs = append(orderIDs, id)
+		}
+		pool.pending = map[unique.TaskOrderID]struct{}{}
+		pool.elock.Unlock()
+
+		pool.evaluateTasks(orderIDs)
+	}
+}
+
+//processTasks - processes tasks concurrently, tasks are taken in the order of the list,
+//returns the number of tasks that released their resources
+func (pool *ActiveTaskPool) processTasks(tasks []*activeTask, t time.Time) int {
+
 	routines := 8
+	if len(tasks) < routines {
+		routines = len(tasks)
+	}
 
-	tchannel := make(chan *activeTask, pool.tasks.len())
+	tchannel := make(chan *activeTask, len(tasks))
+	released := make(chan unique.TaskOrderID, len(tasks))
 	wg := sync.WaitGroup{}
 	wg.Add(routines)
 
 	for x := 0; x < routines; x++ {
-		go pool.processTaskState(tchannel, &wg, t)
+		go pool.processTaskState(tchannel, released, &wg, t)
 	}
 
-	pool.tasks.Over(func(k unique.TaskOrderID, v *activeTask) { tchannel <- v })
+	for _, task := range tasks {
+		tchannel <- task
+	}
 
 	close(tchannel)
 	wg.Wait()
-	pool.log.Info(time.Since(tsart))
+
+	return len(released)
 }
 
-func (pool *ActiveTaskPool) processTaskState(ch <-chan *activeTask, wg *sync.WaitGroup, t time.Time) {
+func (pool *ActiveTaskPool) processTaskState(ch <-chan *activeTask, released chan<- unique.TaskOrderID, wg *sync.WaitGroup, t time.Time) {
 
 	for task := range ch {
 
-		executionState := getProcessState(task.State(), task.IsHeld())
+		state := task.State()
+		executionState := getProcessState(state, task.IsHeld())
 		if executionState == nil {
 			continue
 		}
@@ -174,10 +363,16 @@ func (pool *ActiveTaskPool) processTaskState(ch <-chan *activeTask, wg *sync.Wai
 			isEnforced:   pool.isEnforced(task.OrderID()),
 			isInTime:     false,
 			scheduleTime: pool.config.NewDayProc,
+			variables:    pool.variables,
 		}
+		exCtx.limiter = pool.limiter
 		for exCtx.state.processState(exCtx) {
 		}
 
+		if holdsResources(state) && !holdsResources(task.State()) {
+			released <- task.OrderID()
+		}
+
 		n, g, _ := task.GetInfo()
 		pool.log.Debug(n, ":", g, " Task state:", task.State(), task.OrderID())
 	}
@@ -206,6 +401,7 @@ func (pool *ActiveTaskPool) Detail(orderID unique.TaskOrderID) (events.TaskDetai
 	result.Held = t.IsHeld()
 	result.RunNumber = int32(t.RunNumber())
 	result.Worker = t.WorkerName()
+	result.Variables = t.OrderVariables()
 
 	if c := t.CycleData(); c.IsCyclic {
 
@@ -233,6 +429,37 @@ func (pool *ActiveTaskPool) Detail(orderID unique.TaskOrderID) (events.TaskDetai
 		}{Name: ticket.name, Odate: date.Odate(ticket.odate), Fulfilled: ticket.fulfilled})
 	}
 
+	waiting := t.State() == TaskStateWaiting
+	blocked := map[string]bool{}
+	if waiting {
+		result.WaitingInfo = strings.Join(t.Waiting(), ";")
+		for _, n := range t.Blocked() {
+			blocked[n] = true
+		}
+	}
+
+	result.Resources = make([]struct {
+		Type    string
+		Name    string
+		Blocked bool
+	}, 0)
+
+	for _, f := range t.ScopedFlags() {
+		result.Resources = append(result.Resources, struct {
+			Type    string
+			Name    string
+			Blocked bool
+		}{Type: "flag", Name: f.Name, Blocked: blocked[f.Name]})
+	}
+
+	for _, q := range t.Quantities() {
+		result.Resources = append(result.Resources, struct {
+			Type    string
+			Name    string
+			Blocked bool
+		}{Type: "quantity", Name: q.Name, Blocked: blocked[q.Name]})
+	}
+
 	return result, nil
 
 }
@@ -253,6 +480,9 @@ func (pool *ActiveTaskPool) List(filter string) []events.TaskInfoResultMsg {
 			Held:      v.IsHeld(),
 			Confirmed: v.Confirmed(),
 		}
+		if data.State == int32(TaskStateWaiting) {
+			data.WaitingInfo = strings.Join(v.Waiting(), ";")
+		}
 		result = append(result, data)
 	})
 
@@ -279,6 +509,40 @@ func (pool *ActiveTaskPool) Process(receiver events.EventReceiver, routename eve
 			pool.log.Debug("Process time events")
 			pool.ProcessTimeEvent(msgdata)
 		}
+	case events.RouteTicketNotify:
+		{
+			msgdata, istype := msg.Message().(events.RouteTicketNotifyMsg)
+			if !istype {
+				er := events.ErrUnrecognizedMsgFormat
+				pool.log.Error(er)
+				events.ResponseToReceiver(receiver, er)
+				break
+			}
+			pool.notifyTickets(msgdata.OrderIDs)
+			pool.requestEvaluation(msgdata.OrderIDs)
+		}
+	case events.RouteWorkNotify:
+		{
+			msgdata, istype := msg.Message().(events.RouteWorkNotifyMsg)
+			if !istype {
+				er := events.ErrUnrecognizedMsgFormat
+				pool.log.Error(er)
+				events.ResponseToReceiver(receiver, er)
+				break
+			}
+			pool.requestEvaluation([]unique.TaskOrderID{msgdata.OrderID})
+		}
+	case events.RouteFlagNotify:
+		{
+			msgdata, istype := msg.Message().(events.RouteFlagNotifyMsg)
+			if !istype {
+				er := events.ErrUnrecognizedMsgFormat
+				pool.log.Error(er)
+				events.ResponseToReceiver(receiver, er)
+				break
+			}
+			pool.requestEvaluation(pool.blockedBy(msgdata.Names))
+		}
 	default:
 		{
 			err := events.ErrInvalidRouteName
@@ -288,6 +552,45 @@ func (pool *ActiveTaskPool) Process(receiver events.EventReceiver, routename eve
 	}
 }
 
+//holdsResources - returns true if a task in a given state holds flags, limits and a worker slot
+func holdsResources(state TaskState) bool {
+	return state == TaskStateStarting || state == TaskStateExecuting
+}
+
+//blockedBy - returns waiting tasks that are blocked by any of given resources
+func (pool *ActiveTaskPool) blockedBy(names []string) []unique.TaskOrderID {
+
+	released := map[string]bool{}
+	for _, n := range names {
+		released[n] = true
+	}
+
+	result := []unique.TaskOrderID{}
+	pool.tasks.Over(func(k unique.TaskOrderID, v *activeTask) {
+		if v.State() != TaskStateWaiting || v.IsHeld() {
+			return
+		}
+		for _, n := range v.Blocked() {
+			if released[n] {
+				result = append(result, k)
+				return
+			}
+		}
+	})
+
+	return result
+}
+
+//notifyTickets - informs tasks that tickets they wait for were changed
+func (pool *ActiveTaskPool) notifyTickets(orderIDs []unique.TaskOrderID) {
+
+	for _, id := range orderIDs {
+		if task, exists := pool.tasks.get(id); exists {
+			task.NotifyTickets()
+		}
+	}
+}
+
 //ProcessTimeEvent - entry point for processing tasks
 func (pool *ActiveTaskPool) ProcessTimeEvent(data events.RouteTimeOutMsgFormat) {
 
@@ -303,6 +606,9 @@ func (pool *ActiveTaskPool) Start() error {
 	pool.lock.Lock()
 
 	if pool.done == nil {
+		if _, err := pool.ReconcileResources(); err != nil {
+			pool.log.Error("unable to reconcile resources:", err)
+		}
 		pool.log.Info("starting store watch:", pool.isProcActive)
 		pool.done = pool.tasks.watch(pool.activate, pool.shutdown)
 	}
@@ -313,6 +619,55 @@ func (pool *ActiveTaskPool) Start() error {
 	return nil
 }
 
+//ReconcileResources - recomputes the state of flags and quantitative resources from tasks that are starting or executing,
+//returns descriptions of changes that were made. Tasks are not processed during the reconciliation, so a task
+//that has just acquired its resources is already starting when its resources are checked.
+func (pool *ActiveTaskPool) ReconcileResources() ([]string, error) {
+
+	if pool.dispatcher == nil {
+		return nil, ErrReconcileUnavailable
+	}
+
+	defer pool.cycle.Unlock()
+	pool.cycle.Lock()
+
+	data := events.RouteFlagReconcileMsg{Holdings: []events.RouteFlagAcquireMsg{}}
+
+	pool.tasks.Over(func(k unique.TaskOrderID, v *activeTask) {
+
+		if state := v.State(); state != TaskStateStarting && state != TaskStateExecuting {
+			return
+		}
+
+		if msg := buildFlagMsg(v.OrderID(), v.ScopedFlags(), v.Quantities()); msg != nil {
+			data.Holdings = append(data.Holdings, msg.Message().(events.RouteFlagAcquireMsg))
+		}
+	})
+
+	receiver := events.NewFlagReconcileReceiver()
+	if err := pool.dispatcher.PushEvent(receiver, events.RouteFlagReconcile, events.NewMsg(data)); err != nil {
+		return nil, err
+	}
+
+	result, err := receiver.WaitForResult()
+	if err != nil {
+		return nil, err
+	}
+
+	fixes := []string{}
+	for _, f := range result.Fixes {
+		if f.OrderID != "" {
+			fixes = append(fixes, fmt.Sprintf("%s:%s", f.OrderID, f.Message))
+		} else {
+			fixes = append(fixes, f.Message)
+		}
+	}
+
+	pool.log.Info(fmt.Sprintf("reconciliation complete, %d changes", len(fixes)))
+
+	return fixes, nil
+}
+
 //Shutdown - shutdowns task pool
 func (pool *ActiveTaskPool) Shutdown() error {
 
@@ -25,7 +25,7 @@ func TestNewTaskPool(t *testing.T) {
 	}
 
 	taskPoolConfig.Collection = "invalid_collection"
-	_, err := NewTaskPool(mDispatcher, taskPoolConfig, provider, true, logger.NewTestLogger(), definitionManagerT)
+	_, err := NewTaskPool(mDispatcher, taskPoolConfig, provider, true, logger.NewTestLogger(), definitionManagerT, nil)
 	if err == nil {
 		t.Error("unexpected result")
 	}
@@ -89,6 +89,97 @@ func TestTaskAddGetDetailList(t *testing.T) {
 	taskPoolT.tasks.remove(orderID)
 
 }
+func TestTaskDetailWaiting(t *testing.T) {
+
+	orderID := unique.TaskOrderID("33334")
+	builder := taskdef.DummyTaskBuilder{}
+
+	def, _ := builder.WithBase("test", "task", "testdescription").WithSchedule(taskdef.SchedulingData{OrderType: taskdef.OrderingManual}).
+		WithFlags([]taskdef.FlagData{{Name: "SMPL-FLAG", Type: taskdef.FlagExclusive}}).WithRetention(0).Build()
+	atask := &activeTask{TaskDefinition: def,
+		orderID: orderID, orderDate: date.CurrentOdate(),
+		executions: []taskExecution{{ExecutionID: "ABCD"}},
+	}
+
+	atask.SetState(TaskStateWaiting)
+	atask.SetWaiting([]string{"SMPL-FLAG"}, []string{"waiting for EXL flag SMPL-FLAG held by 0A3F"})
+	taskPoolT.addTask(orderID, atask)
+	defer taskPoolT.tasks.remove(orderID)
+
+	resultmsg, err := taskPoolT.Detail(orderID)
+	if err != nil {
+		t.Fatal("Unexpected result:", err)
+	}
+
+	if resultmsg.WaitingInfo != "waiting for EXL flag SMPL-FLAG held by 0A3F" {
+		t.Error("Unexpected result:", resultmsg.WaitingInfo)
+	}
+
+	if len(resultmsg.Resources) != 1 || resultmsg.Resources[0].Name != "SMPL-FLAG" || !resultmsg.Resources[0].Blocked {
+		t.Error("Unexpected result:", resultmsg.Resources)
+	}
+
+	atask.SetState(TaskStateEndedOk)
+
+	resultmsg, _ = taskPoolT.Detail(orderID)
+	if resultmsg.WaitingInfo != "" || resultmsg.Resources[0].Blocked {
+		t.Error("Unexpected result:", resultmsg.WaitingInfo, resultmsg.Resources)
+	}
+}
+func TestScopedResources(t *testing.T) {
+
+	builder := taskdef.DummyTaskBuilder{}
+
+	def, _ := builder.WithBase("team", "task", "testdescription").WithSchedule(taskdef.SchedulingData{OrderType: taskdef.OrderingManual}).
+		WithInTicekts([]taskdef.InTicketData{{Name: "IN-GLOBAL"}, {Name: "IN-GROUP", Scope: taskdef.GroupScope}, {Name: "IN-OTHER", Scope: "other"}}, taskdef.InTicketAND, "").
+		WithOutTickets([]taskdef.OutTicketData{{Name: "OUT-GROUP", Action: taskdef.OutActionAdd, Scope: taskdef.GroupScope}, {Name: "OUT-GLOBAL", Action: taskdef.OutActionAdd}}).
+		WithFlags([]taskdef.FlagData{{Name: "FLAG", Type: taskdef.FlagShared, Scope: "other"}}).WithRetention(0).Build()
+
+	atask := newActiveTask(unique.TaskOrderID("33335"), date.CurrentOdate(), def, unique.NewID())
+
+	expected := []string{"IN-GLOBAL", "team:IN-GROUP", "other:IN-OTHER"}
+	for i, tc := range atask.tickets {
+		if tc.name != expected[i] {
+			t.Error("unexpected result:", tc.name, "expected:", expected[i])
+		}
+	}
+
+	msg := buildTicketMsg(atask, atask.TicketsOut())
+	if msg.Tickets[0].Name != "team:OUT-GROUP" || msg.Tickets[1].Name != "OUT-GLOBAL" {
+		t.Error("unexpected result:", msg.Tickets)
+	}
+
+	flags := atask.ScopedFlags()
+	if len(flags) != 1 || flags[0].Name != "other:FLAG" || flags[0].Type != taskdef.FlagShared {
+		t.Error("unexpected result:", flags)
+	}
+}
+
+func TestReconcileResources(t *testing.T) {
+
+	builder := taskdef.DummyTaskBuilder{}
+	def, _ := builder.WithBase("test", "task", "testdescription").WithSchedule(taskdef.SchedulingData{OrderType: taskdef.OrderingManual}).
+		WithFlags([]taskdef.FlagData{{Name: "SMPL-FLAG", Type: taskdef.FlagExclusive}}).WithRetention(0).Build()
+
+	running := &activeTask{TaskDefinition: def, orderID: "33335", orderDate: date.CurrentOdate(), executions: []taskExecution{{ExecutionID: "ABCD"}}}
+	running.SetState(TaskStateExecuting)
+	ended := &activeTask{TaskDefinition: def, orderID: "33336", orderDate: date.CurrentOdate(), executions: []taskExecution{{ExecutionID: "ABCE"}}}
+	ended.SetState(TaskStateEndedOk)
+
+	taskPoolT.addTask(running.orderID, running)
+	taskPoolT.addTask(ended.orderID, ended)
+	defer taskPoolT.tasks.remove(running.orderID)
+	defer taskPoolT.tasks.remove(ended.orderID)
+
+	fixes, err := taskPoolT.ReconcileResources()
+	if err != nil {
+		t.Fatal("Unexpected result:", err)
+	}
+
+	if len(fixes) != 1 || fixes[0] != "33335:FLAG RECOVERED" {
+		t.Error("Unexpected result:", fixes)
+	}
+}
 func TestCleanUp(t *testing.T) {
 
 	orderID := unique.TaskOrderID("12345")
@@ -114,13 +205,13 @@ func TestCleanUp(t *testing.T) {
 
 func TestCycleTasks(t *testing.T) {
 
-	_, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_05"}, date.CurrentOdate(), "user")
+	_, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_05"}, date.CurrentOdate(), "user", nil, 0)
 
 	if err != nil {
 		t.Error("unepected result")
 	}
 
-	id, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_05"}, date.CurrentOdate(), "user")
+	id, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_05"}, date.CurrentOdate(), "user", nil, 0)
 
 	if err != nil {
 		t.Error("unepected result")
@@ -132,6 +223,182 @@ func TestCycleTasks(t *testing.T) {
 	time.Sleep(1 * time.Second)
 }
 
+func TestEvaluateTask(t *testing.T) {
+
+	id, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_05"}, date.CurrentOdate(), "user", nil, 0)
+	if err != nil {
+		t.Fatal("unepected result:", err)
+	}
+
+	task := taskPoolT.tasks.store[unique.TaskOrderID(id)]
+	task.SetState(TaskStateExecuting)
+
+	taskPoolT.Process(nil, events.RouteWorkNotify, events.NewMsg(events.RouteWorkNotifyMsg{OrderID: unique.TaskOrderID(id)}))
+
+	for i := 0; i < 20 && task.State() == TaskStateExecuting; i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	if task.State() != TaskStateEndedOk {
+		t.Error("unexpected result, task should be evaluated when its execution ends:", task.State())
+	}
+}
+
+func TestEvaluateTasks_Starved(t *testing.T) {
+
+	ids := []unique.TaskOrderID{}
+	for i := 0; i < 3; i++ {
+		id, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_05"}, date.CurrentOdate(), "user", nil, 0)
+		if err != nil {
+			t.Fatal("unepected result:", err)
+		}
+		ids = append(ids, unique.TaskOrderID(id))
+	}
+
+	mDispatcher.Tickets["IN-DUMMY03"] = string(date.CurrentOdate())
+	defer delete(mDispatcher.Tickets, "IN-DUMMY03")
+
+	ending := taskPoolT.tasks.store[ids[0]]
+	ending.SetState(TaskStateExecuting)
+	starved := taskPoolT.tasks.store[ids[1]]
+	starved.SetState(TaskStateWaiting)
+	starved.SetWaiting(nil, []string{"worker busy"})
+	other := taskPoolT.tasks.store[ids[2]]
+	other.SetState(TaskStateWaiting)
+
+	taskPoolT.evaluateTasks([]unique.TaskOrderID{ids[0]})
+
+	if ending.State() != TaskStateEndedOk {
+		t.Error("unexpected result, task should be evaluated:", ending.State())
+	}
+
+	if starved.State() == TaskStateWaiting {
+		t.Error("unexpected result, task waiting for resources should be evaluated when resources are released:", starved.State())
+	}
+
+	if other.State() != TaskStateWaiting {
+		t.Error("unexpected result, task not waiting for resources should not be evaluated:", other.State())
+	}
+}
+
+func TestFlagNotify(t *testing.T) {
+
+	ids := []unique.TaskOrderID{}
+	for i := 0; i < 2; i++ {
+		id, err := activeTaskManagerT.Force(taskdata.GroupNameData{GroupData: taskdata.GroupData{Group: "test"}, Name: "dummy_05"}, date.CurrentOdate(), "user", nil, 0)
+		if err != nil {
+			t.Fatal("unepected result:", err)
+		}
+		ids = append(ids, unique.TaskOrderID(id))
+	}
+
+	mDispatcher.Tickets["IN-DUMMY03"] = string(date.CurrentOdate())
+	defer delete(mDispatcher.Tickets, "IN-DUMMY03")
+
+	blocked := taskPoolT.tasks.store[ids[0]]
+	blocked.SetState(TaskStateWaiting)
+	blocked.SetWaiting([]string{"FLAG_NOTIFY_01"}, []string{"waiting for exclusive flag FLAG_NOTIFY_01"})
+	other := taskPoolT.tasks.store[ids[1]]
+	other.SetState(TaskStateWaiting)
+	other.SetWaiting([]string{"FLAG_NOTIFY_02"}, []string{"waiting for exclusive flag FLAG_NOTIFY_02"})
+
+	if result := taskPoolT.blockedBy([]string{"FLAG_NOTIFY_01"}); len(result) != 1 || result[0] != ids[0] {
+		t.Error("unexpected result:", result)
+	}
+
+	taskPoolT.Process(nil, events.RouteFlagNotify, events.NewMsg(events.RouteFlagNotifyMsg{Names: []string{"FLAG_NOTIFY_01"}}))
+
+	for i := 0; i < 20 && blocked.State() == TaskStateWaiting; i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	if blocked.State() == TaskStateWaiting {
+		t.Error("unexpected result, task blocked by a released flag should be evaluated:", blocked.State())
+	}
+
+	if other.State() != TaskStateWaiting {
+		t.Error("unexpected result, task blocked by another flag should not be evaluated:", other.State())
+	}
+}
+
+func TestCycleTasks_Skipped(t *testing.T) {
+
+	taskPoolT.cycle.Lock()
+
+	first := make(chan struct{})
+	go func() {
+		taskPoolT.cycleTasks(time.Now())
+		close(first)
+	}()
+
+	for i := 0; i < 20; i++ {
+		taskPoolT.elock.Lock()
+		cycling := taskPoolT.cycling
+		taskPoolT.elock.Unlock()
+		if cycling {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	second := make(chan struct{})
+	go func() {
+		taskPoolT.cycleTasks(time.Now())
+		close(second)
+	}()
+
+	select {
+	case <-second:
+	case <-time.After(time.Second):
+		t.Error("unexpected result, tick waits for a cycle in progress")
+	}
+
+	taskPoolT.cycle.Unlock()
+	<-first
+}
+
+func TestRequestEvaluation_Coalesced(t *testing.T) {
+
+	taskPoolT.cycle.Lock()
+
+	taskPoolT.requestEvaluation([]unique.TaskOrderID{"A0001"})
+
+	//the first request is taken by the evaluation that waits for the cycle
+	for i := 0; i < 20; i++ {
+		taskPoolT.elock.Lock()
+		pending := len(taskPoolT.pending)
+		taskPoolT.elock.Unlock()
+		if pending == 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	taskPoolT.requestEvaluation([]unique.TaskOrderID{"A0002", "A0003"})
+	taskPoolT.requestEvaluation([]unique.TaskOrderID{"A0002"})
+
+	taskPoolT.elock.Lock()
+	pending, evaluating := len(taskPoolT.pending), taskPoolT.evaluating
+	taskPoolT.elock.Unlock()
+
+	if pending != 2 || !evaluating {
+		t.Error("unexpected result:", pending, evaluating)
+	}
+
+	taskPoolT.cycle.Unlock()
+
+	for i := 0; i < 20 && evaluating; i++ {
+		time.Sleep(10 * time.Millisecond)
+		taskPoolT.elock.Lock()
+		pending, evaluating = len(taskPoolT.pending), taskPoolT.evaluating
+		taskPoolT.elock.Unlock()
+	}
+
+	if pending != 0 || evaluating {
+		t.Error("unexpected result, pending evaluations not processed:", pending, evaluating)
+	}
+}
+
 func TestProcess(t *testing.T) {
 
 	var rcverr error
@@ -168,7 +435,7 @@ func TestStartStopQR(t *testing.T) {
 
 	taskPoolConfig.Collection = testCollectionName
 
-	tpool, err := NewTaskPool(mDispatcher, taskPoolConfig, provider, false, logger.NewTestLogger(), definitionManagerT)
+	tpool, err := NewTaskPool(mDispatcher, taskPoolConfig, provider, false, logger.NewTestLogger(), definitionManagerT, nil)
 	if err != nil {
 		t.Error("Unexpected result")
 	}
@@ -9,6 +9,8 @@ import (
 	"github.com/przebro/overseer/common/types/date"
 	"github.com/przebro/overseer/overseer/internal/events"
 	"github.com/przebro/overseer/overseer/internal/journal"
+	"github.com/przebro/overseer/overseer/internal/resources"
+	converter "github.com/przebro/overseer/overseer/internal/work/converters"
 
 	"strconv"
 	"time"
@@ -42,6 +44,8 @@ type TaskExecutionContext struct {
 	isEnforced   bool
 	maxRc        int32
 	scheduleTime types.HourMinTime
+	variables    VariableReader
+	limiter      *concurrencyLimiter
 }
 
 type ostateCheckOtype struct{}
@@ -242,48 +246,28 @@ func (state ostateCheckConditions) processState(ctx *TaskExecutionContext) bool
 	}
 
 	ctx.task.SetState(TaskStateWaiting)
-	receiver := events.NewTicketCheckReceiver()
-
-	msgData := events.RouteTicketCheckMsgFormat{Tickets: make([]struct {
-		Name, Odate string
-		Label       string
-		Fulfilled   bool
-	}, 0)}
-
-	for _, tc := range ctx.task.Tickets() {
-
-		msgData.Tickets = append(msgData.Tickets, struct {
-			Name, Odate, Label string
-			Fulfilled          bool
-		}{tc.name, tc.odate, tc.label, tc.fulfilled})
-	}
-
-	msg := events.NewMsg(msgData)
-
-	ctx.dispatcher.PushEvent(receiver, events.RouteTicketCheck, msg)
 
-	result, err := receiver.WaitForResult()
-	if err != nil {
-		n, g, _ := ctx.task.GetInfo()
-		ctx.log.Error(err, g, " ", n)
-		return false
-	}
-
-	ctx.task.collected = make([]taskInTicket, 0)
-	for _, t := range result.Tickets {
-		ctx.task.collected = append(ctx.task.collected, taskInTicket{t.Name, t.Odate, t.Label, t.Fulfilled})
-		ctx.log.Debug(t.Name, "::", t.Odate, "::", t.Fulfilled)
+	//tickets are checked only if they were changed since the last check, the resource manager notifies the task about changes
+	collected, ok := ctx.task.CachedTickets()
+	if !ok {
+		var err error
+		if collected, err = checkTickets(ctx); err != nil {
+			n, g, _ := ctx.task.GetInfo()
+			ctx.log.Error(err, g, " ", n)
+			return false
+		}
 	}
 
 	var fulfilled bool = false
+	var err error
 
 	// If relation is described by expression not by simple OR / AND
 	if ctx.task.Relation() == taskdef.InTicketExpr {
 		ex := ctx.task.Expr()
 
 		vars := map[string]interface{}{}
-		for _, elem := range result.Tickets {
-			vars[elem.Label] = elem.Fulfilled
+		for _, elem := range collected {
+			vars[elem.label] = elem.fulfilled
 		}
 
 		if fulfilled, err = expr.Eval(ex, vars); err != nil {
@@ -294,7 +278,7 @@ func (state ostateCheckConditions) processState(ctx *TaskExecutionContext) bool
 
 	} else {
 
-		if len(result.Tickets) == 0 {
+		if len(collected) == 0 {
 			fulfilled = true
 		} else {
 
@@ -302,11 +286,11 @@ func (state ostateCheckConditions) processState(ctx *TaskExecutionContext) bool
 				fulfilled = true
 			}
 
-			for _, t := range result.Tickets {
+			for _, t := range collected {
 				if ctx.task.Relation() == taskdef.InTicketAND {
-					fulfilled = t.Fulfilled && fulfilled
+					fulfilled = t.fulfilled && fulfilled
 				} else {
-					fulfilled = t.Fulfilled || fulfilled
+					fulfilled = t.fulfilled || fulfilled
 				}
 			}
 		}
@@ -314,6 +298,11 @@ func (state ostateCheckConditions) processState(ctx *TaskExecutionContext) bool
 	}
 
 	if fulfilled && ctx.isInTime {
+		//the task no longer waits for tickets, they will be checked again if the task is rerun
+		ctx.task.InvalidateTickets()
+		if len(collected) != 0 {
+			ctx.dispatcher.PushEvent(nil, events.RouteTicketUnwatch, events.NewMsg(events.RouteTicketUnwatchMsg{OrderID: ctx.task.OrderID()}))
+		}
 		ctx.state = &ostateAcquireResources{}
 		pushJournalMessage(ctx.dispatcher, ctx.task.OrderID(), ctx.task.CurrentExecutionID(), time.Now(), journal.TaskFulfill)
 	}
@@ -321,9 +310,49 @@ func (state ostateCheckConditions) processState(ctx *TaskExecutionContext) bool
 	return fulfilled && ctx.isInTime
 }
 
+//checkTickets - checks tickets required by a task and registers the task to be notified when they are changed
+func checkTickets(ctx *TaskExecutionContext) ([]taskInTicket, error) {
+
+	gen := ctx.task.TicketGeneration()
+	receiver := events.NewTicketCheckReceiver()
+
+	msgData := events.RouteTicketCheckMsgFormat{OrderID: ctx.task.OrderID(), Tickets: make([]struct {
+		Name, Odate string
+		Label       string
+		Fulfilled   bool
+	}, 0)}
+
+	for _, tc := range ctx.task.Tickets() {
+
+		msgData.Tickets = append(msgData.Tickets, struct {
+			Name, Odate, Label string
+			Fulfilled          bool
+		}{tc.name, tc.odate, tc.label, tc.fulfilled})
+	}
+
+	msg := events.NewMsg(msgData)
+
+	ctx.dispatcher.PushEvent(receiver, events.RouteTicketCheck, msg)
+
+	result, err := receiver.WaitForResult()
+	if err != nil {
+		return nil, err
+	}
+
+	collected := make([]taskInTicket, 0)
+	for _, t := range result.Tickets {
+		collected = append(collected, taskInTicket{t.Name, t.Odate, t.Label, t.Fulfilled})
+		ctx.log.Debug(t.Name, "::", t.Odate, "::", t.Fulfilled)
+	}
+
+	ctx.task.CacheTickets(collected, gen)
+
+	return collected, nil
+}
+
 func (state ostateAcquireResources) processState(ctx *TaskExecutionContext) bool {
 
-	msg := buildFlagMsg(ctx.task.Flags())
+	msg := buildFlagMsg(ctx.task.OrderID(), ctx.task.ScopedFlags(), ctx.task.Quantities())
 	if msg == nil {
 		ctx.state = &ostateStarting{}
 		return true
@@ -342,9 +371,14 @@ func (state ostateAcquireResources) processState(ctx *TaskExecutionContext) bool
 
 	if !result.Success {
 		ctx.task.SetState(TaskStateWaiting)
+		ctx.task.SetWaiting(result.Names, result.Reasons)
 		return false
 	}
 
+	//resources are held from now on, the task is marked as starting before the cycle ends,
+	//reconciliation waits for the cycle, so it does not release them
+	ctx.task.SetWaiting(nil, nil)
+	ctx.task.SetState(TaskStateStarting)
 	ctx.state = &ostateStarting{}
 	return true
 }
@@ -353,7 +387,24 @@ func (state ostateStarting) processState(ctx *TaskExecutionContext) bool {
 
 	ctx.task.SetState(TaskStateStarting)
 
-	variables := prepareVaribles(ctx.task, ctx.odate)
+	if ctx.limiter != nil {
+		_, group, _ := ctx.task.GetInfo()
+		if ok, reason := ctx.limiter.acquire(ctx.task.OrderID(), group, ctx.task.TypeName()); !ok {
+			state.wait(ctx, reason)
+			return false
+		}
+	}
+
+	variables, err := prepareVaribles(ctx.task, ctx.odate, ctx.variables)
+	if err != nil {
+
+		pushJournalMessage(ctx.dispatcher, ctx.task.OrderID(), ctx.task.CurrentExecutionID(), time.Now(), fmt.Sprintf(journal.TaskStartingVariablesErr, err))
+
+		ctx.state = &ostatePostProcessing{}
+		ctx.task.SetState(TaskStateEndedNotOk)
+		ctx.log.Error("State starting, variables error:", err)
+		return true
+	}
 
 	data := events.RouteTaskExecutionMsg{
 		OrderID:     ctx.task.OrderID(),
@@ -361,6 +412,8 @@ func (state ostateStarting) processState(ctx *TaskExecutionContext) bool {
 		Type:        ctx.task.TypeName(),
 		Variables:   variables,
 		Command:     ctx.task.Action(),
+		Worker:      ctx.task.WorkerSelector(),
+		Idempotent:  ctx.task.Idempotent(),
 	}
 
 	msg := events.NewMsg(data)
@@ -383,14 +436,7 @@ func (state ostateStarting) processState(ctx *TaskExecutionContext) bool {
 
 	if result.Status == types.WorkerTaskStatusWorkerBusy {
 
-		ctx.task.SetState(TaskStateWaiting)
-		fmsg := buildFlagMsg(ctx.task.Flags())
-		if fmsg != nil {
-
-			ctx.dispatcher.PushEvent(nil, events.RouteFlagRelase, fmsg)
-			ctx.log.Info("Release resources:", result, err)
-		}
-
+		state.wait(ctx, result.Reason)
 		return false
 
 	}
@@ -418,6 +464,26 @@ func (state ostateStarting) processState(ctx *TaskExecutionContext) bool {
 
 	return true
 }
+
+//wait - returns a task to the waiting state and releases its resources, the task will try to start in the next cycle
+func (state ostateStarting) wait(ctx *TaskExecutionContext, reason string) {
+
+	ctx.task.SetState(TaskStateWaiting)
+	if reason != "" {
+		ctx.task.SetWaiting(nil, []string{reason})
+	}
+
+	if ctx.limiter != nil {
+		ctx.limiter.release(ctx.task.OrderID())
+	}
+
+	fmsg := buildFlagMsg(ctx.task.OrderID(), ctx.task.ScopedFlags(), ctx.task.Quantities())
+	if fmsg != nil {
+
+		ctx.dispatcher.PushEvent(nil, events.RouteFlagRelase, fmsg)
+		ctx.log.Info("Release resources:", reason)
+	}
+}
 func (state ostateExecuting) processState(ctx *TaskExecutionContext) bool {
 
 	ctx.task.SetState(TaskStateExecuting)
@@ -440,6 +506,12 @@ func (state ostateExecuting) processState(ctx *TaskExecutionContext) bool {
 		return false
 	}
 
+	//a task could be re-dispatched to another worker because its worker was lost
+	if result.WorkerName != "" && result.WorkerName != ctx.task.WorkerName() {
+		ctx.log.Info("Task executed by another worker:", ctx.task.OrderID(), " ", result.WorkerName)
+		ctx.task.SetWorkerName(result.WorkerName)
+	}
+
 	if result.Status == types.WorkerTaskStatusEnded || result.Status == types.WorkerTaskStatusFailed {
 
 		n, g, _ := ctx.task.GetInfo()
@@ -459,6 +531,7 @@ func (state ostateExecuting) processState(ctx *TaskExecutionContext) bool {
 		if result.Status == types.WorkerTaskStatusEnded {
 
 			msg := ""
+			ctx.task.SetResult(result.ReturnCode, result.StatusCode)
 
 			resultState := computeTaskState(ctx.task.TypeName(), ctx.maxRc, result.ReturnCode, result.StatusCode)
 
@@ -485,29 +558,28 @@ func (state ostatePostProcessing) processState(ctx *TaskExecutionContext) bool {
 	ctx.dispatcher.PushEvent(nil, events.RouteTaskClean, msg)
 	ctx.task.SetEndTime()
 
-	fmsg := buildFlagMsg(ctx.task.Flags())
+	if ctx.limiter != nil {
+		ctx.limiter.release(ctx.task.OrderID())
+	}
+
+	fmsg := buildFlagMsg(ctx.task.OrderID(), ctx.task.ScopedFlags(), ctx.task.Quantities())
 	if fmsg != nil {
 		ctx.dispatcher.PushEvent(nil, events.RouteFlagRelase, fmsg)
 	}
 
-	if ctx.task.State() == TaskStateEndedNotOk {
+	ok := ctx.task.State() != TaskStateEndedNotOk
+	rc, sc, ended := ctx.task.Result()
 
-		pushJournalMessage(ctx.dispatcher, ctx.task.OrderID(), ctx.task.CurrentExecutionID(), time.Now(), journal.TaskPostProc)
-		ctx.log.Info("Task post processing ends")
-
-		if !ctx.task.IsCyclic() {
-			return false
-		}
-
-	} else {
-
-		outticket := ctx.task.TicketsOut()
+	if outticket := matchOutTickets(ctx.task.TicketsOut(), ok, ended, rc, sc); len(outticket) != 0 {
 		ticketMsg := buildTicketMsg(ctx.task, outticket)
-
 		ctx.dispatcher.PushEvent(nil, events.RouteTicketIn, events.NewMsg(ticketMsg))
-		pushJournalMessage(ctx.dispatcher, ctx.task.OrderID(), ctx.task.CurrentExecutionID(), time.Now(), journal.TaskPostProc)
+	}
 
-		ctx.log.Info("Task post processing ends")
+	pushJournalMessage(ctx.dispatcher, ctx.task.OrderID(), ctx.task.CurrentExecutionID(), time.Now(), journal.TaskPostProc)
+	ctx.log.Info("Task post processing ends")
+
+	if !ok && !ctx.task.IsCyclic() {
+		return false
 	}
 
 	if ctx.task.prepareNextCycle() {
@@ -534,6 +606,16 @@ func getProcessState(state TaskState, isHeld bool) taskExecutionState {
 
 }
 
+//calcTicketOdate - returns an odate of an in ticket, the LASTnnn value resolves to a range of odates that ends on the current odate
+func calcTicketOdate(current date.Odate, expect date.OdateValue, schedule taskdef.SchedulingData) string {
+
+	if days, ok := expect.LastDays(); ok {
+		return date.OdateRange{From: date.AddDays(current, -days), To: current}.String()
+	}
+
+	return string(calcRealOdate(current, expect, schedule))
+}
+
 func calcRealOdate(current date.Odate, expect date.OdateValue, schedule taskdef.SchedulingData) date.Odate {
 
 	mths := map[time.Month]bool{}
@@ -555,6 +637,15 @@ func calcRealOdate(current date.Odate, expect date.OdateValue, schedule taskdef.
 		return current
 	}
 
+	//values relative to a week and a month do not depend on the schedule of a task
+	if expect == date.OdateValuePrevWeek {
+		return date.AddDays(current, -7)
+	}
+
+	if expect == date.OdateValuePrevMonthFirst || expect == date.OdateValuePrevMonthLast {
+		return date.PrevMonthBusinessDay(current, expect == date.OdateValuePrevMonthFirst)
+	}
+
 	//It is explicite date relative to current date, so do just simply compute
 	if expect != date.OdateValueNext && expect != date.OdateValuePrev {
 
@@ -887,21 +978,47 @@ func getStartOfWeek(current date.Odate, shift int) date.Odate {
 	return date.FromTime(t)
 }
 
-func prepareVaribles(task *activeTask, odate date.Odate) types.EnvironmentVariableList {
+//prepareVaribles - returns resolved variables of a task, variables are resolved in the following order,
+//from the lowest to the highest priority: global, group, definition, task, order time and built-in variables.
+//Returns an error if any of the variables or the action of a task refers to an undefined variable
+func prepareVaribles(task *activeTask, odate date.Odate, reader VariableReader) (types.EnvironmentVariableList, error) {
 
-	variables := types.EnvironmentVariableList{}
-	n, _, _ := task.GetInfo()
+	n, g, _ := task.GetInfo()
 	cID := task.CurrentExecutionID()
 
-	variables = append(variables, types.EnvironmentVariable{Name: "%%ORDERID", Value: string(task.orderID)})
-	variables = append(variables, types.EnvironmentVariable{Name: "%%RN", Value: fmt.Sprintf("%d", task.RunNumber())})
-	variables = append(variables, types.EnvironmentVariable{Name: "%%EXECID", Value: cID})
-	variables = append(variables, types.EnvironmentVariable{Name: "%%ODATE", Value: odate.ODATE()})
-	variables = append(variables, types.EnvironmentVariable{Name: "%%TASKNAME", Value: n})
+	builtin := types.EnvironmentVariableList{
+		{Name: "%%ORDERID", Value: string(task.orderID)},
+		{Name: "%%RN", Value: fmt.Sprintf("%d", task.RunNumber())},
+		{Name: "%%EXECID", Value: cID},
+		{Name: "%%ODATE", Value: odate.ODATE()},
+		{Name: "%%TASKNAME", Value: n},
+	}
+
+	variables := types.EnvironmentVariableList{}
+
+	if reader != nil {
+		variables = variables.Merge(reader.ScopeVariables(resources.VariableScopeGlobal, "", ""))
+		variables = variables.Merge(reader.ScopeVariables(resources.VariableScopeGroup, g, ""))
+	}
+
+	variables = variables.Merge(task.TaskDefinition.Variables())
+
+	if reader != nil {
+		variables = variables.Merge(reader.ScopeVariables(resources.VariableScopeTask, g, n))
+	}
 
-	variables = append(variables, task.Variables()...)
+	variables = variables.Merge(task.OrderVariables()).Merge(builtin)
 
-	return variables
+	resolved, err := converter.ResolveVariables(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = converter.ReplaceAction(task.Action(), resolved); err != nil {
+		return nil, err
+	}
+
+	return resolved, nil
 }
 
 func pushJournalMessage(dispatcher events.Dispatcher, ID unique.TaskOrderID, execID string, t time.Time, msg string) {
@@ -916,9 +1033,9 @@ func pushJournalMessage(dispatcher events.Dispatcher, ID unique.TaskOrderID, exe
 	dispatcher.PushEvent(nil, events.RoutTaskJournal, events.NewMsg(jmsg))
 }
 
-func buildFlagMsg(data []taskdef.FlagData) events.DispatchedMessage {
+func buildFlagMsg(orderID unique.TaskOrderID, data []taskdef.FlagData, quantities []taskdef.QuantityData) events.DispatchedMessage {
 
-	if len(data) == 0 {
+	if len(data) == 0 && len(quantities) == 0 {
 		return nil
 	}
 
@@ -935,23 +1052,47 @@ func buildFlagMsg(data []taskdef.FlagData) events.DispatchedMessage {
 		flags = append(flags, events.FlagActionData{Name: rs.Name, Policy: policy})
 	}
 
-	return events.NewMsg(events.RouteFlagAcquireMsg{Flags: flags})
+	qdata := []events.QuantityActionData{}
+
+	for _, q := range quantities {
+		qdata = append(qdata, events.QuantityActionData{Name: q.Name, Quantity: q.Quantity})
+	}
+
+	return events.NewMsg(events.RouteFlagAcquireMsg{OrderID: orderID, Flags: flags, Quantities: qdata})
+}
+
+//matchOutTickets - returns out tickets whose conditions are met by a result of an execution
+func matchOutTickets(outticket []taskdef.OutTicketData, ok, ended bool, rc, sc int32) []taskdef.OutTicketData {
+
+	result := []taskdef.OutTicketData{}
+
+	for _, t := range outticket {
+		if t.When.Match(ok, ended, rc, sc) {
+			result = append(result, t)
+		}
+	}
+
+	return result
 }
 
 func buildTicketMsg(task *activeTask, outticket []taskdef.OutTicketData) events.RouteTicketInMsgFormat {
 
-	ticketMsg := events.RouteTicketInMsgFormat{Tickets: make([]struct {
+	ticketMsg := events.RouteTicketInMsgFormat{OrderID: task.OrderID(), ExecutionID: task.CurrentExecutionID(), Tickets: make([]struct {
 		Name   string
 		Odate  date.Odate
 		Action taskdef.OutAction
+		Keep   bool
 	}, len(outticket))}
 
+	_, group, _ := task.GetInfo()
+
 	for i, t := range outticket {
 
 		realOdat := calcRealOdate(task.OrderDate(), t.Odate, task.TaskDefinition.Calendar())
-		ticketMsg.Tickets[i].Name = t.Name
+		ticketMsg.Tickets[i].Name = resources.ScopedName(taskdef.ResolveScope(t.Scope, group), t.Name)
 		ticketMsg.Tickets[i].Odate = realOdat
 		ticketMsg.Tickets[i].Action = t.Action
+		ticketMsg.Tickets[i].Keep = t.Keep
 
 	}
 
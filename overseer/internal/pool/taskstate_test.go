@@ -9,8 +9,14 @@ import (
 	"github.com/przebro/overseer/common/types"
 	"github.com/przebro/overseer/common/types/date"
 	"github.com/przebro/overseer/overseer/internal/events"
+	"github.com/przebro/overseer/overseer/internal/resources"
 	"github.com/przebro/overseer/overseer/internal/taskdef"
 	"github.com/przebro/overseer/overseer/internal/unique"
+	converter "github.com/przebro/overseer/overseer/internal/work/converters"
+	_ "github.com/przebro/overseer/overseer/internal/work/converters/os"
+	"github.com/przebro/overseer/proto/actions"
+
+	"google.golang.org/protobuf/proto"
 
 	"testing"
 	"time"
@@ -349,6 +355,60 @@ func TestStateCheckCond(t *testing.T) {
 
 }
 
+func TestStateCheckCond_Cached(t *testing.T) {
+
+	builder := taskdef.DummyTaskBuilder{}
+	definition, err := builder.WithBase("test", "dummy_cached", "test task").
+		WithSchedule(taskdef.SchedulingData{OrderType: taskdef.OrderingManual}).
+		WithInTicekts([]taskdef.InTicketData{{Name: "TESTCACHE01", Odate: date.OdateValueDate}}, "AND", "").
+		Build()
+	if err != nil {
+		t.Fatal("Unable to construct task")
+	}
+
+	ctx := TaskExecutionContext{
+		log:        log,
+		odate:      date.CurrentOdate(),
+		dispatcher: mDispatcher,
+		time:       time.Now(),
+		isInTime:   true,
+	}
+
+	ctx.task = newActiveTask(seq.Next(), date.CurrentOdate(), definition, unique.NewID())
+	taskPoolT.addTask(ctx.task.OrderID(), ctx.task)
+	state := ostateCheckConditions{}
+
+	if result := state.processState(&ctx); result {
+		t.Error("expected result: ", false, " actual:", result)
+	}
+
+	if _, ok := ctx.task.CachedTickets(); !ok {
+		t.Error("unexpected result, tickets not cached")
+	}
+
+	mDispatcher.Tickets["TESTCACHE01"] = string(date.CurrentOdate())
+	defer delete(mDispatcher.Tickets, "TESTCACHE01")
+
+	//without a notification the cached state is used
+	if result := state.processState(&ctx); result {
+		t.Error("expected result: ", false, " actual:", result)
+	}
+
+	taskPoolT.Process(nil, events.RouteTicketNotify, events.NewMsg(events.RouteTicketNotifyMsg{OrderIDs: []unique.TaskOrderID{ctx.task.OrderID()}}))
+
+	if _, ok := ctx.task.CachedTickets(); ok {
+		t.Error("unexpected result, cached tickets valid after a notification")
+	}
+
+	if result := state.processState(&ctx); !result {
+		t.Error("expected result: ", true, " actual:", result)
+	}
+
+	if _, ok := ctx.task.CachedTickets(); ok {
+		t.Error("unexpected result, cached tickets valid after conditions are fulfilled")
+	}
+}
+
 func TestStateCheckCond_WithExpr(t *testing.T) {
 
 	var result bool
@@ -480,6 +540,53 @@ func TestNewCyclicTask(t *testing.T) {
 
 }
 
+func TestMatchOutTickets(t *testing.T) {
+
+	builder := taskdef.DummyTaskBuilder{}
+	definition, _ := builder.WithBase("test", "out_cond_01", "test task").
+		WithOutTickets([]taskdef.OutTicketData{
+			{Name: "LOAD-OK", Action: taskdef.OutActionAdd},
+			{Name: "LOAD-FAILED", Action: taskdef.OutActionAdd, When: taskdef.OutWhenNotOK},
+			{Name: "IN-PROGRESS", Action: taskdef.OutActionRemove, When: taskdef.OutWhenAny},
+			{Name: "LOAD-WARN", Action: taskdef.OutActionAdd, When: "RC=1-4"},
+		}).WithSchedule(taskdef.SchedulingData{OrderType: taskdef.OrderingManual}).Build()
+
+	task := newActiveTask(seq.Next(), date.CurrentOdate(), definition, unique.NewID())
+
+	if _, _, ended := task.Result(); ended {
+		t.Error("unexpected result:", ended)
+	}
+
+	names := func(data []taskdef.OutTicketData) string {
+		result := []string{}
+		for _, d := range data {
+			result = append(result, d.Name)
+		}
+		return strings.Join(result, ",")
+	}
+
+	rc, sc, ended := task.Result()
+	if result := names(matchOutTickets(task.TicketsOut(), false, ended, rc, sc)); result != "LOAD-FAILED,IN-PROGRESS" {
+		t.Error("unexpected result:", result)
+	}
+
+	task.SetResult(4, 0)
+	rc, sc, ended = task.Result()
+	if rc != 4 || sc != 0 || !ended {
+		t.Error("unexpected result:", rc, sc, ended)
+	}
+
+	if result := names(matchOutTickets(task.TicketsOut(), false, ended, rc, sc)); result != "LOAD-FAILED,IN-PROGRESS,LOAD-WARN" {
+		t.Error("unexpected result:", result)
+	}
+
+	task.SetResult(0, 0)
+	rc, sc, ended = task.Result()
+	if result := names(matchOutTickets(task.TicketsOut(), true, ended, rc, sc)); result != "LOAD-OK,IN-PROGRESS" {
+		t.Error("unexpected result:", result)
+	}
+}
+
 func TestCyclicState_Enforced(t *testing.T) {
 
 	builder := taskdef.DummyTaskBuilder{}
@@ -1179,6 +1286,30 @@ func TestCalcRealOdate(t *testing.T) {
 
 }
 
+func TestCalcTicketOdate(t *testing.T) {
+
+	schedule := taskdef.SchedulingData{OrderType: taskdef.OrderingDaily}
+	current := date.Odate("20201101")
+
+	tdata := []struct {
+		expect date.OdateValue
+		result string
+	}{
+		{date.OdateValueDate, "20201101"},
+		{date.OdateValuePrevWeek, "20201025"},
+		{date.OdateValuePrevMonthFirst, "20201001"},
+		{date.OdateValuePrevMonthLast, "20201030"},
+		{"LAST007", "20201025-20201101"},
+		{"LAST000", "20201101-20201101"},
+	}
+
+	for _, d := range tdata {
+		if result := calcTicketOdate(current, d.expect, schedule); result != d.result {
+			t.Error("unexpected result:", result, "expected:", d.result)
+		}
+	}
+}
+
 func TestCalcRealOdateFromEnd(t *testing.T) {
 
 	schedule := taskdef.SchedulingData{}
@@ -1629,13 +1760,13 @@ func Test_prepareNextCycle_MaxRuns(t *testing.T) {
 
 func Test_buildFlagMsg_Error(t *testing.T) {
 
-	result := buildFlagMsg(nil)
+	result := buildFlagMsg("", nil, nil)
 	if result != nil {
 		t.Error("unexpected result:", result, "expected:", nil)
 	}
 
 	data := []taskdef.FlagData{}
-	result = buildFlagMsg(data)
+	result = buildFlagMsg("", data, []taskdef.QuantityData{})
 	if result != nil {
 		t.Error("unexpected result:", result, "expected:", nil)
 	}
@@ -1649,7 +1780,7 @@ func Test_buildFlagMsg(t *testing.T) {
 		{Name: "ABCE", Type: taskdef.FlagShared},
 	}
 
-	result := buildFlagMsg(data)
+	result := buildFlagMsg("12345", data, nil)
 	if result == nil {
 		t.Error("unexpected result:", result, "expected:", nil)
 	}
@@ -1661,6 +1792,146 @@ func Test_buildFlagMsg(t *testing.T) {
 	if len(msg.Flags) != len(data) {
 		t.Error("unexpected result:", len(msg.Flags), "expected:", len(data))
 	}
+
+	if msg.OrderID != "12345" {
+		t.Error("unexpected result:", msg.OrderID, "expected:", "12345")
+	}
+
+	quantities := []taskdef.QuantityData{{Name: "DB2_CONNECTIONS", Quantity: 2}}
+
+	result = buildFlagMsg("12345", nil, quantities)
+	if result == nil {
+		t.Fatal("unexpected result:", result)
+	}
+
+	msg = result.Message().(events.RouteFlagAcquireMsg)
+	if len(msg.Flags) != 0 || len(msg.Quantities) != 1 || msg.Quantities[0].Quantity != 2 {
+		t.Error("unexpected result:", msg)
+	}
+}
+
+type mockVariableReader struct {
+	variables map[resources.VariableScope]types.EnvironmentVariableList
+}
+
+func (m *mockVariableReader) ScopeVariables(scope resources.VariableScope, group, task string) types.EnvironmentVariableList {
+	return m.variables[scope]
+}
+
+func Test_prepareVariables(t *testing.T) {
+
+	builder := taskdef.DummyTaskBuilder{}
+	definition, err := builder.WithBase("test", "dummy_vars", "test task").
+		WithSchedule(taskdef.SchedulingData{OrderType: taskdef.OrderingManual}).
+		WithVariables(types.EnvironmentVariableList{{Name: "%%DIR", Value: "%%HOME/definition"}, {Name: "%%FILE", Value: "%%DIR/data"}}).
+		Build()
+	if err != nil {
+		t.Fatal("Unable to construct task")
+	}
+
+	reader := &mockVariableReader{variables: map[resources.VariableScope]types.EnvironmentVariableList{
+		resources.VariableScopeGlobal: {{Name: "%%HOME", Value: "/global"}, {Name: "%%USER", Value: "global"}},
+		resources.VariableScopeGroup:  {{Name: "%%HOME", Value: "/group"}},
+		resources.VariableScopeTask:   {{Name: "%%FILE", Value: "%%DIR/%%ODATE.csv"}},
+	}}
+
+	task := newActiveTask("00010", date.Odate("20201115"), definition, unique.NewID())
+	task.variables = types.EnvironmentVariableList{{Name: "%%USER", Value: "order"}}
+
+	result, err := prepareVaribles(task, date.Odate("20201115"), reader)
+	if err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+
+	expected := map[string]string{
+		"%%HOME":    "/group",
+		"%%USER":    "order",
+		"%%DIR":     "/group/definition",
+		"%%FILE":    "/group/definition/201115.csv",
+		"%%ORDERID": "00010",
+		"%%ODATE":   "201115",
+	}
+
+	values := map[string]string{}
+	for _, v := range result {
+		values[v.Name] = v.Value
+	}
+
+	for k, v := range expected {
+		if values[k] != v {
+			t.Error("unexpected result:", k, "=", values[k], "expected:", v)
+		}
+	}
+
+	if _, err = prepareVaribles(task, date.Odate("20201115"), nil); err == nil {
+		t.Error("unexpected result, expected error")
+	}
+}
+
+func Test_prepareVariables_Action(t *testing.T) {
+
+	definition, err := taskdef.FromString(`{"type":"os","name":"os_vars","group":"test","schedule":{"type":"manual"},
+		"spec":{"type":"command","command":"cp data.%%$FORMAT(%%ODATE,\"2006/01/02\") $(date +%%%%H).bak %%SECRET(UNDEFINED)"}}`)
+	if err != nil {
+		t.Fatal("Unable to construct task:", err)
+	}
+
+	task := newActiveTask("00011", date.Odate("20201115"), definition, unique.NewID())
+
+	if _, err = prepareVaribles(task, date.Odate("20201115"), nil); err != nil {
+		t.Error("unexpected result:", err)
+	}
+
+	definition, err = taskdef.FromString(`{"type":"os","name":"os_vars","group":"test","schedule":{"type":"manual"},
+		"spec":{"type":"command","command":"date +%%H"}}`)
+	if err != nil {
+		t.Fatal("Unable to construct task:", err)
+	}
+
+	task = newActiveTask("00012", date.Odate("20201115"), definition, unique.NewID())
+
+	if _, err = prepareVaribles(task, date.Odate("20201115"), nil); err == nil {
+		t.Error("unexpected result, expected error")
+	}
+}
+
+type mockSecretReader map[string]string
+
+func (m *mockSecretReader) Secret(name string) (string, bool, error) {
+	value, ok := (*m)[name]
+	return value, ok, nil
+}
+
+func Test_prepareVariables_Command(t *testing.T) {
+
+	definition, err := taskdef.FromString(`{"type":"os","name":"os_dates","group":"test","schedule":{"type":"manual"},
+		"variables":[{"name":"%%YESTERDAY","value":"%%$CALCDATE(%%ODATE,-1)"}],
+		"spec":{"type":"command","command":"load %%$FORMAT(%%YESTERDAY,\"2006/01/02\") %%$MONTHEND week%%$WEEK(20210104) +%%%%H %%SECRET(DB-PASS)"}}`)
+	if err != nil {
+		t.Fatal("Unable to construct task:", err)
+	}
+
+	task := newActiveTask("00013", date.Odate("20210301"), definition, unique.NewID())
+
+	variables, err := prepareVaribles(task, date.Odate("20210301"), nil)
+	if err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+
+	msg, err := converter.ConvertToMsg(types.TypeOs, task.Action(), variables, &mockSecretReader{"DB-PASS": "P@ss"})
+	if err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+
+	action := &actions.OsTaskAction{}
+	if err = proto.Unmarshal(msg.Value, action); err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+
+	expected := "load 2021/02/28 210331 week01 +%%H P@ss"
+	if action.CommandLine != expected {
+		t.Error("unexpected result:", action.CommandLine, "expected:", expected)
+	}
 }
 
 func strTimeToInt(time string) (int, int) {
@@ -1,26 +1,54 @@
 package resources
 
 import (
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"regexp"
 	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/przebro/overseer/common/core"
 	"github.com/przebro/overseer/common/logger"
+	"github.com/przebro/overseer/common/types"
 	"github.com/przebro/overseer/common/types/date"
 	"github.com/przebro/overseer/datastore"
 	"github.com/przebro/overseer/overseer/config"
 	"github.com/przebro/overseer/overseer/internal/events"
+	"github.com/przebro/overseer/overseer/internal/journal"
 	"github.com/przebro/overseer/overseer/internal/taskdef"
+	"github.com/przebro/overseer/overseer/internal/unique"
 )
 
 type resourceManager struct {
 	dispatcher events.Dispatcher
 	log        logger.AppLogger
 	tstore     *resourceStore
+	tlock      sync.Mutex
+	astore     *resourceStore
+	aseq       int64
+	alock      sync.Mutex
 	fstore     *resourceStore
+	qstore     *resourceStore
+	vstore     *resourceStore
+	sstore     *resourceStore
+	aead       cipher.AEAD
 	flock      sync.Mutex
+	retention  int
+	wlock      sync.Mutex
+	watchers   map[string]map[unique.TaskOrderID]struct{}
+	watched    map[unique.TaskOrderID][]string
+	ranges     map[string]ticketRange
+}
+
+//ticketRange - a ticket with any odate from a range that is watched by tasks
+type ticketRange struct {
+	name   string
+	odates date.OdateRange
 }
 
 //TicketManager - base resources required by task to run
@@ -28,7 +56,16 @@ type TicketManager interface {
 	Add(name string, odate date.Odate) (bool, error)
 	Delete(name string, odate date.Odate) (bool, error)
 	Check(name string, odate date.Odate) bool
+	CheckRange(name string, odates date.OdateRange) bool
 	ListTickets(name string, datestr string) []TicketResource
+	AddTicket(name string, odate date.Odate, keep bool, origin TicketOrigin) (bool, error)
+	DeleteTicket(name string, odate date.Odate, origin TicketOrigin) (bool, error)
+	AddTickets(tickets []TicketResource, origin TicketOrigin) (int, error)
+	DeleteTickets(name string, datestr string, dryRun bool, token string, origin TicketOrigin) ([]TicketResource, string, error)
+	GetTicket(name string, odate date.Odate) (TicketResource, bool)
+	TicketAudit(name string, datestr string) []TicketAuditEntry
+	KeepTicket(name string, odate date.Odate, keep bool) (bool, error)
+	PurgeTickets(odate date.Odate) int
 }
 
 //FlagManager - Resource that helps run tasks
@@ -37,22 +74,66 @@ type FlagManager interface {
 	Unset(name string) (bool, error)
 	DestroyFlag(name string) (bool, error)
 	ListFlags(name string) []FlagResource
+	ReleaseHolder(name, holder, username string) (bool, error)
+}
+
+//QuantityManager - Quantitative resources that have a capacity shared by tasks
+type QuantityManager interface {
+	SetQuantity(name string, capacity int) (bool, error)
+	DeleteQuantity(name string) (bool, error)
+	ListQuantities(name string) []QuantityResource
+}
+
+//VariableManager - Stores variables in a global, group or task scope
+type VariableManager interface {
+	SetVariable(variable VariableResource) (bool, error)
+	DeleteVariable(scope VariableScope, group, task, name string) (bool, error)
+	ListVariables(scope VariableScope, group, task, name string) []VariableResource
+	ScopeVariables(scope VariableScope, group, task string) types.EnvironmentVariableList
+}
+
+//SecretManager - Stores encrypted secrets
+type SecretManager interface {
+	SetSecret(name, value string) (bool, error)
+	DeleteSecret(name string) (bool, error)
+	ListSecrets(name string) []string
+	Secret(name string) (string, bool, error)
+	MaskSecrets(in string) string
 }
 
 //ResourceManager - manages resources that are required by tasks
 type ResourceManager interface {
 	TicketManager
 	FlagManager
+	QuantityManager
+	VariableManager
+	SecretManager
 	core.OverseerComponent
 }
 
+//ErrInvalidVariableScope - occurs when a scope of a variable does not match with given group and task
+var ErrInvalidVariableScope = errors.New("invalid variable scope")
+
+//ErrTicketsChanged - occurs when tickets that match patterns differ from tickets returned by a preview
+var ErrTicketsChanged = errors.New("matching tickets changed since the preview")
+
+//SecretMask - replaces values of secrets in a masked text
+const SecretMask = "********"
+
+//maxTicketAuditEntries - number of the most recent changes of tickets kept in the audit trail
+const maxTicketAuditEntries = 4096
+
 //NewManager - crates new resources manager
 func NewManager(dispatcher events.Dispatcher, log logger.AppLogger, rconfig config.ResourcesConfigurartion, provider *datastore.Provider) (ResourceManager, error) {
 
 	var err error
 
 	var tstore *resourceStore
+	var astore *resourceStore
 	var fstore *resourceStore
+	var qstore *resourceStore
+	var vstore *resourceStore
+	var sstore *resourceStore
 
 	trw, err := newTicketReadWriter(rconfig.TicketSource.Collection, "tickets", provider)
 	if err != nil {
@@ -64,199 +145,1078 @@ func NewManager(dispatcher events.Dispatcher, log logger.AppLogger, rconfig conf
 		return nil, err
 	}
 
-	frw, err := newFlagReadWriter(rconfig.FlagSource.Collection, "flags", provider)
-	if err != nil {
-		return nil, err
-	}
+	arw, err := newTicketAuditReadWriter(rconfig.TicketSource.Collection, "ticket_audit", provider)
+	if err != nil {
+		return nil, err
+	}
+
+	astore, err = newStore(arw, rconfig.TicketSource.Sync)
+	if err != nil {
+		return nil, err
+	}
+
+	frw, err := newFlagReadWriter(rconfig.FlagSource.Collection, "flags", provider)
+	if err != nil {
+		return nil, err
+	}
+
+	fstore, err = newStore(frw, rconfig.FlagSource.Sync)
+	if err != nil {
+		return nil, err
+	}
+
+	qrw, err := newQuantityReadWriter(rconfig.QuantitySource.Collection, "quantities", provider)
+	if err != nil {
+		return nil, err
+	}
+
+	qstore, err = newStore(qrw, rconfig.QuantitySource.Sync)
+	if err != nil {
+		return nil, err
+	}
+
+	vrw, err := newVariableReadWriter(rconfig.VariableSource.Collection, "variables", provider)
+	if err != nil {
+		return nil, err
+	}
+
+	vstore, err = newStore(vrw, rconfig.VariableSource.Sync)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newSecretCipher(rconfig.SecretSource.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	srw, err := newSecretReadWriter(rconfig.SecretSource.Collection, "secrets", provider)
+	if err != nil {
+		return nil, err
+	}
+
+	sstore, err = newStore(srw, rconfig.SecretSource.Sync)
+	if err != nil {
+		return nil, err
+	}
+
+	rm := &resourceManager{
+		log:        log,
+		dispatcher: dispatcher,
+		tstore:     tstore,
+		tlock:      sync.Mutex{},
+		astore:     astore,
+		fstore:     fstore,
+		qstore:     qstore,
+		vstore:     vstore,
+		sstore:     sstore,
+		aead:       aead,
+		flock:      sync.Mutex{},
+		retention:  rconfig.TicketRetention,
+		watchers:   map[string]map[unique.TaskOrderID]struct{}{},
+		watched:    map[unique.TaskOrderID][]string{},
+		ranges:     map[string]ticketRange{},
+	}
+
+	for _, v := range astore.All() {
+		if e := v.(TicketAuditEntry); e.Seq > rm.aseq {
+			rm.aseq = e.Seq
+		}
+	}
+
+	//Subscribe for incoming messages about requests for tickets
+	rm.dispatcher.Subscribe(events.RouteTicketCheck, rm)
+	rm.dispatcher.Subscribe(events.RouteTicketIn, rm)
+	rm.dispatcher.Subscribe(events.RouteFlagAcquire, rm)
+	rm.dispatcher.Subscribe(events.RouteFlagRelase, rm)
+	rm.dispatcher.Subscribe(events.RouteFlagReconcile, rm)
+	rm.dispatcher.Subscribe(events.RouteTicketUnwatch, rm)
+
+	return rm, nil
+}
+
+func (rm *resourceManager) Add(name string, odate date.Odate) (bool, error) {
+	return rm.AddTicket(name, odate, false, TicketOrigin{})
+}
+
+//AddTicket - adds a ticket, the creator of the ticket and the time of creation are recorded with the ticket
+func (rm *resourceManager) AddTicket(name string, odate date.Odate, keep bool, origin TicketOrigin) (bool, error) {
+
+	ticket := TicketResource{Name: name, Odate: odate, Keep: keep, Origin: origin, Created: time.Now()}
+
+	rm.tlock.Lock()
+	defer rm.tlock.Unlock()
+
+	err := rm.tstore.Insert(name+string(odate), ticket)
+	if err != nil {
+		return false, errors.New("ticket with given name and odate already exists")
+	}
+	rm.log.Info("TICKET:", name, odate, "KEEP:", keep, "ORIGIN:", origin)
+	rm.audit(TicketAuditAdd, name, odate, origin)
+	rm.notifyWatchers(ticket)
+
+	return true, nil
+}
+
+//KeepTicket - sets or clears the keep option of a ticket, a kept ticket is not removed when it expires
+func (rm *resourceManager) KeepTicket(name string, odate date.Odate, keep bool) (bool, error) {
+
+	key := name + string(odate)
+
+	rm.tlock.Lock()
+	defer rm.tlock.Unlock()
+
+	v, ok := rm.tstore.Get(key)
+	if !ok {
+		return false, errors.New("ticket with given name and odate does not exists")
+	}
+
+	ticket := v.(TicketResource)
+	ticket.Keep = keep
+
+	if err := rm.tstore.Update(key, ticket); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+//PurgeTickets - removes tickets with an ODATE older than the retention period counted from a given ODATE,
+//tickets without an ODATE and tickets with the keep option are not removed. Returns the number of removed tickets.
+func (rm *resourceManager) PurgeTickets(odate date.Odate) int {
+
+	if rm.retention <= 0 {
+		return 0
+	}
+
+	expiry := date.AddDays(odate, -rm.retention)
+	purged := 0
+
+	rm.tlock.Lock()
+	defer rm.tlock.Unlock()
+
+	for _, v := range rm.tstore.All() {
+
+		ticket := v.(TicketResource)
+		if ticket.Keep || ticket.Odate == date.OdateNone {
+			continue
+		}
+
+		if date.IsBeforeCurrent(ticket.Odate, expiry) {
+			if err := rm.tstore.Delete(ticket.Name + string(ticket.Odate)); err == nil {
+				rm.audit(TicketAuditPurge, ticket.Name, ticket.Odate, TicketOrigin{})
+				rm.notifyWatchers(ticket)
+				purged++
+			}
+		}
+	}
+
+	rm.log.Info("Tickets purged:", purged, " expiry odate:", expiry)
+
+	return purged
+}
+func (rm *resourceManager) Delete(name string, odate date.Odate) (bool, error) {
+	return rm.DeleteTicket(name, odate, TicketOrigin{})
+}
+
+//DeleteTicket - removes a ticket, the removal is recorded in the audit trail together with a task or a user that removed the ticket
+func (rm *resourceManager) DeleteTicket(name string, odate date.Odate, origin TicketOrigin) (bool, error) {
+
+	rm.tlock.Lock()
+	defer rm.tlock.Unlock()
+
+	return rm.deleteTicket(name, odate, origin)
+}
+
+//deleteTicket - removes a ticket, the caller must hold the ticket lock
+func (rm *resourceManager) deleteTicket(name string, odate date.Odate, origin TicketOrigin) (bool, error) {
+
+	key := name + string(odate)
+	if err := rm.tstore.Delete(key); err != nil {
+		return false, err
+	}
+	rm.log.Info("TICKET REMOVED:", name, odate, "ORIGIN:", origin)
+	rm.audit(TicketAuditRemove, name, odate, origin)
+	rm.notifyWatchers(TicketResource{Name: name, Odate: odate})
+
+	return true, nil
+}
+
+//AddTickets - adds tickets at once, if any of tickets already exists or is repeated, none of them is added
+func (rm *resourceManager) AddTickets(tickets []TicketResource, origin TicketOrigin) (int, error) {
+
+	items := map[string]interface{}{}
+	created := time.Now()
+
+	rm.tlock.Lock()
+	defer rm.tlock.Unlock()
+
+	for _, t := range tickets {
+
+		key := t.Name + string(t.Odate)
+		if _, ok := items[key]; ok {
+			return 0, fmt.Errorf("ticket %s with odate:%s is repeated", t.Name, t.Odate)
+		}
+
+		if _, ok := rm.tstore.Get(key); ok {
+			return 0, fmt.Errorf("ticket %s with odate:%s already exists", t.Name, t.Odate)
+		}
+
+		items[key] = TicketResource{Name: t.Name, Odate: t.Odate, Keep: t.Keep, Origin: origin, Created: created}
+	}
+
+	if err := rm.tstore.InsertMany(items); err != nil {
+		return 0, errors.New("one of tickets with given name and odate already exists")
+	}
+
+	for _, t := range tickets {
+		rm.audit(TicketAuditAdd, t.Name, t.Odate, origin)
+	}
+	rm.notifyWatchers(tickets...)
+	rm.log.Info("TICKETS ADDED:", len(items), "ORIGIN:", origin)
+
+	return len(items), nil
+}
+
+//DeleteTickets - removes tickets that match given name and odate patterns and returns removed tickets together with a token of matching tickets.
+//If dryRun is set, matching tickets are returned without removing them, otherwise tickets are removed only if the token
+//is equal to the token returned by the preview.
+func (rm *resourceManager) DeleteTickets(name string, datestr string, dryRun bool, token string, origin TicketOrigin) ([]TicketResource, string, error) {
+
+	rm.tlock.Lock()
+	defer rm.tlock.Unlock()
+
+	matched := rm.ListTickets(name, datestr)
+	current := ticketsToken(matched)
+	if dryRun {
+		return matched, current, nil
+	}
+
+	if token != current {
+		return []TicketResource{}, current, ErrTicketsChanged
+	}
+
+	deleted := make([]TicketResource, 0, len(matched))
+	for _, t := range matched {
+		if ok, _ := rm.deleteTicket(t.Name, t.Odate, origin); ok {
+			deleted = append(deleted, t)
+		}
+	}
+
+	return deleted, current, nil
+}
+
+//ticketsToken - returns a digest of names and odates of tickets
+func ticketsToken(tickets []TicketResource) string {
+
+	keys := make([]string, 0, len(tickets))
+	for _, t := range tickets {
+		keys = append(keys, t.Name+"\x00"+string(t.Odate))
+	}
+	sort.Strings(keys)
+
+	sum := sha256.Sum256([]byte(strings.Join(keys, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+//GetTicket - returns a ticket with given name and odate
+func (rm *resourceManager) GetTicket(name string, odate date.Odate) (TicketResource, bool) {
+
+	v, ok := rm.tstore.Get(name + string(odate))
+	if !ok {
+		return TicketResource{}, false
+	}
+
+	return v.(TicketResource), true
+}
+
+//TicketAudit - returns changes of tickets restricted to given name and odate, ordered from the oldest
+func (rm *resourceManager) TicketAudit(name string, datestr string) []TicketAuditEntry {
+
+	var matchName bool
+	var matchDate bool
+	var err error
+
+	result := make([]TicketAuditEntry, 0)
+	nexpr := buildExpr(name)
+	dexpr := buildDateExpr(datestr)
+
+	for _, v := range rm.astore.All() {
+
+		e := v.(TicketAuditEntry)
+		if matchName, err = regexp.Match(nexpr, []byte(e.Name)); err != nil {
+			return []TicketAuditEntry{}
+		}
+
+		if matchDate, err = regexp.Match(dexpr, []byte(e.Odate)); err != nil {
+			return []TicketAuditEntry{}
+		}
+
+		if matchName && matchDate {
+			result = append(result, e)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Seq < result[j].Seq })
+
+	return result
+}
+
+//audit - records a change of a ticket, the oldest entry is removed when the audit trail exceeds its limit
+func (rm *resourceManager) audit(action TicketAuditAction, name string, odate date.Odate, origin TicketOrigin) {
+
+	defer rm.alock.Unlock()
+	rm.alock.Lock()
+
+	rm.aseq++
+	rm.astore.Insert(auditKey(rm.aseq), TicketAuditEntry{Seq: rm.aseq, Time: time.Now(), Action: action, Name: name, Odate: odate, Origin: origin})
+
+	if rm.aseq > maxTicketAuditEntries {
+		rm.astore.Delete(auditKey(rm.aseq - maxTicketAuditEntries))
+	}
+}
+func (rm *resourceManager) Check(name string, odate date.Odate) bool {
+
+	key := name + string(odate)
+	_, ok := rm.tstore.Get(key)
+	return ok
+}
+
+//CheckRange - checks if there is a ticket with a given name and any odate from a range
+func (rm *resourceManager) CheckRange(name string, odates date.OdateRange) bool {
+
+	for _, v := range rm.tstore.All() {
+		if t := v.(TicketResource); t.Name == name && odates.Contains(t.Odate) {
+			return true
+		}
+	}
+
+	return false
+}
+
+//ListTickets - return a list of tickets restricted to given name and odate
+func (rm *resourceManager) ListTickets(name string, datestr string) []TicketResource {
+
+	tickets := rm.tstore.All()
+	var matchName bool
+	var matchDate bool
+	var err error
+
+	result := make([]TicketResource, 0)
+	nexpr := buildExpr(name)
+	dexpr := buildDateExpr(datestr)
+
+	for _, n := range tickets {
+
+		if matchName, err = regexp.Match(nexpr, []byte(n.(TicketResource).Name)); err != nil {
+			return []TicketResource{}
+		}
+
+		if matchDate, err = regexp.Match(dexpr, []byte(n.(TicketResource).Odate)); err != nil {
+			return []TicketResource{}
+		}
+
+		if matchName && matchDate {
+			result = append(result, n.(TicketResource))
+		}
+
+	}
+
+	sort.Sort(ticketSorter{result})
+
+	return result
+}
+
+//Set - change a value of a flag
+func (rm *resourceManager) Set(name string, policy FlagResourcePolicy) (bool, error) {
+	return rm.setFlag(name, policy, "")
+}
+
+//setFlag - change a value of a flag on behalf of a holder, an empty holder means that a flag is set manually
+func (rm *resourceManager) setFlag(name string, policy FlagResourcePolicy, holder string) (bool, error) {
+
+	defer rm.flock.Unlock()
+	rm.flock.Lock()
+
+	return rm.setFlagLocked(name, policy, holder)
+}
+
+//setFlagLocked - change a value of a flag on behalf of a holder, the caller must hold the lock of flags
+func (rm *resourceManager) setFlagLocked(name string, policy FlagResourcePolicy, holder string) (bool, error) {
+
+	var v interface{}
+	var ok bool
+
+	if v, ok = rm.fstore.Get(name); !ok {
+		rm.log.Debug("CREATE FLAG:", name, "COUNT:", 1, "TYPE:", policy, "HOLDER:", holder)
+		rm.fstore.Insert(name, FlagResource{Name: name, Policy: policy, Count: 1, Holders: addHolder(nil, holder)})
+		return true, nil
+	}
+
+	flag := v.(FlagResource)
+	if flag.Policy == FlagPolicyExclusive {
+		rm.log.Debug("ACQ ERR FLAG:", name, "TYPE:", policy, "flag in use with exclusive policy")
+		return false, errors.New("flag in use with exclusive policy")
+	}
+
+	if flag.Policy == FlagPolicyShared && policy == FlagPolicyExclusive && flag.Count != 0 {
+		rm.log.Debug("ACQ ERR FLAG SHR:", name, "TYPE:", flag.Policy, "flag in use with shared,trying exclusive")
+		return false, errors.New("unable to set shared, flag in use with exclusive policy")
+	}
+
+	flag.Count++
+	flag.Policy = policy
+	flag.Holders = addHolder(flag.Holders, holder)
+	rm.log.Debug("ACQ FLAG SUCCESS:", flag.Name, "TYPE:", flag.Policy, "COUNT:", flag.Count, "HOLDER:", holder)
+	rm.fstore.Update(flag.Name, flag)
+
+	return true, nil
+}
+
+//Unset - remove a flag
+func (rm *resourceManager) Unset(name string) (bool, error) {
+
+	ok, err := rm.unsetFlag(name, "")
+	if ok {
+		rm.notifyReleased(name)
+	}
+
+	return ok, err
+}
+
+//unsetFlag - remove a flag on behalf of a holder
+func (rm *resourceManager) unsetFlag(name string, holder string) (bool, error) {
+
+	defer rm.flock.Unlock()
+	rm.flock.Lock()
+
+	return rm.unsetFlagLocked(name, holder)
+}
+
+//unsetFlagLocked - remove a flag on behalf of a holder, the caller must hold the lock of flags
+func (rm *resourceManager) unsetFlagLocked(name string, holder string) (bool, error) {
+
+	var v interface{}
+	var ok bool
+
+	if v, ok = rm.fstore.Get(name); !ok {
+		return false, errors.New("flag with given name does not exists")
+	}
+
+	flag := v.(FlagResource)
+	//a flag acquired before holders were recorded has no holders, it is released by any task
+	if holder != "" && len(flag.Holders) != 0 && !hasHolder(flag.Holders, holder) {
+		return false, errors.New("flag is not held by a given holder")
+	}
+
+	flag.Count--
+	flag.Holders = removeHolder(flag.Holders, holder)
+	rm.log.Debug("UNSET FLAG:", flag.Name, "COUNT:", flag.Count, "HOLDER:", holder)
+	if flag.Count == 0 {
+		rm.fstore.Delete(name)
+		rm.log.Debug("FLAG REMOVED")
+	} else {
+		rm.fstore.Update(flag.Name, flag)
+	}
+
+	return true, nil
+
+}
+
+//ReleaseHolder - forcefully releases a flag held by a task, the release is written to the journal of the task
+func (rm *resourceManager) ReleaseHolder(name, holder, username string) (bool, error) {
+
+	if holder == "" {
+		return false, errors.New("holder is required")
+	}
+
+	if ok, err := rm.unsetFlag(name, holder); !ok {
+		return false, err
+	}
+
+	rm.log.Info("FLAG:", name, "FORCE RELEASED, HOLDER:", holder, "USER:", username)
+	rm.pushJournal(unique.TaskOrderID(holder), fmt.Sprintf(journal.TaskFlagForceReleased, name, username))
+	rm.notifyReleased(name)
+
+	return true, nil
+}
+
+//reconcileFlags - recomputes the state of flags and quantitative resources from resources held by tasks
+//that are starting or executing. Flags that were set manually are left unchanged.
+func (rm *resourceManager) reconcileFlags(data events.RouteFlagReconcileMsg) events.RouteFlagReconcileResponse {
+
+	defer rm.flock.Unlock()
+	rm.flock.Lock()
+
+	type holding struct {
+		policy  FlagResourcePolicy
+		holders []string
+	}
+
+	fixes := []events.FlagReconcileData{}
+	expected := map[string]*holding{}
+	used := map[string]int{}
+
+	for _, h := range data.Holdings {
+		for _, f := range h.Flags {
+			e, ok := expected[f.Name]
+			if !ok {
+				e = &holding{policy: FlagPolicyShared, holders: []string{}}
+				expected[f.Name] = e
+			}
+			if FlagResourcePolicy(f.Policy) == FlagPolicyExclusive {
+				e.policy = FlagPolicyExclusive
+			}
+			e.holders = append(e.holders, string(h.OrderID))
+		}
+		for _, q := range h.Quantities {
+			used[q.Name] += q.Quantity
+		}
+	}
+
+	for _, v := range rm.fstore.All() {
+
+		flag := v.(FlagResource)
+
+		e, ok := expected[flag.Name]
+		if !ok {
+			e = &holding{policy: flag.Policy, holders: []string{}}
+		}
+		delete(expected, flag.Name)
+
+		//a flag acquired before holders were recorded is owned by tasks that hold it, up to its count
+		holders := flag.Holders
+		legacy := len(holders) == 0 && len(e.holders) != 0
+		if legacy {
+			for _, h := range e.holders {
+				if len(holders) < flag.Count {
+					holders = append(holders, h)
+				}
+			}
+		}
+
+		manual := flag.Count - len(holders)
+		if manual < 0 {
+			manual = 0
+		}
+
+		released := holders
+		for _, h := range e.holders {
+			released = removeHolder(released, h)
+		}
+
+		acquired := e.holders
+		for _, h := range holders {
+			acquired = removeHolder(acquired, h)
+		}
+
+		for _, h := range released {
+			fixes = append(fixes, events.FlagReconcileData{OrderID: unique.TaskOrderID(h), Name: flag.Name, Message: fmt.Sprintf(journal.TaskFlagReconciled, flag.Name)})
+		}
+		for _, h := range acquired {
+			fixes = append(fixes, events.FlagReconcileData{OrderID: unique.TaskOrderID(h), Name: flag.Name, Message: fmt.Sprintf(journal.TaskFlagRecovered, flag.Name)})
+		}
+
+		if !legacy && len(released) == 0 && len(acquired) == 0 && manual+len(e.holders) == flag.Count {
+			continue
+		}
+
+		if manual+len(e.holders) == 0 {
+			rm.fstore.Delete(flag.Name)
+			continue
+		}
+
+		if len(e.holders) != 0 {
+			flag.Policy = e.policy
+		}
+
+		flag.Count = manual + len(e.holders)
+		flag.Holders = e.holders
+		rm.fstore.Update(flag.Name, flag)
+	}
+
+	for name, e := range expected {
+		rm.fstore.Insert(name, FlagResource{Name: name, Policy: e.policy, Count: len(e.holders), Holders: e.holders})
+		for _, h := range e.holders {
+			fixes = append(fixes, events.FlagReconcileData{OrderID: unique.TaskOrderID(h), Name: name, Message: fmt.Sprintf(journal.TaskFlagRecovered, name)})
+		}
+	}
+
+	for _, v := range rm.qstore.All() {
+		q := v.(QuantityResource)
+		if q.Used != used[q.Name] {
+			fixes = append(fixes, events.FlagReconcileData{Name: q.Name, Message: fmt.Sprintf("QUANTITY %s USED CHANGED FROM %d TO %d BY RECONCILIATION", q.Name, q.Used, used[q.Name])})
+			q.Used = used[q.Name]
+			rm.qstore.Update(q.Name, q)
+		}
+	}
+
+	sort.Slice(fixes, func(i, j int) bool {
+		if fixes[i].Name != fixes[j].Name {
+			return fixes[i].Name < fixes[j].Name
+		}
+		return fixes[i].OrderID < fixes[j].OrderID
+	})
+
+	names := []string{}
+	for _, f := range fixes {
+		rm.log.Info("RECONCILE:", f.Message, "HOLDER:", f.OrderID)
+		if f.OrderID != "" {
+			rm.pushJournal(f.OrderID, f.Message)
+		}
+		names = append(names, f.Name)
+	}
+	rm.notifyReleased(names...)
+
+	return events.RouteFlagReconcileResponse{Fixes: fixes}
+}
+
+//notifyReleased - informs the pool that flags or quantitative resources were released, so tasks that wait for them are evaluated
+//without waiting for the next cycle
+func (rm *resourceManager) notifyReleased(names ...string) {
+
+	if len(names) == 0 || rm.dispatcher == nil {
+		return
+	}
+
+	rm.dispatcher.PushEvent(nil, events.RouteFlagNotify, events.NewMsg(events.RouteFlagNotifyMsg{Names: names}))
+}
+
+//pushJournal - writes a message to the journal of a task
+func (rm *resourceManager) pushJournal(orderID unique.TaskOrderID, msg string) {
+
+	jmsg := events.RouteJournalMsg{
+		Time:    time.Now(),
+		OrderID: orderID,
+		Msg:     msg,
+	}
+
+	rm.dispatcher.PushEvent(nil, events.RoutTaskJournal, events.NewMsg(jmsg))
+}
+
+//flagReason - describes why a flag cannot be acquired
+func (rm *resourceManager) flagReason(name string, policy FlagResourcePolicy) string {
+
+	defer rm.flock.Unlock()
+	rm.flock.Lock()
+
+	return rm.flagReasonLocked(name, policy)
+}
+
+//flagReasonLocked - describes why a flag cannot be acquired, the caller must hold the lock of flags
+func (rm *resourceManager) flagReasonLocked(name string, policy FlagResourcePolicy) string {
+
+	reason := fmt.Sprintf("waiting for %s flag %s", policy, name)
+
+	v, ok := rm.fstore.Get(name)
+	if !ok {
+		return reason
+	}
+
+	if flag := v.(FlagResource); len(flag.Holders) != 0 {
+		reason += " held by " + strings.Join(flag.Holders, ",")
+	}
+
+	return reason
+}
+
+//DestroyFlag - forcefully removes a flag
+func (rm *resourceManager) DestroyFlag(name string) (bool, error) {
+
+	defer rm.flock.Unlock()
+	rm.flock.Lock()
+
+	var ok bool
+
+	if _, ok = rm.fstore.Get(name); !ok {
+		return false, errors.New("flag with given name does not exists")
+	}
+
+	rm.fstore.Delete(name)
+	rm.notifyReleased(name)
+
+	return true, nil
+
+}
+
+func (rm *resourceManager) ListFlags(name string) []FlagResource {
+
+	var matchName bool
+	var err error
+	flags := rm.fstore.All()
+	result := make([]FlagResource, 0)
+
+	nexpr := buildExpr(name)
+
+	for _, n := range flags {
+
+		if matchName, err = regexp.Match(nexpr, []byte(n.(FlagResource).Name)); err != nil {
+			return []FlagResource{}
+		}
+
+		if matchName {
+			result = append(result, n.(FlagResource))
+		}
+	}
+
+	return result
+}
+
+//SetQuantity - creates a quantitative resource or changes its capacity,
+//if the capacity is lower than the amount currently in use, tasks wait until enough of the resource is released
+func (rm *resourceManager) SetQuantity(name string, capacity int) (bool, error) {
+
+	defer rm.flock.Unlock()
+	rm.flock.Lock()
+
+	if capacity < 1 {
+		return false, errors.New("capacity of a resource must be greater than 0")
+	}
+
+	if v, ok := rm.qstore.Get(name); ok {
+		q := v.(QuantityResource)
+		q.Capacity = capacity
+		rm.qstore.Update(name, q)
+	} else {
+		rm.qstore.Insert(name, QuantityResource{Name: name, Capacity: capacity})
+	}
+
+	rm.log.Info("QUANTITY:", name, "CAPACITY:", capacity)
+	rm.notifyReleased(name)
+
+	return true, nil
+}
+
+//DeleteQuantity - removes a quantitative resource, a resource that is in use cannot be removed
+func (rm *resourceManager) DeleteQuantity(name string) (bool, error) {
+
+	defer rm.flock.Unlock()
+	rm.flock.Lock()
+
+	v, ok := rm.qstore.Get(name)
+	if !ok {
+		return false, errors.New("resource with given name does not exists")
+	}
+
+	if v.(QuantityResource).Used != 0 {
+		return false, errors.New("resource is in use")
+	}
+
+	rm.qstore.Delete(name)
+
+	return true, nil
+}
+
+//ListQuantities - returns a list of quantitative resources that match a given name
+func (rm *resourceManager) ListQuantities(name string) []QuantityResource {
+
+	result := make([]QuantityResource, 0)
+	nexpr := buildExpr(name)
+
+	for _, n := range rm.qstore.All() {
+
+		q := n.(QuantityResource)
+		match, err := regexp.MatchString(nexpr, q.Name)
+		if err != nil {
+			return []QuantityResource{}
+		}
+
+		if match {
+			result = append(result, q)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	return result
+}
+
+//acquireQuantities - acquires all required amounts of quantitative resources or none of them,
+//returns names of resources that do not have enough free capacity and descriptions why they are not available.
+func (rm *resourceManager) acquireQuantities(data []events.QuantityActionData) ([]string, []string) {
+
+	defer rm.flock.Unlock()
+	rm.flock.Lock()
+
+	return rm.acquireQuantitiesLocked(data)
+}
+
+//acquireQuantitiesLocked - acquires all required amounts of quantitative resources or none of them, the caller must hold the lock of flags
+func (rm *resourceManager) acquireQuantitiesLocked(data []events.QuantityActionData) ([]string, []string) {
+
+	missing := []string{}
+	reasons := []string{}
+	required := map[string]int{}
+
+	for _, d := range data {
+		required[d.Name] += d.Quantity
+	}
+
+	names := []string{}
+	for name := range required {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		amount := required[name]
+		v, ok := rm.qstore.Get(name)
+		if !ok {
+			rm.log.Debug("ACQ ERR QUANTITY:", name, "resource does not exists")
+			missing = append(missing, name)
+			reasons = append(reasons, fmt.Sprintf("waiting for quantitative resource %s, resource does not exist", name))
+			continue
+		}
+		if q := v.(QuantityResource); q.Capacity-q.Used < amount {
+			rm.log.Debug("ACQ ERR QUANTITY:", name, "REQUIRED:", amount, "FREE:", q.Capacity-q.Used)
+			missing = append(missing, name)
+			reasons = append(reasons, fmt.Sprintf("waiting for %d of quantitative resource %s, %d of %d in use", amount, name, q.Used, q.Capacity))
+		}
+	}
+
+	if len(missing) != 0 {
+		return missing, reasons
+	}
+
+	for name, amount := range required {
+		v, _ := rm.qstore.Get(name)
+		q := v.(QuantityResource)
+		q.Used += amount
+		rm.log.Debug("ACQ QUANTITY SUCCESS:", name, "USED:", q.Used, "CAPACITY:", q.Capacity)
+		rm.qstore.Update(name, q)
+	}
+
+	return missing, reasons
+}
+
+//releaseQuantities - releases amounts of quantitative resources, returns names of resources that do not exist
+func (rm *resourceManager) releaseQuantities(data []events.QuantityActionData) []string {
+
+	defer rm.flock.Unlock()
+	rm.flock.Lock()
+
+	missing := []string{}
+
+	for _, d := range data {
+		v, ok := rm.qstore.Get(d.Name)
+		if !ok {
+			missing = append(missing, d.Name)
+			continue
+		}
 
-	fstore, err = newStore(frw, rconfig.FlagSource.Sync)
-	if err != nil {
-		return nil, err
+		q := v.(QuantityResource)
+		q.Used -= d.Quantity
+		if q.Used < 0 {
+			q.Used = 0
+		}
+		rm.log.Debug("RELEASE QUANTITY:", q.Name, "USED:", q.Used)
+		rm.qstore.Update(q.Name, q)
 	}
 
-	rm := &resourceManager{
-		log:        log,
-		dispatcher: dispatcher,
-		tstore:     tstore,
-		fstore:     fstore,
-		flock:      sync.Mutex{},
-	}
+	return missing
+}
 
-	//Subscribe for incoming messages about requests for tickets
-	rm.dispatcher.Subscribe(events.RouteTicketCheck, rm)
-	rm.dispatcher.Subscribe(events.RouteTicketIn, rm)
-	rm.dispatcher.Subscribe(events.RouteFlagAcquire, rm)
-	rm.dispatcher.Subscribe(events.RouteFlagRelase, rm)
+//SetVariable - creates a new variable or changes a value of an existing one
+func (rm *resourceManager) SetVariable(variable VariableResource) (bool, error) {
 
-	return rm, nil
-}
+	if err := checkVariableScope(variable.Scope, variable.Group, variable.Task); err != nil {
+		return false, err
+	}
 
-func (rm *resourceManager) Add(name string, odate date.Odate) (bool, error) {
+	key := variableKey(variable.Scope, variable.Group, variable.Task, variable.Name)
 
-	err := rm.tstore.Insert(name+string(odate), TicketResource{Name: name, Odate: odate})
-	if err != nil {
-		return false, errors.New("ticket with given name and odate already exists")
+	if err := rm.vstore.Update(key, variable); err == errKeyNotFound {
+		if err = rm.vstore.Insert(key, variable); err != nil {
+			return false, err
+		}
 	}
-	rm.log.Info("TICKET:", name, odate)
+
+	rm.log.Info("VARIABLE:", variable.Scope, variable.Group, variable.Task, variable.Name)
 
 	return true, nil
 }
-func (rm *resourceManager) Delete(name string, odate date.Odate) (bool, error) {
 
-	key := name + string(odate)
-	if err := rm.tstore.Delete(key); err != nil {
+//DeleteVariable - removes a variable
+func (rm *resourceManager) DeleteVariable(scope VariableScope, group, task, name string) (bool, error) {
+
+	if err := checkVariableScope(scope, group, task); err != nil {
 		return false, err
 	}
 
-	return true, nil
-}
-func (rm *resourceManager) Check(name string, odate date.Odate) bool {
+	if err := rm.vstore.Delete(variableKey(scope, group, task, name)); err != nil {
+		return false, errors.New("variable with given name does not exists")
+	}
 
-	key := name + string(odate)
-	_, ok := rm.tstore.Get(key)
-	return ok
+	return true, nil
 }
 
-//ListTickets - return a list of tickets restricted to given name and odate
-func (rm *resourceManager) ListTickets(name string, datestr string) []TicketResource {
+//ListVariables - returns a list of variables restricted to given scope, group, task and name,
+//if the scope is empty, variables from all scopes are returned
+func (rm *resourceManager) ListVariables(scope VariableScope, group, task, name string) []VariableResource {
 
-	tickets := rm.tstore.All()
-	var matchName bool
-	var matchDate bool
+	var matchGroup, matchTask, matchName bool
 	var err error
 
-	result := make([]TicketResource, 0)
+	result := make([]VariableResource, 0)
+	gexpr := buildExpr(group)
+	texpr := buildExpr(task)
 	nexpr := buildExpr(name)
-	dexpr := buildDateExpr(datestr)
 
-	for _, n := range tickets {
+	for _, n := range rm.vstore.All() {
 
-		if matchName, err = regexp.Match(nexpr, []byte(n.(TicketResource).Name)); err != nil {
-			return []TicketResource{}
+		v := n.(VariableResource)
+
+		if scope != "" && v.Scope != scope {
+			continue
 		}
 
-		if matchDate, err = regexp.Match(dexpr, []byte(n.(TicketResource).Odate)); err != nil {
-			return []TicketResource{}
+		if matchGroup, err = regexp.MatchString(gexpr, v.Group); err != nil {
+			return []VariableResource{}
 		}
 
-		if matchName && matchDate {
-			result = append(result, n.(TicketResource))
+		if matchTask, err = regexp.MatchString(texpr, v.Task); err != nil {
+			return []VariableResource{}
 		}
 
+		if matchName, err = regexp.MatchString(nexpr, v.Name); err != nil {
+			return []VariableResource{}
+		}
+
+		if matchGroup && matchTask && matchName {
+			result = append(result, v)
+		}
 	}
 
-	sort.Sort(ticketSorter{result})
+	sort.Sort(variableSorter{result})
 
 	return result
 }
 
-//Set - change a value of a flag
-func (rm *resourceManager) Set(name string, policy FlagResourcePolicy) (bool, error) {
-
-	defer rm.flock.Unlock()
-	rm.flock.Lock()
+//ScopeVariables - returns variables defined exactly in given scope
+func (rm *resourceManager) ScopeVariables(scope VariableScope, group, task string) types.EnvironmentVariableList {
 
-	var v interface{}
-	var ok bool
+	result := types.EnvironmentVariableList{}
+	vars := []VariableResource{}
 
-	if v, ok = rm.fstore.Get(name); !ok {
-		rm.log.Debug("CREATE FLAG:", name, "COUNT:", 1, "TYPE:", policy)
-		rm.fstore.Insert(name, FlagResource{Name: name, Policy: policy, Count: 1})
-		return true, nil
+	for _, n := range rm.vstore.All() {
+		v := n.(VariableResource)
+		if v.Scope == scope && v.Group == group && v.Task == task {
+			vars = append(vars, v)
+		}
 	}
 
-	flag := v.(FlagResource)
-	if flag.Policy == FlagPolicyExclusive {
-		rm.log.Debug("ACQ ERR FLAG:", name, "TYPE:", policy, "flag in use with exclusive policy")
-		return false, errors.New("flag in use with exclusive policy")
-	}
+	sort.Sort(variableSorter{vars})
 
-	if flag.Policy == FlagPolicyShared && policy == FlagPolicyExclusive && flag.Count != 0 {
-		rm.log.Debug("ACQ ERR FLAG SHR:", name, "TYPE:", flag.Policy, "flag in use with shared,trying exclusive")
-		return false, errors.New("unable to set shared, flag in use with exclusive policy")
+	for _, v := range vars {
+		result = append(result, types.EnvironmentVariable{Name: v.Name, Value: v.Value})
 	}
 
-	flag.Count++
-	flag.Policy = policy
-	rm.log.Debug("ACQ FLAG SUCCESS:", flag.Name, "TYPE:", flag.Policy, "COUNT:", flag.Count)
-	rm.fstore.Update(flag.Name, flag)
-
-	return true, nil
+	return result
 }
 
-//Unset - remove a flag
-func (rm *resourceManager) Unset(name string) (bool, error) {
+//SetSecret - encrypts and stores a secret, if the secret exists its value is replaced
+func (rm *resourceManager) SetSecret(name, value string) (bool, error) {
 
-	defer rm.flock.Unlock()
-	rm.flock.Lock()
+	sealed, err := sealSecret(rm.aead, name, value)
+	if err != nil {
+		return false, err
+	}
 
-	var v interface{}
-	var ok bool
+	secret := SecretResource{Name: name, Value: sealed}
 
-	if v, ok = rm.fstore.Get(name); !ok {
-		return false, errors.New("flag with given name does not exists")
+	if err := rm.sstore.Update(name, secret); err == errKeyNotFound {
+		if err = rm.sstore.Insert(name, secret); err != nil {
+			return false, err
+		}
 	}
 
-	flag := v.(FlagResource)
-	flag.Count--
-	rm.log.Debug("UNSET FLAG:", flag.Name, "COUNT:", flag.Count)
-	if flag.Count == 0 {
-		rm.fstore.Delete(name)
-		rm.log.Debug("FLAG REMOVED")
-	} else {
-		rm.fstore.Update(flag.Name, flag)
-	}
+	rm.log.Info("SECRET:", name)
 
 	return true, nil
+}
+
+//DeleteSecret - removes a secret
+func (rm *resourceManager) DeleteSecret(name string) (bool, error) {
+
+	if err := rm.sstore.Delete(name); err != nil {
+		return false, errors.New("secret with given name does not exists")
+	}
 
+	return true, nil
 }
 
-//DestroyFlag - forcefully removes a flag
-func (rm *resourceManager) DestroyFlag(name string) (bool, error) {
+//ListSecrets - returns names of secrets that match a given name, values of secrets are never returned
+func (rm *resourceManager) ListSecrets(name string) []string {
 
-	defer rm.flock.Unlock()
-	rm.flock.Lock()
+	result := make([]string, 0)
+	expr := buildExpr(name)
 
-	var ok bool
+	for _, n := range rm.sstore.All() {
 
-	if _, ok = rm.fstore.Get(name); !ok {
-		return false, errors.New("flag with given name does not exists")
-	}
+		s := n.(SecretResource)
+		match, err := regexp.MatchString(expr, s.Name)
+		if err != nil {
+			return []string{}
+		}
 
-	rm.fstore.Delete(name)
+		if match {
+			result = append(result, s.Name)
+		}
+	}
 
-	return true, nil
+	sort.Strings(result)
 
+	return result
 }
 
-func (rm *resourceManager) ListFlags(name string) []FlagResource {
+//Secret - returns a decrypted value of a secret. Implementation of converter.SecretReader
+func (rm *resourceManager) Secret(name string) (string, bool, error) {
 
-	var matchName bool
-	var err error
-	flags := rm.fstore.All()
-	result := make([]FlagResource, 0)
+	item, ok := rm.sstore.Get(name)
+	if !ok {
+		return "", false, nil
+	}
 
-	nexpr := buildExpr(name)
+	s := item.(SecretResource)
+	value, err := openSecret(rm.aead, s.Name, s.Value)
+	if err != nil {
+		return "", true, err
+	}
 
-	for _, n := range flags {
+	return value, true, nil
+}
 
-		if matchName, err = regexp.Match(nexpr, []byte(n.(FlagResource).Name)); err != nil {
-			return []FlagResource{}
-		}
+//MaskSecrets - replaces values of all stored secrets in a given text with a mask
+func (rm *resourceManager) MaskSecrets(in string) string {
 
-		if matchName {
-			result = append(result, n.(FlagResource))
+	values := []string{}
+
+	for _, n := range rm.sstore.All() {
+		s := n.(SecretResource)
+		if value, err := openSecret(rm.aead, s.Name, s.Value); err == nil && value != "" {
+			values = append(values, value)
 		}
 	}
 
-	return result
+	//longer values are replaced first, so a secret that is a part of another one does not reveal the rest of it
+	sort.Slice(values, func(i, j int) bool { return len(values[i]) > len(values[j]) })
+
+	for _, v := range values {
+		in = strings.ReplaceAll(in, v, SecretMask)
+	}
+
+	return in
 }
 
 //Start - starts the task pool
 func (rm *resourceManager) Start() error {
 
 	rm.tstore.start()
+	rm.astore.start()
 	rm.fstore.start()
+	rm.qstore.start()
+	rm.vstore.start()
+	rm.sstore.start()
 	return nil
 }
 
@@ -264,7 +1224,11 @@ func (rm *resourceManager) Start() error {
 func (rm *resourceManager) Shutdown() error {
 
 	rm.tstore.shutdown()
+	rm.astore.shutdown()
 	rm.fstore.shutdown()
+	rm.qstore.shutdown()
+	rm.vstore.shutdown()
+	rm.sstore.shutdown()
 
 	return nil
 }
@@ -327,6 +1291,30 @@ func (rm *resourceManager) Process(receiver events.EventReceiver, route events.R
 			result := rm.processReleaseFlag(data)
 			events.ResponseToReceiver(receiver, result)
 		}
+	case events.RouteFlagReconcile:
+		{
+			data, ok := msg.Message().(events.RouteFlagReconcileMsg)
+			if !ok {
+				rm.log.Error("ResourceManager: route processing error, unexpected msg format")
+				events.ResponseToReceiver(receiver, events.ErrUnrecognizedMsgFormat)
+				return
+			}
+
+			result := rm.reconcileFlags(data)
+			events.ResponseToReceiver(receiver, result)
+		}
+	case events.RouteTicketUnwatch:
+		{
+			data, ok := msg.Message().(events.RouteTicketUnwatchMsg)
+			if !ok {
+				rm.log.Error("ResourceManager: route processing error, unexpected msg format")
+				events.ResponseToReceiver(receiver, events.ErrUnrecognizedMsgFormat)
+				return
+			}
+
+			rm.unwatchTickets(data.OrderID)
+			events.ResponseToReceiver(receiver, data)
+		}
 	default:
 		{
 			err := events.ErrInvalidRouteName
@@ -338,7 +1326,20 @@ func (rm *resourceManager) Process(receiver events.EventReceiver, route events.R
 }
 func (rm *resourceManager) processCheckTicketEvent(data events.RouteTicketCheckMsgFormat) {
 
+	//a task is registered before tickets are checked, so a ticket added in the meantime is not missed
+	if data.OrderID != "" {
+		tickets := make([]ticketRange, len(data.Tickets))
+		for idx, d := range data.Tickets {
+			tickets[idx] = watchedTicket(d.Name, d.Odate)
+		}
+		rm.watchTickets(data.OrderID, tickets)
+	}
+
 	for idx, d := range data.Tickets {
+		if odates, ok := date.ParseRange(d.Odate); ok {
+			data.Tickets[idx].Fulfilled = rm.CheckRange(d.Name, odates)
+			continue
+		}
 		data.Tickets[idx].Fulfilled = rm.Check(d.Name, date.Odate(d.Odate))
 	}
 }
@@ -346,7 +1347,12 @@ func (rm *resourceManager) processCheckTicketEvent(data events.RouteTicketCheckM
 func (rm *resourceManager) processAcquireFlag(data events.RouteFlagAcquireMsg) events.RouteFlagActionResponse {
 
 	var requiredFlagNames []string = []string{}
+	var reasons []string = []string{}
 	ok := true
+	rolledBack := []string{}
+
+	//flags and quantities are acquired under one lock, so a concurrent acquire never sees a half acquired state
+	rm.flock.Lock()
 
 	aflags := []struct {
 		Name   string
@@ -356,8 +1362,9 @@ func (rm *resourceManager) processAcquireFlag(data events.RouteFlagAcquireMsg) e
 	for _, f := range data.Flags {
 		policy := FlagResourcePolicy(f.Policy)
 		// if flag is not acquired, rollback changes that were made
-		if ok, _ = rm.Set(f.Name, policy); !ok {
+		if ok, _ = rm.setFlagLocked(f.Name, policy, string(data.OrderID)); !ok {
 			requiredFlagNames = append(requiredFlagNames, f.Name)
+			reasons = append(reasons, rm.flagReasonLocked(f.Name, policy))
 			break
 		}
 		aflags = append(aflags, struct {
@@ -366,44 +1373,71 @@ func (rm *resourceManager) processAcquireFlag(data events.RouteFlagAcquireMsg) e
 		}{Name: f.Name, Policy: policy})
 	}
 
+	if ok {
+		if missing, why := rm.acquireQuantitiesLocked(data.Quantities); len(missing) != 0 {
+			requiredFlagNames = append(requiredFlagNames, missing...)
+			reasons = append(reasons, why...)
+			ok = false
+		}
+	}
+
 	if !ok {
 
 		for _, f := range aflags {
-			rm.Unset(f.Name)
+			if released, _ := rm.unsetFlagLocked(f.Name, string(data.OrderID)); released {
+				rolledBack = append(rolledBack, f.Name)
+			}
 		}
 	}
 
-	return events.RouteFlagActionResponse{Success: ok, Names: requiredFlagNames}
+	rm.flock.Unlock()
+	rm.notifyReleased(rolledBack...)
+
+	return events.RouteFlagActionResponse{Success: ok, Names: requiredFlagNames, Reasons: reasons}
 }
 
 func (rm *resourceManager) processReleaseFlag(data events.RouteFlagAcquireMsg) events.RouteFlagActionResponse {
 
 	var flagNames []string = []string{}
 	var success bool = true
+	released := []string{}
 
 	for _, f := range data.Flags {
-		if ok, _ := rm.Unset(f.Name); !ok {
+		if ok, _ := rm.unsetFlag(f.Name, string(data.OrderID)); !ok {
 			flagNames = append(flagNames, f.Name)
 			success = success && false
 		} else {
 			success = success && true
+			released = append(released, f.Name)
 		}
 	}
 
+	if missing := rm.releaseQuantities(data.Quantities); len(missing) != 0 {
+		flagNames = append(flagNames, missing...)
+		success = false
+	}
+
+	for _, q := range data.Quantities {
+		released = append(released, q.Name)
+	}
+	rm.notifyReleased(released...)
+
 	return events.RouteFlagActionResponse{Success: success, Names: flagNames}
 }
 
 func (rm *resourceManager) processInTicketEvent(data events.RouteTicketInMsgFormat) {
 
+	origin := TicketOrigin{OrderID: string(data.OrderID), ExecutionID: data.ExecutionID}
+
 	for _, item := range data.Tickets {
 		if item.Action == taskdef.OutActionAdd {
-			_, err := rm.Add(item.Name, item.Odate)
+			_, err := rm.AddTicket(item.Name, item.Odate, item.Keep, origin)
 			if err != nil {
 				rm.log.Error(err)
 			}
 		}
 		if item.Action == taskdef.OutActionRemove {
-			_, err := rm.Delete(item.Name, item.Odate)
+			_, err := rm.DeleteTicket(item.Name, item.Odate, origin)
 			if err != nil {
 				rm.log.Error(err)
 			}
@@ -411,6 +1445,177 @@ func (rm *resourceManager) processInTicketEvent(data events.RouteTicketInMsgForm
 	}
 }
 
+//watchedTicket - returns a ticket that a task waits for, a ticket with a single odate is a range with equal ends
+func watchedTicket(name, odate string) ticketRange {
+
+	if odates, ok := date.ParseRange(odate); ok {
+		return ticketRange{name: name, odates: odates}
+	}
+
+	return ticketRange{name: name, odates: date.OdateRange{From: date.Odate(odate), To: date.Odate(odate)}}
+}
+
+//key - returns a key of a watched ticket, a key of a ticket with a single odate is the same as a key of a ticket in the store
+func (t ticketRange) key() string {
+
+	if t.odates.From == t.odates.To {
+		return t.name + string(t.odates.From)
+	}
+
+	return t.name + t.odates.String()
+}
+
+//watchTickets - registers a task that waits for given tickets, previous registration of the task is replaced
+func (rm *resourceManager) watchTickets(orderID unique.TaskOrderID, tickets []ticketRange) {
+
+	defer rm.wlock.Unlock()
+	rm.wlock.Lock()
+
+	rm.unwatch(orderID)
+
+	keys := make([]string, 0, len(tickets))
+	for _, t := range tickets {
+		key := t.key()
+		w, ok := rm.watchers[key]
+		if !ok {
+			w = map[unique.TaskOrderID]struct{}{}
+			rm.watchers[key] = w
+		}
+		w[orderID] = struct{}{}
+		if t.odates.From != t.odates.To {
+			rm.ranges[key] = t
+		}
+		keys = append(keys, key)
+	}
+	rm.watched[orderID] = keys
+}
+
+//unwatchTickets - removes a registration of a task that no longer waits for tickets
+func (rm *resourceManager) unwatchTickets(orderID unique.TaskOrderID) {
+
+	defer rm.wlock.Unlock()
+	rm.wlock.Lock()
+
+	rm.unwatch(orderID)
+}
+
+func (rm *resourceManager) unwatch(orderID unique.TaskOrderID) {
+
+	for _, key := range rm.watched[orderID] {
+		delete(rm.watchers[key], orderID)
+		if len(rm.watchers[key]) == 0 {
+			delete(rm.watchers, key)
+			delete(rm.ranges, key)
+		}
+	}
+	delete(rm.watched, orderID)
+}
+
+//notifyWatchers - wakes tasks that wait for given tickets or for ranges of odates that contain them,
+//a notification is sent only once, woken tasks register again when they check their tickets.
+func (rm *resourceManager) notifyWatchers(tickets ...TicketResource) {
+
+	rm.wlock.Lock()
+
+	woken := map[unique.TaskOrderID]struct{}{}
+	for _, t := range tickets {
+		for orderID := range rm.watchers[t.Name+string(t.Odate)] {
+			woken[orderID] = struct{}{}
+		}
+		for key, r := range rm.ranges {
+			if r.name != t.Name || !r.odates.Contains(t.Odate) {
+				continue
+			}
+			for orderID := range rm.watchers[key] {
+				woken[orderID] = struct{}{}
+			}
+		}
+	}
+
+	msg := events.RouteTicketNotifyMsg{OrderIDs: make([]unique.TaskOrderID, 0, len(woken))}
+	for orderID := range woken {
+		rm.unwatch(orderID)
+		msg.OrderIDs = append(msg.OrderIDs, orderID)
+	}
+
+	rm.wlock.Unlock()
+
+	if len(msg.OrderIDs) == 0 {
+		return
+	}
+
+	rm.log.Debug("TICKET NOTIFY:", msg.OrderIDs)
+	rm.dispatcher.PushEvent(nil, events.RouteTicketNotify, events.NewMsg(msg))
+}
+
+//checkVariableScope - checks if group and task are consistent with the scope of a variable
+func checkVariableScope(scope VariableScope, group, task string) error {
+
+	switch scope {
+	case VariableScopeGlobal:
+		if group == "" && task == "" {
+			return nil
+		}
+	case VariableScopeGroup:
+		if group != "" && task == "" {
+			return nil
+		}
+	case VariableScopeTask:
+		if group != "" && task != "" {
+			return nil
+		}
+	}
+
+	return ErrInvalidVariableScope
+}
+
+func variableKey(scope VariableScope, group, task, name string) string {
+	return string(scope) + "@" + group + "@" + task + "@" + name
+}
+
+//addHolder - adds a holder to the list of holders of a flag
+func addHolder(holders []string, holder string) []string {
+
+	if holders == nil {
+		holders = []string{}
+	}
+
+	if holder == "" {
+		return holders
+	}
+
+	return append(holders, holder)
+}
+
+//hasHolder - checks if a holder is on the list of holders of a flag
+func hasHolder(holders []string, holder string) bool {
+
+	for _, h := range holders {
+		if h == holder {
+			return true
+		}
+	}
+
+	return false
+}
+
+//removeHolder - removes the first occurrence of a holder from the list of holders of a flag
+func removeHolder(holders []string, holder string) []string {
+
+	result := []string{}
+	removed := false
+
+	for _, h := range holders {
+		if h == holder && !removed {
+			removed = true
+			continue
+		}
+		result = append(result, h)
+	}
+
+	return result
+}
+
 func buildExpr(value string) string {
 
 	expr := ""
@@ -2,8 +2,10 @@ package resources
 
 import (
 	"encoding/json"
+	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/przebro/overseer/common/logger"
 	"github.com/przebro/overseer/common/types/date"
@@ -11,6 +13,7 @@ import (
 	"github.com/przebro/overseer/overseer/config"
 	"github.com/przebro/overseer/overseer/internal/events"
 	"github.com/przebro/overseer/overseer/internal/taskdef"
+	"github.com/przebro/overseer/overseer/internal/unique"
 )
 
 var mlog = logger.NewTestLogger()
@@ -19,8 +22,11 @@ var mdispatcher mockDispacher = mockDispacher{}
 var testManager ResourceManager
 
 var manResConfig config.ResourcesConfigurartion = config.ResourcesConfigurartion{
-	TicketSource: config.ResourceEntry{Sync: 1, Collection: "mresources"},
-	FlagSource:   config.ResourceEntry{Sync: 1, Collection: "mresources"},
+	TicketSource:   config.ResourceEntry{Sync: 1, Collection: "mresources"},
+	FlagSource:     config.ResourceEntry{Sync: 1, Collection: "mresources"},
+	QuantitySource: config.ResourceEntry{Sync: 1, Collection: "mresources"},
+	VariableSource: config.ResourceEntry{Sync: 1, Collection: "mresources"},
+	SecretSource:   config.SecretEntry{Sync: 1, Collection: "mresources", Key: "WBdumgVKBK4iTB+CR2Z2meseDrlnrg54QDSAPcFswWU="},
 }
 var manStoreConfig config.StoreProviderConfiguration = config.StoreProviderConfiguration{
 	Store: []config.StoreConfiguration{
@@ -188,6 +194,7 @@ func TestDispatch_Route_RouteTicketIn(t *testing.T) {
 			Name   string
 			Odate  date.Odate
 			Action taskdef.OutAction
+			Keep   bool
 		}{
 			{Name: "TEST_MSG_TICKET_01", Action: taskdef.OutActionAdd},
 			{Name: "TEST_MSG_TICKET_02", Action: taskdef.OutActionAdd},
@@ -235,6 +242,244 @@ func TestDispatch_Route_RouteAcquireFlag(t *testing.T) {
 	}
 }
 
+func TestDispatch_Route_RouteAcquireQuantity(t *testing.T) {
+
+	testman := testManager.(*resourceManager)
+
+	if _, err := testman.SetQuantity("TEST_QUANTITY_01", 3); err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+
+	acquire := func(msg events.RouteFlagAcquireMsg, route events.RouteName) events.RouteFlagActionResponse {
+		receiver := events.NewFlagActionReceiver()
+		go testman.Process(receiver, route, events.NewMsg(msg))
+		result, err := receiver.WaitForResult()
+		if err != nil {
+			t.Fatal("unexpected result:", err)
+		}
+		return result
+	}
+
+	msg := events.RouteFlagAcquireMsg{
+		Flags:      []events.FlagActionData{{Name: "TEST_FLAG_QNT_01", Policy: 0}},
+		Quantities: []events.QuantityActionData{{Name: "TEST_QUANTITY_01", Quantity: 2}},
+	}
+
+	if result := acquire(msg, events.RouteFlagAcquire); !result.Success {
+		t.Error("unexpected result:", result)
+	}
+
+	msg2 := events.RouteFlagAcquireMsg{
+		Flags:      []events.FlagActionData{{Name: "TEST_FLAG_QNT_02", Policy: 0}},
+		Quantities: []events.QuantityActionData{{Name: "TEST_QUANTITY_01", Quantity: 2}},
+	}
+
+	result := acquire(msg2, events.RouteFlagAcquire)
+	if result.Success || len(result.Names) != 1 || result.Names[0] != "TEST_QUANTITY_01" {
+		t.Error("unexpected result:", result)
+	}
+
+	//flags acquired together with the quantity are released when the quantity is not available
+	if flags := testman.ListFlags("TEST_FLAG_QNT_02"); len(flags) != 0 {
+		t.Error("unexpected result:", flags)
+	}
+
+	if _, err := testman.DeleteQuantity("TEST_QUANTITY_01"); err == nil {
+		t.Error("unexpected result, resource in use deleted")
+	}
+
+	if result := acquire(msg, events.RouteFlagRelase); !result.Success {
+		t.Error("unexpected result:", result)
+	}
+
+	if result := acquire(msg2, events.RouteFlagAcquire); !result.Success {
+		t.Error("unexpected result:", result)
+	}
+
+	q := testman.ListQuantities("TEST_QUANTITY_01")
+	if len(q) != 1 || q[0].Used != 2 || q[0].Capacity != 3 {
+		t.Error("unexpected result:", q)
+	}
+
+	acquire(msg2, events.RouteFlagRelase)
+
+	msg3 := events.RouteFlagAcquireMsg{
+		Quantities: []events.QuantityActionData{{Name: "TEST_QUANTITY_NOT_EXISTS", Quantity: 1}},
+	}
+
+	if result := acquire(msg3, events.RouteFlagAcquire); result.Success || len(result.Reasons) != 1 {
+		t.Error("unexpected result:", result)
+	}
+
+	if _, err := testman.SetQuantity("TEST_QUANTITY_01", 0); err == nil {
+		t.Error("unexpected result, invalid capacity")
+	}
+
+	if _, err := testman.DeleteQuantity("TEST_QUANTITY_01"); err != nil {
+		t.Error("unexpected result:", err)
+	}
+}
+
+func TestDispatch_Route_RouteAcquireFlagHolders(t *testing.T) {
+
+	testman := testManager.(*resourceManager)
+
+	acquire := func(msg events.RouteFlagAcquireMsg, route events.RouteName) events.RouteFlagActionResponse {
+		receiver := events.NewFlagActionReceiver()
+		go testman.Process(receiver, route, events.NewMsg(msg))
+		result, err := receiver.WaitForResult()
+		if err != nil {
+			t.Fatal("unexpected result:", err)
+		}
+		return result
+	}
+
+	msg := events.RouteFlagAcquireMsg{OrderID: "0A3F", Flags: []events.FlagActionData{{Name: "TEST_FLAG_HOLDER", Policy: 1}}}
+	if result := acquire(msg, events.RouteFlagAcquire); !result.Success {
+		t.Error("unexpected result:", result)
+	}
+
+	flags := testman.ListFlags("TEST_FLAG_HOLDER")
+	if len(flags) != 1 || len(flags[0].Holders) != 1 || flags[0].Holders[0] != "0A3F" {
+		t.Error("unexpected result:", flags)
+	}
+
+	msg2 := events.RouteFlagAcquireMsg{OrderID: "0B11", Flags: []events.FlagActionData{{Name: "TEST_FLAG_HOLDER", Policy: 1}}}
+	result := acquire(msg2, events.RouteFlagAcquire)
+	if result.Success || len(result.Names) != 1 || result.Names[0] != "TEST_FLAG_HOLDER" {
+		t.Error("unexpected result:", result)
+	}
+
+	expected := "waiting for EXL flag TEST_FLAG_HOLDER held by 0A3F"
+	if len(result.Reasons) != 1 || result.Reasons[0] != expected {
+		t.Error("unexpected result:", result.Reasons, "expected:", expected)
+	}
+
+	acquire(msg, events.RouteFlagRelase)
+
+	if result := acquire(msg2, events.RouteFlagAcquire); !result.Success {
+		t.Error("unexpected result:", result)
+	}
+
+	flags = testman.ListFlags("TEST_FLAG_HOLDER")
+	if len(flags) != 1 || len(flags[0].Holders) != 1 || flags[0].Holders[0] != "0B11" {
+		t.Error("unexpected result:", flags)
+	}
+
+	acquire(msg2, events.RouteFlagRelase)
+
+	if flags = testman.ListFlags("TEST_FLAG_HOLDER"); len(flags) != 0 {
+		t.Error("unexpected result:", flags)
+	}
+}
+
+func TestDispatch_Route_RouteFlagReconcile(t *testing.T) {
+
+	testman := testManager.(*resourceManager)
+
+	reconcile := func(msg events.RouteFlagReconcileMsg) events.RouteFlagReconcileResponse {
+		receiver := events.NewFlagReconcileReceiver()
+		go testman.Process(receiver, events.RouteFlagReconcile, events.NewMsg(msg))
+		result, err := receiver.WaitForResult()
+		if err != nil {
+			t.Fatal("unexpected result:", err)
+		}
+		return result
+	}
+
+	//a flag leaked by a task that is no longer running, a flag set manually and a flag held by a running task
+	testman.setFlag("TEST_FLAG_LEAK", FlagPolicyExclusive, "00001")
+	testman.Set("TEST_FLAG_MANUAL", FlagPolicyShared)
+	testman.setFlag("TEST_FLAG_MANUAL", FlagPolicyShared, "00001")
+	testman.setFlag("TEST_FLAG_RUN", FlagPolicyShared, "00002")
+
+	if _, err := testman.SetQuantity("TEST_QUANTITY_LEAK", 2); err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+	testman.acquireQuantities([]events.QuantityActionData{{Name: "TEST_QUANTITY_LEAK", Quantity: 2}})
+
+	msg := events.RouteFlagReconcileMsg{Holdings: []events.RouteFlagAcquireMsg{
+		{OrderID: "00002", Flags: []events.FlagActionData{{Name: "TEST_FLAG_RUN", Policy: 0}, {Name: "TEST_FLAG_LOST", Policy: 1}}},
+	}}
+
+	result := reconcile(msg)
+	if len(result.Fixes) != 4 {
+		t.Error("unexpected result:", result.Fixes)
+	}
+
+	if flags := testman.ListFlags("TEST_FLAG_LEAK"); len(flags) != 0 {
+		t.Error("unexpected result:", flags)
+	}
+
+	flags := testman.ListFlags("TEST_FLAG_MANUAL")
+	if len(flags) != 1 || flags[0].Count != 1 || len(flags[0].Holders) != 0 {
+		t.Error("unexpected result:", flags)
+	}
+
+	flags = testman.ListFlags("TEST_FLAG_LOST")
+	if len(flags) != 1 || flags[0].Policy != FlagPolicyExclusive || flags[0].Holders[0] != "00002" {
+		t.Error("unexpected result:", flags)
+	}
+
+	if q := testman.ListQuantities("TEST_QUANTITY_LEAK"); len(q) != 1 || q[0].Used != 0 {
+		t.Error("unexpected result:", q)
+	}
+
+	if result = reconcile(msg); len(result.Fixes) != 0 {
+		t.Error("unexpected result:", result.Fixes)
+	}
+
+	if _, err := testman.ReleaseHolder("TEST_FLAG_RUN", "00001", "user"); err == nil {
+		t.Error("unexpected result, flag released by a task that does not hold it")
+	}
+
+	if ok, err := testman.ReleaseHolder("TEST_FLAG_RUN", "00002", "user"); !ok || err != nil {
+		t.Error("unexpected result:", ok, err)
+	}
+
+	if flags = testman.ListFlags("TEST_FLAG_RUN"); len(flags) != 0 {
+		t.Error("unexpected result:", flags)
+	}
+
+	testman.ReleaseHolder("TEST_FLAG_LOST", "00002", "user")
+	testman.DestroyFlag("TEST_FLAG_MANUAL")
+	testman.DeleteQuantity("TEST_QUANTITY_LEAK")
+}
+
+func TestFlagLegacyHolders(t *testing.T) {
+
+	testman := testManager.(*resourceManager)
+
+	//flags acquired before holders were recorded
+	testman.fstore.Insert("TEST_FLAG_LEGACY", FlagResource{Name: "TEST_FLAG_LEGACY", Policy: FlagPolicyShared, Count: 2})
+	testman.fstore.Insert("TEST_FLAG_LEGACY_REL", FlagResource{Name: "TEST_FLAG_LEGACY_REL", Policy: FlagPolicyExclusive, Count: 1})
+
+	msg := events.RouteFlagReconcileMsg{Holdings: []events.RouteFlagAcquireMsg{
+		{OrderID: "00003", Flags: []events.FlagActionData{{Name: "TEST_FLAG_LEGACY", Policy: 0}}},
+		{OrderID: "00004", Flags: []events.FlagActionData{{Name: "TEST_FLAG_LEGACY", Policy: 0}}},
+	}}
+
+	if result := testman.reconcileFlags(msg); len(result.Fixes) != 0 {
+		t.Error("unexpected result:", result.Fixes)
+	}
+
+	flags := testman.ListFlags("TEST_FLAG_LEGACY")
+	if len(flags) != 1 || flags[0].Count != 2 || len(flags[0].Holders) != 2 {
+		t.Error("unexpected result, holders of a legacy flag counted twice:", flags)
+	}
+
+	if ok, err := testman.unsetFlag("TEST_FLAG_LEGACY_REL", "00005"); !ok || err != nil {
+		t.Error("unexpected result, legacy flag not released:", ok, err)
+	}
+
+	if flags = testman.ListFlags("TEST_FLAG_LEGACY_REL"); len(flags) != 0 {
+		t.Error("unexpected result:", flags)
+	}
+
+	testman.unsetFlag("TEST_FLAG_LEGACY", "00003")
+	testman.unsetFlag("TEST_FLAG_LEGACY", "00004")
+}
+
 func TestDispatch_Route_RouteFlagAcquire_Errors(t *testing.T) {
 
 	var testman *resourceManager
@@ -466,3 +711,376 @@ func TestBuildDateExpr(t *testing.T) {
 	}
 
 }
+
+func TestPurgeTickets(t *testing.T) {
+
+	testman := testManager.(*resourceManager)
+
+	if n := testman.PurgeTickets("20201120"); n != 0 {
+		t.Error("unexpected result, tickets purged without retention:", n)
+	}
+
+	testman.retention = 5
+	defer func() { testman.retention = 0 }()
+
+	testman.Add("TEST_PURGE_01", "20201110")
+	testman.Add("TEST_PURGE_02", "20201118")
+	testman.Add("TEST_PURGE_03", date.OdateNone)
+	testman.AddTicket("TEST_PURGE_04", "20201110", true, TicketOrigin{})
+	testman.Add("TEST_PURGE_05", "20201109")
+
+	if _, err := testman.KeepTicket("TEST_PURGE_05", "20201110", true); err == nil {
+		t.Error("unexpected result, ticket does not exist")
+	}
+
+	if ok, err := testman.KeepTicket("TEST_PURGE_05", "20201109", true); !ok || err != nil {
+		t.Error("unexpected result:", ok, err)
+	}
+
+	if ok, err := testman.KeepTicket("TEST_PURGE_04", "20201110", false); !ok || err != nil {
+		t.Error("unexpected result:", ok, err)
+	}
+
+	if n := testman.PurgeTickets("20201120"); n != 2 {
+		t.Error("unexpected result, expected 2 purged tickets, actual:", n)
+	}
+
+	if testman.Check("TEST_PURGE_01", "20201110") || testman.Check("TEST_PURGE_04", "20201110") {
+		t.Error("unexpected result, expired ticket not purged")
+	}
+
+	if !testman.Check("TEST_PURGE_02", "20201118") || !testman.Check("TEST_PURGE_03", date.OdateNone) || !testman.Check("TEST_PURGE_05", "20201109") {
+		t.Error("unexpected result, ticket purged")
+	}
+
+	testman.Delete("TEST_PURGE_02", "20201118")
+	testman.Delete("TEST_PURGE_03", date.OdateNone)
+	testman.Delete("TEST_PURGE_05", "20201109")
+}
+
+func TestTicketAudit(t *testing.T) {
+
+	testman := testManager.(*resourceManager)
+
+	receiver := events.NewTicketInReceiver()
+	msg := events.RouteTicketInMsgFormat{
+		OrderID:     "00010",
+		ExecutionID: "execution_01",
+		Tickets: []struct {
+			Name   string
+			Odate  date.Odate
+			Action taskdef.OutAction
+			Keep   bool
+		}{
+			{Name: "TEST_AUDIT_01", Odate: "20201120", Action: taskdef.OutActionAdd},
+		},
+	}
+
+	go testman.Process(receiver, events.RouteTicketIn, events.NewMsg(msg))
+	if _, err := receiver.WaitForResult(); err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+
+	ticket, ok := testman.GetTicket("TEST_AUDIT_01", "20201120")
+	if !ok || ticket.Origin.OrderID != "00010" || ticket.Origin.ExecutionID != "execution_01" || ticket.Created.IsZero() {
+		t.Error("unexpected result:", ticket)
+	}
+
+	if _, err := testman.DeleteTicket("TEST_AUDIT_01", "20201120", TicketOrigin{Username: "user"}); err != nil {
+		t.Error("unexpected result:", err)
+	}
+
+	entries := testman.TicketAudit("TEST_AUDIT_01", "")
+	if len(entries) != 2 {
+		t.Fatal("unexpected result:", entries)
+	}
+
+	if entries[0].Action != TicketAuditAdd || entries[0].Origin.String() != "task:00010 execution:execution_01" {
+		t.Error("unexpected result:", entries[0])
+	}
+
+	if entries[1].Action != TicketAuditRemove || entries[1].Origin.String() != "user:user" || entries[1].Seq <= entries[0].Seq {
+		t.Error("unexpected result:", entries[1])
+	}
+
+	if entries = testman.TicketAudit("TEST_AUDIT_01", "20201121"); len(entries) != 0 {
+		t.Error("unexpected result:", entries)
+	}
+}
+
+func TestAddDeleteTickets(t *testing.T) {
+
+	testman := testManager.(*resourceManager)
+	origin := TicketOrigin{Username: "user"}
+
+	tickets := []TicketResource{
+		{Name: "TEST_BATCH_01", Odate: "20201120"},
+		{Name: "TEST_BATCH_02", Odate: "20201120", Keep: true},
+		{Name: "TEST_BATCH_01", Odate: "20201120"},
+	}
+
+	if _, err := testman.AddTickets(tickets, origin); err == nil {
+		t.Error("unexpected result, repeated ticket added")
+	}
+
+	testman.Add("TEST_BATCH_03", "20201120")
+	tickets[2] = TicketResource{Name: "TEST_BATCH_03", Odate: "20201120"}
+
+	if _, err := testman.AddTickets(tickets, origin); err == nil {
+		t.Error("unexpected result, existing ticket added")
+	}
+
+	if testman.Check("TEST_BATCH_01", "20201120") {
+		t.Error("unexpected result, ticket added by a failed batch")
+	}
+
+	tickets[2] = TicketResource{Name: "TEST_BATCH_04", Odate: "20201121"}
+	if n, err := testman.AddTickets(tickets, origin); n != 3 || err != nil {
+		t.Error("unexpected result:", n, err)
+	}
+
+	if ticket, ok := testman.GetTicket("TEST_BATCH_02", "20201120"); !ok || !ticket.Keep || ticket.Origin.Username != "user" {
+		t.Error("unexpected result:", ticket)
+	}
+
+	result, token, err := testman.DeleteTickets("TEST_BATCH_*", "20201120", true, "", origin)
+	if len(result) != 3 || token == "" || err != nil {
+		t.Error("unexpected result:", result, token, err)
+	}
+
+	if !testman.Check("TEST_BATCH_01", "20201120") {
+		t.Error("unexpected result, ticket removed by a dry run")
+	}
+
+	testman.Delete("TEST_BATCH_03", "20201120")
+	testman.Add("TEST_BATCH_05", "20201120")
+
+	if result, _, err = testman.DeleteTickets("TEST_BATCH_*", "20201120", false, token, origin); len(result) != 0 || !errors.Is(err, ErrTicketsChanged) {
+		t.Error("unexpected result:", result, err)
+	}
+
+	if !testman.Check("TEST_BATCH_01", "20201120") {
+		t.Error("unexpected result, ticket removed with a token of a different preview")
+	}
+
+	_, token, _ = testman.DeleteTickets("TEST_BATCH_*", "20201120", true, "", origin)
+	if result, _, err = testman.DeleteTickets("TEST_BATCH_*", "20201120", false, token, origin); len(result) != 3 || err != nil {
+		t.Error("unexpected result:", result, err)
+	}
+
+	if result := testman.ListTickets("TEST_BATCH_*", ""); len(result) != 1 || result[0].Name != "TEST_BATCH_04" {
+		t.Error("unexpected result:", result)
+	}
+
+	testman.Delete("TEST_BATCH_04", "20201121")
+}
+
+type recordingDispatcher struct {
+	mockDispacher
+	notified chan events.RouteTicketNotifyMsg
+}
+
+func (m *recordingDispatcher) PushEvent(sender events.EventReceiver, route events.RouteName, msg events.DispatchedMessage) error {
+	if route == events.RouteTicketNotify {
+		m.notified <- msg.Message().(events.RouteTicketNotifyMsg)
+	}
+	return nil
+}
+
+func TestTicketWatchers(t *testing.T) {
+
+	testman := testManager.(*resourceManager)
+	recorder := &recordingDispatcher{notified: make(chan events.RouteTicketNotifyMsg, 4)}
+	testman.dispatcher = recorder
+	defer func() { testman.dispatcher = &mdispatcher }()
+
+	check := func(orderID unique.TaskOrderID, names ...string) {
+		msg := events.RouteTicketCheckMsgFormat{OrderID: orderID}
+		for _, n := range names {
+			msg.Tickets = append(msg.Tickets, struct {
+				Name      string
+				Odate     string
+				Label     string
+				Fulfilled bool
+			}{Name: n, Odate: "20201120"})
+		}
+		receiver := events.NewTicketCheckReceiver()
+		go testman.Process(receiver, events.RouteTicketCheck, events.NewMsg(msg))
+		if _, err := receiver.WaitForResult(); err != nil {
+			t.Fatal("unexpected result:", err)
+		}
+	}
+
+	check("00020", "TEST_WATCH_01", "TEST_WATCH_02")
+	check("00021", "TEST_WATCH_02")
+	check("00022", "TEST_WATCH_03")
+
+	testman.Add("TEST_WATCH_02", "20201120")
+
+	select {
+	case n := <-recorder.notified:
+		if len(n.OrderIDs) != 2 {
+			t.Error("unexpected result:", n.OrderIDs)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("notification not sent")
+	}
+
+	if _, ok := testman.watched["00020"]; ok {
+		t.Error("unexpected result, notified task still registered")
+	}
+
+	if _, ok := testman.watchers["TEST_WATCH_0120201120"]; ok {
+		t.Error("unexpected result, notified task still registered")
+	}
+
+	testman.Process(nil, events.RouteTicketUnwatch, events.NewMsg(events.RouteTicketUnwatchMsg{OrderID: "00022"}))
+	testman.Add("TEST_WATCH_03", "20201120")
+
+	select {
+	case n := <-recorder.notified:
+		t.Error("unexpected notification:", n.OrderIDs)
+	default:
+	}
+
+	if len(testman.watchers) != 0 || len(testman.watched) != 0 {
+		t.Error("unexpected result:", testman.watchers, testman.watched)
+	}
+
+	testman.Delete("TEST_WATCH_02", "20201120")
+	testman.Delete("TEST_WATCH_03", "20201120")
+}
+
+func TestTicketRange(t *testing.T) {
+
+	testman := testManager.(*resourceManager)
+	recorder := &recordingDispatcher{notified: make(chan events.RouteTicketNotifyMsg, 4)}
+	testman.dispatcher = recorder
+	defer func() { testman.dispatcher = &mdispatcher }()
+
+	check := func(orderID unique.TaskOrderID, odate string) bool {
+		msg := events.RouteTicketCheckMsgFormat{OrderID: orderID, Tickets: []struct {
+			Name      string
+			Odate     string
+			Label     string
+			Fulfilled bool
+		}{{Name: "TEST_RANGE_01", Odate: odate}}}
+		receiver := events.NewTicketCheckReceiver()
+		go testman.Process(receiver, events.RouteTicketCheck, events.NewMsg(msg))
+		result, err := receiver.WaitForResult()
+		if err != nil {
+			t.Fatal("unexpected result:", err)
+		}
+		return result.Tickets[0].Fulfilled
+	}
+
+	if check("00030", "20201101-20201107") {
+		t.Error("unexpected result, ticket does not exist")
+	}
+
+	testman.Add("TEST_RANGE_01", "20201108")
+
+	select {
+	case n := <-recorder.notified:
+		t.Error("unexpected notification:", n.OrderIDs)
+	default:
+	}
+
+	testman.Add("TEST_RANGE_01", "20201103")
+
+	select {
+	case n := <-recorder.notified:
+		if len(n.OrderIDs) != 1 || n.OrderIDs[0] != "00030" {
+			t.Error("unexpected result:", n.OrderIDs)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("notification not sent")
+	}
+
+	if len(testman.ranges) != 0 {
+		t.Error("unexpected result:", testman.ranges)
+	}
+
+	if !check("00030", "20201101-20201107") || check("00030", "20201104-20201107") {
+		t.Error("unexpected result")
+	}
+
+	if !testman.CheckRange("TEST_RANGE_01", date.OdateRange{From: "20201108", To: "20201108"}) {
+		t.Error("unexpected result")
+	}
+
+	testman.Process(nil, events.RouteTicketUnwatch, events.NewMsg(events.RouteTicketUnwatchMsg{OrderID: "00030"}))
+	testman.Delete("TEST_RANGE_01", "20201103")
+	testman.Delete("TEST_RANGE_01", "20201108")
+}
+
+type flagRecordingDispatcher struct {
+	mockDispacher
+	released chan events.RouteFlagNotifyMsg
+}
+
+func (m *flagRecordingDispatcher) PushEvent(sender events.EventReceiver, route events.RouteName, msg events.DispatchedMessage) error {
+	if route == events.RouteFlagNotify {
+		m.released <- msg.Message().(events.RouteFlagNotifyMsg)
+	}
+	return nil
+}
+
+func TestFlagReleaseNotify(t *testing.T) {
+
+	testman := testManager.(*resourceManager)
+	recorder := &flagRecordingDispatcher{released: make(chan events.RouteFlagNotifyMsg, 4)}
+	testman.dispatcher = recorder
+	defer func() { testman.dispatcher = &mdispatcher }()
+
+	process := func(msg events.RouteFlagAcquireMsg, route events.RouteName) events.RouteFlagActionResponse {
+		receiver := events.NewFlagActionReceiver()
+		go testman.Process(receiver, route, events.NewMsg(msg))
+		result, err := receiver.WaitForResult()
+		if err != nil {
+			t.Fatal("unexpected result:", err)
+		}
+		return result
+	}
+
+	msg := events.RouteFlagAcquireMsg{OrderID: "00040", Flags: []events.FlagActionData{{Name: "TEST_FLAG_NOTIFY_01", Policy: 1}}}
+	if result := process(msg, events.RouteFlagAcquire); !result.Success {
+		t.Fatal("unexpected result:", result)
+	}
+
+	select {
+	case n := <-recorder.released:
+		t.Error("unexpected result, acquired flag notified:", n)
+	default:
+	}
+
+	if result := process(msg, events.RouteFlagRelase); !result.Success {
+		t.Fatal("unexpected result:", result)
+	}
+
+	if n := <-recorder.released; len(n.Names) != 1 || n.Names[0] != "TEST_FLAG_NOTIFY_01" {
+		t.Error("unexpected result:", n)
+	}
+
+	msg.Quantities = []events.QuantityActionData{{Name: "TEST_QUANTITY_NOTIFY_MISSING", Quantity: 1}}
+	if result := process(msg, events.RouteFlagAcquire); result.Success {
+		t.Fatal("unexpected result:", result)
+	}
+
+	if n := <-recorder.released; len(n.Names) != 1 || n.Names[0] != "TEST_FLAG_NOTIFY_01" {
+		t.Error("unexpected result, rolled back flag not notified:", n)
+	}
+
+	if _, ok := testman.fstore.Get("TEST_FLAG_NOTIFY_01"); ok {
+		t.Error("unexpected result, flag not rolled back")
+	}
+
+	testman.Set("TEST_FLAG_NOTIFY_02", FlagPolicyShared)
+	if ok, err := testman.Unset("TEST_FLAG_NOTIFY_02"); !ok {
+		t.Fatal(err)
+	}
+
+	if n := <-recorder.released; len(n.Names) != 1 || n.Names[0] != "TEST_FLAG_NOTIFY_02" {
+		t.Error("unexpected result:", n)
+	}
+}
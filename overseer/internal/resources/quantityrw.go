@@ -0,0 +1,66 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/przebro/overseer/datastore"
+
+	"github.com/przebro/databazaar/collection"
+)
+
+type quantityReadWriter struct {
+	colname  string
+	objectID string
+	rev      string
+	col      collection.DataCollection
+}
+
+//newQuantityReadWriter - creates a new readWriter
+func newQuantityReadWriter(colname, objectID string, provider *datastore.Provider) (readWriter, error) {
+
+	col, err := provider.GetCollection(colname)
+	if err != nil {
+		return nil, err
+	}
+
+	return &quantityReadWriter{colname: colname, col: col, objectID: objectID}, nil
+}
+
+//Load - load items from a persistent store
+func (cl *quantityReadWriter) Load() (map[string]interface{}, error) {
+
+	model := QuantitiesResourceModel{Quantities: []QuantityResource{}}
+
+	err := cl.col.Get(context.Background(), cl.objectID, &model)
+	if err != nil {
+		if err == collection.ErrNoDocuments {
+			model.ID = cl.objectID
+			cl.col.Create(context.Background(), &model)
+		} else {
+			return nil, err
+		}
+	}
+	cl.rev = model.REV
+
+	data := map[string]interface{}{}
+
+	for _, v := range model.Quantities {
+		data[v.Name] = v
+	}
+
+	return data, nil
+}
+
+//Write - writes items to the persistent store
+func (cl *quantityReadWriter) Write(items map[string]interface{}) error {
+
+	model := []QuantityResource{}
+
+	for _, v := range items {
+		model = append(model, v.(QuantityResource))
+	}
+
+	qrm := QuantitiesResourceModel{ID: cl.objectID, REV: cl.rev, Quantities: model}
+
+	return cl.col.Update(context.Background(), qrm)
+}
@@ -1,6 +1,10 @@
 package resources
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/przebro/overseer/common/types/date"
 )
 
@@ -9,16 +13,61 @@ type (
 	//FlagResourcePolicy - type of flag
 	FlagResourcePolicy int8
 
-	//TicketResource - Condition resources
+	//VariableScope - scope of a variable
+	VariableScope string
+
+	//TicketAuditAction - kind of a change of a ticket recorded in the audit trail
+	TicketAuditAction string
+
+	//TicketOrigin - creator of a ticket, either a task identified by order ID and execution ID or a user
+	TicketOrigin struct {
+		OrderID     string `json:"orderid,omitempty" bson:"orderid,omitempty"`
+		ExecutionID string `json:"executionid,omitempty" bson:"executionid,omitempty"`
+		Username    string `json:"username,omitempty" bson:"username,omitempty"`
+	}
+
+	//TicketResource - Condition resources, a ticket with Keep set is not removed when it expires
 	TicketResource struct {
-		Name  string     `json:"name" bson:"name" validate:"required,max=32"`
-		Odate date.Odate `json:"odate" bson:"odate"`
+		Name    string       `json:"name" bson:"name" validate:"required,max=32"`
+		Odate   date.Odate   `json:"odate" bson:"odate"`
+		Keep    bool         `json:"keep,omitempty" bson:"keep,omitempty"`
+		Origin  TicketOrigin `json:"origin" bson:"origin"`
+		Created time.Time    `json:"created" bson:"created"`
+	}
+	//TicketAuditEntry - a single change of a ticket, entries are ordered by a sequence number
+	TicketAuditEntry struct {
+		Seq    int64             `json:"seq" bson:"seq"`
+		Time   time.Time         `json:"time" bson:"time"`
+		Action TicketAuditAction `json:"action" bson:"action"`
+		Name   string            `json:"name" bson:"name"`
+		Odate  date.Odate        `json:"odate" bson:"odate"`
+		Origin TicketOrigin      `json:"origin" bson:"origin"`
 	}
-	//FlagResource - Semaphore like resources
+	//FlagResource - Semaphore like resources, Holders contains order IDs of tasks that acquired the flag
 	FlagResource struct {
-		Name   string             `json:"name" bson:"name" validate:"required,max=32"`
-		Policy FlagResourcePolicy `json:"policy" bson:"policy"`
-		Count  int                `json:"count" bson:"count"`
+		Name    string             `json:"name" bson:"name" validate:"required,max=32"`
+		Policy  FlagResourcePolicy `json:"policy" bson:"policy"`
+		Count   int                `json:"count" bson:"count"`
+		Holders []string           `json:"holders" bson:"holders"`
+	}
+	//VariableResource - Variable stored in the variable store
+	VariableResource struct {
+		Scope VariableScope `json:"scope" bson:"scope"`
+		Group string        `json:"group" bson:"group"`
+		Task  string        `json:"task" bson:"task"`
+		Name  string        `json:"name" bson:"name"`
+		Value string        `json:"value" bson:"value"`
+	}
+	//QuantityResource - Quantitative resource with a capacity that is shared by tasks
+	QuantityResource struct {
+		Name     string `json:"name" bson:"name"`
+		Capacity int    `json:"capacity" bson:"capacity"`
+		Used     int    `json:"used" bson:"used"`
+	}
+	//SecretResource - Secret stored in the secret store, a value of the secret is encrypted
+	SecretResource struct {
+		Name  string `json:"name" bson:"name"`
+		Value string `json:"value" bson:"value"`
 	}
 	//TicketsResourceModel - tickets model
 	TicketsResourceModel struct {
@@ -26,12 +75,36 @@ type (
 		REV     string           `json:"_rev" bson:"_rev"`
 		Tickets []TicketResource `json:"tickets" bson:"tickets"`
 	}
+	//TicketAuditModel - ticket audit trail model
+	TicketAuditModel struct {
+		ID      string             `json:"_id" bson:"_id"`
+		REV     string             `json:"_rev" bson:"_rev"`
+		Entries []TicketAuditEntry `json:"entries" bson:"entries"`
+	}
 	//FlagsResourceModel - flags model
 	FlagsResourceModel struct {
 		ID    string         `json:"_id" bson:"_id"`
 		REV   string         `json:"_rev" bson:"_rev"`
 		Flags []FlagResource `json:"flags" bson:"flags"`
 	}
+	//VariablesResourceModel - variables model
+	VariablesResourceModel struct {
+		ID        string             `json:"_id" bson:"_id"`
+		REV       string             `json:"_rev" bson:"_rev"`
+		Variables []VariableResource `json:"variables" bson:"variables"`
+	}
+	//QuantitiesResourceModel - quantitative resources model
+	QuantitiesResourceModel struct {
+		ID         string             `json:"_id" bson:"_id"`
+		REV        string             `json:"_rev" bson:"_rev"`
+		Quantities []QuantityResource `json:"quantities" bson:"quantities"`
+	}
+	//SecretsResourceModel - secrets model
+	SecretsResourceModel struct {
+		ID      string           `json:"_id" bson:"_id"`
+		REV     string           `json:"_rev" bson:"_rev"`
+		Secrets []SecretResource `json:"secrets" bson:"secrets"`
+	}
 )
 
 const (
@@ -41,6 +114,67 @@ const (
 	FlagPolicyExclusive FlagResourcePolicy = 1
 )
 
+//String - returns a name of a policy
+func (p FlagResourcePolicy) String() string {
+	if p == FlagPolicyExclusive {
+		return "EXL"
+	}
+	return "SHR"
+}
+
+//ScopeSeparator - separates a namespace from a name of a ticket or a flag
+const ScopeSeparator = ":"
+
+//ScopedName - returns a name of a ticket or a flag qualified with a namespace, names in the global namespace are not qualified
+func ScopedName(scope, name string) string {
+
+	if scope == "" {
+		return name
+	}
+
+	return scope + ScopeSeparator + name
+}
+
+//SplitScope - returns a namespace and a name from a qualified name
+func SplitScope(name string) (string, string) {
+
+	if idx := strings.Index(name, ScopeSeparator); idx != -1 {
+		return name[:idx], name[idx+1:]
+	}
+
+	return "", name
+}
+
+const (
+	//TicketAuditAdd - a ticket was added
+	TicketAuditAdd TicketAuditAction = "ADD"
+	//TicketAuditRemove - a ticket was removed by a task or a user
+	TicketAuditRemove TicketAuditAction = "REM"
+	//TicketAuditPurge - an expired ticket was removed by the new day procedure
+	TicketAuditPurge TicketAuditAction = "PURGE"
+)
+
+//String - returns a description of a creator of a ticket
+func (o TicketOrigin) String() string {
+
+	if o.OrderID != "" {
+		return fmt.Sprintf("task:%s execution:%s", o.OrderID, o.ExecutionID)
+	}
+	if o.Username != "" {
+		return fmt.Sprintf("user:%s", o.Username)
+	}
+	return "unknown"
+}
+
+const (
+	//VariableScopeGlobal - variable is visible for all tasks
+	VariableScopeGlobal VariableScope = "global"
+	//VariableScopeGroup - variable is visible for tasks from a group
+	VariableScopeGroup VariableScope = "group"
+	//VariableScopeTask - variable is visible only for a task
+	VariableScopeTask VariableScope = "task"
+)
+
 type ticketSorter struct{ list []TicketResource }
 
 func (s ticketSorter) Len() int      { return len(s.list) }
@@ -49,3 +183,13 @@ func (s ticketSorter) Swap(i, j int) { s.list[i], s.list[j] = s.list[j], s.list[
 func (s ticketSorter) Less(i, j int) bool {
 	return s.list[i].Name < s.list[j].Name
 }
+
+type variableSorter struct{ list []VariableResource }
+
+func (s variableSorter) Len() int      { return len(s.list) }
+func (s variableSorter) Swap(i, j int) { s.list[i], s.list[j] = s.list[j], s.list[i] }
+
+func (s variableSorter) Less(i, j int) bool {
+	return variableKey(s.list[i].Scope, s.list[i].Group, s.list[i].Task, s.list[i].Name) <
+		variableKey(s.list[j].Scope, s.list[j].Group, s.list[j].Task, s.list[j].Name)
+}
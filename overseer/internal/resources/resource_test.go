@@ -29,8 +29,11 @@ func (m *mockDispacher) Unsubscribe(route events.RouteName, participant events.E
 var dispatcher mockDispacher = mockDispacher{}
 
 var resConfig config.ResourcesConfigurartion = config.ResourcesConfigurartion{
-	TicketSource: config.ResourceEntry{Sync: 1, Collection: "resources"},
-	FlagSource:   config.ResourceEntry{Sync: 1, Collection: "resources"},
+	TicketSource:   config.ResourceEntry{Sync: 1, Collection: "resources"},
+	FlagSource:     config.ResourceEntry{Sync: 1, Collection: "resources"},
+	QuantitySource: config.ResourceEntry{Sync: 1, Collection: "resources"},
+	VariableSource: config.ResourceEntry{Sync: 1, Collection: "resources"},
+	SecretSource:   config.SecretEntry{Sync: 1, Collection: "resources", Key: "WBdumgVKBK4iTB+CR2Z2meseDrlnrg54QDSAPcFswWU="},
 }
 var storeConfig config.StoreProviderConfiguration = config.StoreProviderConfiguration{
 	Store: []config.StoreConfiguration{
@@ -379,6 +382,90 @@ func TestCreateReadWriter(t *testing.T) {
 	if err != nil {
 		t.Error("unexpected result:", err)
 	}
+
+	_, err = newVariableReadWriter("invalid", "variables", provider)
+	if err == nil {
+		t.Error("unexpected result")
+	}
+
+	_, err = newVariableReadWriter("resources", "variables", provider)
+
+	if err != nil {
+		t.Error("unexpected result:", err)
+	}
+}
+
+func TestVariables(t *testing.T) {
+
+	_, err := manager.SetVariable(VariableResource{Scope: VariableScopeGlobal, Name: "%%HOME", Value: "/home"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = manager.SetVariable(VariableResource{Scope: VariableScopeGroup, Group: "GROUP_V", Name: "%%HOME", Value: "/home/group"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = manager.SetVariable(VariableResource{Scope: VariableScopeTask, Group: "GROUP_V", Task: "TASK_V", Name: "%%HOME", Value: "/home/task"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = manager.SetVariable(VariableResource{Scope: VariableScopeGlobal, Name: "%%HOME", Value: "/home/global"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = manager.SetVariable(VariableResource{Scope: VariableScopeGroup, Name: "%%HOME", Value: "/home"})
+	if err != ErrInvalidVariableScope {
+		t.Error("unexpected result:", err, " expected:", ErrInvalidVariableScope)
+	}
+
+	_, err = manager.SetVariable(VariableResource{Scope: VariableScopeGlobal, Group: "GROUP_V", Name: "%%HOME", Value: "/home"})
+	if err != ErrInvalidVariableScope {
+		t.Error("unexpected result:", err, " expected:", ErrInvalidVariableScope)
+	}
+
+	_, err = manager.SetVariable(VariableResource{Scope: "invalid", Name: "%%HOME", Value: "/home"})
+	if err != ErrInvalidVariableScope {
+		t.Error("unexpected result:", err, " expected:", ErrInvalidVariableScope)
+	}
+
+	vars := manager.ScopeVariables(VariableScopeGlobal, "", "")
+	if len(vars) != 1 || vars[0].Value != "/home/global" {
+		t.Error("unexpected result:", vars)
+	}
+
+	vars = manager.ScopeVariables(VariableScopeTask, "GROUP_V", "TASK_V")
+	if len(vars) != 1 || vars[0].Value != "/home/task" {
+		t.Error("unexpected result:", vars)
+	}
+
+	list := manager.ListVariables("", "", "", "%%HOME")
+	if len(list) != 3 {
+		t.Error("unexpected result:", len(list), " expected:", 3)
+	}
+
+	list = manager.ListVariables(VariableScopeGroup, "GROUP_*", "", "")
+	if len(list) != 1 || list[0].Value != "/home/group" {
+		t.Error("unexpected result:", list)
+	}
+
+	if _, err = manager.DeleteVariable(VariableScopeGroup, "GROUP_V", "", "%%HOME"); err != nil {
+		t.Error(err)
+	}
+
+	if _, err = manager.DeleteVariable(VariableScopeGroup, "GROUP_V", "", "%%HOME"); err == nil {
+		t.Error("unexpected result")
+	}
+
+	if _, err = manager.DeleteVariable(VariableScopeTask, "", "", "%%HOME"); err != ErrInvalidVariableScope {
+		t.Error("unexpected result:", err, " expected:", ErrInvalidVariableScope)
+	}
+
+	manager.DeleteVariable(VariableScopeGlobal, "", "", "%%HOME")
+	manager.DeleteVariable(VariableScopeTask, "GROUP_V", "TASK_V", "%%HOME")
 }
 
 func TestSortSwap(t *testing.T) {
@@ -414,3 +501,102 @@ func TestSortLess(t *testing.T) {
 
 	}
 }
+
+func TestScopedName(t *testing.T) {
+
+	if n := ScopedName("", "TICKET"); n != "TICKET" {
+		t.Error("unexpected result:", n)
+	}
+
+	if n := ScopedName("TEAM", "TICKET"); n != "TEAM:TICKET" {
+		t.Error("unexpected result:", n)
+	}
+
+	if scope, name := SplitScope("TEAM:TICKET"); scope != "TEAM" || name != "TICKET" {
+		t.Error("unexpected result:", scope, name)
+	}
+
+	if scope, name := SplitScope("TICKET"); scope != "" || name != "TICKET" {
+		t.Error("unexpected result:", scope, name)
+	}
+}
+
+func TestSecrets(t *testing.T) {
+
+	if _, err := manager.SetSecret("DB-PASS", "P@ssw0rd"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := manager.SetSecret("DB-USER", "overseer"); err != nil {
+		t.Fatal(err)
+	}
+
+	item, ok := manager.(*resourceManager).sstore.Get("DB-PASS")
+	if !ok {
+		t.Fatal("unexpected result, secret not stored")
+	}
+
+	if item.(SecretResource).Value == "P@ssw0rd" {
+		t.Error("unexpected result, secret stored as plain text")
+	}
+
+	value, ok, err := manager.Secret("DB-PASS")
+	if err != nil || !ok || value != "P@ssw0rd" {
+		t.Error("unexpected result:", value, ok, err)
+	}
+
+	if _, ok, _ = manager.Secret("NOT-EXISTS"); ok {
+		t.Error("unexpected result, secret exists")
+	}
+
+	names := manager.ListSecrets("DB*")
+	if len(names) != 2 || names[0] != "DB-PASS" || names[1] != "DB-USER" {
+		t.Error("unexpected result:", names)
+	}
+
+	masked := manager.MaskSecrets("login overseer:P@ssw0rd")
+	if masked != "login "+SecretMask+":"+SecretMask {
+		t.Error("unexpected result:", masked)
+	}
+
+	//encrypted value cannot be used with another name
+	manager.(*resourceManager).sstore.Update("DB-USER", SecretResource{Name: "DB-USER", Value: item.(SecretResource).Value})
+	if _, _, err = manager.Secret("DB-USER"); err != ErrSecretDecryption {
+		t.Error("unexpected result:", err, " expected:", ErrSecretDecryption)
+	}
+
+	if _, err = manager.DeleteSecret("DB-USER"); err != nil {
+		t.Error(err)
+	}
+
+	if _, err = manager.DeleteSecret("DB-USER"); err == nil {
+		t.Error("unexpected result, secret deleted twice")
+	}
+}
+
+func TestSecretCipher(t *testing.T) {
+
+	if _, err := newSecretCipher(""); err != ErrSecretKeyRequired {
+		t.Error("unexpected result:", err, " expected:", ErrSecretKeyRequired)
+	}
+
+	if _, err := newSecretCipher("invalid^%*()"); err == nil {
+		t.Error("unexpected result, expected error")
+	}
+
+	c1, _ := newSecretCipher("WBdumgVKBK4iTB+CR2Z2meseDrlnrg54QDSAPcFswWU=")
+	c2, _ := newSecretCipher("AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA")
+
+	sealed, err := sealSecret(c1, "name", "value")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = openSecret(c2, "name", sealed); err != ErrSecretDecryption {
+		t.Error("unexpected result:", err, " expected:", ErrSecretDecryption)
+	}
+
+	if value, err := openSecret(c1, "name", sealed); err != nil || value != "value" {
+		t.Error("unexpected result:", value, err)
+	}
+}
@@ -0,0 +1,66 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/przebro/overseer/datastore"
+
+	"github.com/przebro/databazaar/collection"
+)
+
+type secretReadWriter struct {
+	colname  string
+	objectID string
+	rev      string
+	col      collection.DataCollection
+}
+
+//newSecretReadWriter - creates a new readWriter
+func newSecretReadWriter(colname, objectID string, provider *datastore.Provider) (readWriter, error) {
+
+	col, err := provider.GetCollection(colname)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretReadWriter{colname: colname, col: col, objectID: objectID}, nil
+}
+
+//Load - load items from a persistent store
+func (cl *secretReadWriter) Load() (map[string]interface{}, error) {
+
+	model := SecretsResourceModel{Secrets: []SecretResource{}}
+
+	err := cl.col.Get(context.Background(), cl.objectID, &model)
+	if err != nil {
+		if err == collection.ErrNoDocuments {
+			model.ID = cl.objectID
+			cl.col.Create(context.Background(), &model)
+		} else {
+			return nil, err
+		}
+	}
+	cl.rev = model.REV
+
+	data := map[string]interface{}{}
+
+	for _, v := range model.Secrets {
+		data[v.Name] = v
+	}
+
+	return data, nil
+}
+
+//Write - writes items to the persistent store
+func (cl *secretReadWriter) Write(items map[string]interface{}) error {
+
+	model := []SecretResource{}
+
+	for _, v := range items {
+		model = append(model, v.(SecretResource))
+	}
+
+	srm := SecretsResourceModel{ID: cl.objectID, REV: cl.rev, Secrets: model}
+
+	return cl.col.Update(context.Background(), srm)
+}
@@ -81,6 +81,25 @@ func (s *resourceStore) Insert(key string, item interface{}) error {
 
 	return errKeyExists
 }
+
+//InsertMany - inserts all items or none of them if any of keys already exists
+func (s *resourceStore) InsertMany(items map[string]interface{}) error {
+
+	defer s.lock.Unlock()
+	s.lock.Lock()
+
+	for key := range items {
+		if _, ok := s.items[key]; ok {
+			return errKeyExists
+		}
+	}
+
+	for key, item := range items {
+		s.items[key] = item
+	}
+
+	return nil
+}
 func (s *resourceStore) Get(key string) (interface{}, bool) {
 
 	defer s.lock.RUnlock()
@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/przebro/overseer/datastore"
+
+	"github.com/przebro/databazaar/collection"
+)
+
+type ticketAuditReadWriter struct {
+	colname  string
+	objectID string
+	rev      string
+	col      collection.DataCollection
+}
+
+//newTicketAuditReadWriter - creates a new readWriter
+func newTicketAuditReadWriter(colname, objectID string, provider *datastore.Provider) (readWriter, error) {
+
+	col, err := provider.GetCollection(colname)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ticketAuditReadWriter{colname: colname, col: col, objectID: objectID}, nil
+}
+
+//Load - load items from a persistent store
+func (cl *ticketAuditReadWriter) Load() (map[string]interface{}, error) {
+
+	model := TicketAuditModel{Entries: []TicketAuditEntry{}}
+
+	err := cl.col.Get(context.Background(), cl.objectID, &model)
+	if err != nil {
+		if err == collection.ErrNoDocuments {
+			model.ID = cl.objectID
+			cl.col.Create(context.Background(), &model)
+		} else {
+			return nil, err
+		}
+	}
+	cl.rev = model.REV
+
+	data := map[string]interface{}{}
+
+	for _, v := range model.Entries {
+		data[auditKey(v.Seq)] = v
+	}
+
+	return data, nil
+}
+
+//Write - writes items to the persistent store
+func (cl *ticketAuditReadWriter) Write(items map[string]interface{}) error {
+
+	model := []TicketAuditEntry{}
+
+	for _, v := range items {
+		model = append(model, v.(TicketAuditEntry))
+	}
+
+	tam := TicketAuditModel{ID: cl.objectID, REV: cl.rev, Entries: model}
+
+	return cl.col.Update(context.Background(), tam)
+}
+
+func auditKey(seq int64) string {
+	return strconv.FormatInt(seq, 10)
+}
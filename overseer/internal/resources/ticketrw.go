@@ -47,7 +47,7 @@ func (cl *ticketReadWriter) Load() (map[string]interface{}, error) {
 	for _, t := range model.Tickets {
 
 		key := t.Name + string(t.Odate)
-		data[key] = TicketResource{Name: t.Name, Odate: t.Odate}
+		data[key] = t
 	}
 
 	return data, nil
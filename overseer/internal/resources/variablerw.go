@@ -0,0 +1,66 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/przebro/overseer/datastore"
+
+	"github.com/przebro/databazaar/collection"
+)
+
+type variableReadWriter struct {
+	colname  string
+	objectID string
+	rev      string
+	col      collection.DataCollection
+}
+
+//newVariableReadWriter - creates a new readWriter
+func newVariableReadWriter(colname, objectID string, provider *datastore.Provider) (readWriter, error) {
+
+	col, err := provider.GetCollection(colname)
+	if err != nil {
+		return nil, err
+	}
+
+	return &variableReadWriter{colname: colname, col: col, objectID: objectID}, nil
+}
+
+//Load - load items from a persistent store
+func (cl *variableReadWriter) Load() (map[string]interface{}, error) {
+
+	model := VariablesResourceModel{Variables: []VariableResource{}}
+
+	err := cl.col.Get(context.Background(), cl.objectID, &model)
+	if err != nil {
+		if err == collection.ErrNoDocuments {
+			model.ID = cl.objectID
+			cl.col.Create(context.Background(), &model)
+		} else {
+			return nil, err
+		}
+	}
+	cl.rev = model.REV
+
+	data := map[string]interface{}{}
+
+	for _, v := range model.Variables {
+		data[variableKey(v.Scope, v.Group, v.Task, v.Name)] = v
+	}
+
+	return data, nil
+}
+
+//Write - writes items to the persistent store
+func (cl *variableReadWriter) Write(items map[string]interface{}) error {
+
+	model := []VariableResource{}
+
+	for _, v := range items {
+		model = append(model, v.(VariableResource))
+	}
+
+	vrm := VariablesResourceModel{ID: cl.objectID, REV: cl.rev, Variables: model}
+
+	return cl.col.Update(context.Background(), vrm)
+}
@@ -0,0 +1,69 @@
+package resources
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+)
+
+//ErrSecretKeyRequired - occurs when a key that encrypts secrets is not provided
+var ErrSecretKeyRequired = errors.New("key for the secret store is required")
+
+//ErrSecretDecryption - occurs when a secret cannot be decrypted, e.g. the key has changed
+var ErrSecretDecryption = errors.New("unable to decrypt secret")
+
+//newSecretCipher - creates a cipher from a base64 encoded key, the key is hashed to get a 256 bit AES key
+func newSecretCipher(key string) (cipher.AEAD, error) {
+
+	if key == "" {
+		return nil, ErrSecretKeyRequired
+	}
+
+	b, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(b)
+
+	block, err := aes.NewCipher(hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+//sealSecret - encrypts a value of a secret, the name of the secret is used as additional data
+//so an encrypted value cannot be moved to another secret.
+func sealSecret(aead cipher.AEAD, name, value string) (string, error) {
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(value), []byte(name))
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+//openSecret - decrypts a value of a secret
+func openSecret(aead cipher.AEAD, name, value string) (string, error) {
+
+	b, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || len(b) < aead.NonceSize() {
+		return "", ErrSecretDecryption
+	}
+
+	data, err := aead.Open(nil, b[:aead.NonceSize()], b[aead.NonceSize():], []byte(name))
+	if err != nil {
+		return "", ErrSecretDecryption
+	}
+
+	return string(data), nil
+}
@@ -16,10 +16,14 @@ type TaskBuilder interface {
 	WithInTicekts(in []InTicketData, relation InTicketRelation, expr string) TaskBuilder
 	WithOutTickets(out []OutTicketData) TaskBuilder
 	WithFlags(flags []FlagData) TaskBuilder
+	WithQuantities(quantities []QuantityData) TaskBuilder
 	WithConfirm() TaskBuilder
 	WithCyclic(data CyclicTaskData) TaskBuilder
 	WithVariables(vars types.EnvironmentVariableList) TaskBuilder
 	WithRetention(days int) TaskBuilder
+	WithWorker(selector WorkerSelector) TaskBuilder
+	WithPriority(priority int) TaskBuilder
+	WithIdempotent(idempotent bool) TaskBuilder
 	Build() (TaskDefinition, error)
 }
 
@@ -51,6 +55,9 @@ func (builder *DummyTaskBuilder) FromTemplate(templ TaskDefinition) TaskBuilder
 	builder.def.FlagsTab = make([]FlagData, len(templ.Flags()))
 	copy(builder.def.FlagsTab, templ.Flags())
 
+	builder.def.QuantitiesTab = make([]QuantityData, len(templ.Quantities()))
+	copy(builder.def.QuantitiesTab, templ.Quantities())
+
 	builder.def.Schedule = SchedulingData{}
 
 	builder.def.Schedule.FromTime, builder.def.Schedule.ToTime = templ.TimeSpan()
@@ -59,6 +66,13 @@ func (builder *DummyTaskBuilder) FromTemplate(templ TaskDefinition) TaskBuilder
 	copy(builder.def.Schedule.Months, templ.Months())
 
 	builder.def.Cyclics = templ.Cyclic()
+	builder.def.TaskPriority = templ.Priority()
+	builder.def.IsIdempotent = templ.Idempotent()
+	builder.def.Worker = templ.WorkerSelector()
+	if templ.WorkerSelector().Tags != nil {
+		builder.def.Worker.Tags = make([]string, len(templ.WorkerSelector().Tags))
+		copy(builder.def.Worker.Tags, templ.WorkerSelector().Tags)
+	}
 
 	builder.def.Schedule.Dayvalues = make([]int, len(templ.Days()))
 	copy(builder.def.Schedule.Dayvalues, templ.Days())
@@ -114,6 +128,14 @@ func (builder *DummyTaskBuilder) WithFlags(flags []FlagData) TaskBuilder {
 	return builder
 }
 
+//WithQuantities - Adds quantitative resources to the constructed task.
+func (builder *DummyTaskBuilder) WithQuantities(quantities []QuantityData) TaskBuilder {
+
+	builder.def.QuantitiesTab = quantities
+
+	return builder
+}
+
 //WithConfirm - Adds confirm to the constructed task.
 func (builder *DummyTaskBuilder) WithConfirm() TaskBuilder {
 
@@ -144,6 +166,27 @@ func (builder *DummyTaskBuilder) WithCyclic(data CyclicTaskData) TaskBuilder {
 	return builder
 }
 
+//WithWorker - Restricts workers on which the constructed task can be executed.
+func (builder *DummyTaskBuilder) WithWorker(selector WorkerSelector) TaskBuilder {
+
+	builder.def.Worker = selector
+	return builder
+}
+
+//WithPriority - Sets a priority of the constructed task.
+func (builder *DummyTaskBuilder) WithPriority(priority int) TaskBuilder {
+
+	builder.def.TaskPriority = priority
+	return builder
+}
+
+//WithIdempotent - Allows to execute the constructed task again on another worker if its worker is lost.
+func (builder *DummyTaskBuilder) WithIdempotent(idempotent bool) TaskBuilder {
+
+	builder.def.IsIdempotent = idempotent
+	return builder
+}
+
 //Build - Builds a new task definition.
 func (builder *DummyTaskBuilder) Build() (TaskDefinition, error) {
 
@@ -89,3 +89,90 @@ func TestBuilder_WithCyclic(t *testing.T) {
 		t.Error("unexpected result:", actual)
 	}
 }
+
+func TestBuilderWorkerSelector(t *testing.T) {
+
+	builder, builder2 := &DummyTaskBuilder{}, &DummyTaskBuilder{}
+
+	sel := WorkerSelector{Pool: "aws", Tags: []string{"credentials"}}
+
+	task, err := builder.WithBase("testgroup", "testname", "testdescription").WithSchedule(SchedulingData{OrderType: OrderingManual}).WithWorker(sel).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	task2, err := builder2.FromTemplate(task).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := task2.WorkerSelector()
+	if result.Pool != "aws" || len(result.Tags) != 1 || result.Tags[0] != "credentials" {
+		t.Error("Unexpected selector:", result)
+	}
+
+	result.Tags[0] = "changed"
+	if task.WorkerSelector().Tags[0] != "credentials" {
+		t.Error("tags of a template should not be shared")
+	}
+
+	if result.String() != "pool=aws tags=changed" {
+		t.Error("Unexpected value:", result.String())
+	}
+
+	_, err = builder.WithBase("testgroup", "testname", "testdescription").WithSchedule(SchedulingData{OrderType: OrderingManual}).WithWorker(WorkerSelector{Tags: []string{"a", "a"}}).Build()
+	if err == nil {
+		t.Error("expected error for duplicated tags")
+	}
+
+	if !(WorkerSelector{}).IsEmpty() {
+		t.Error("empty selector expected")
+	}
+}
+
+func TestBuilderPriority(t *testing.T) {
+
+	builder, builder2 := &DummyTaskBuilder{}, &DummyTaskBuilder{}
+
+	task, err := builder.WithBase("testgroup", "testname", "testdescription").WithSchedule(SchedulingData{OrderType: OrderingManual}).WithPriority(50).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	task2, err := builder2.FromTemplate(task).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if task2.Priority() != 50 {
+		t.Error("Unexpected priority:", task2.Priority())
+	}
+
+	_, err = builder.WithBase("testgroup", "testname", "testdescription").WithSchedule(SchedulingData{OrderType: OrderingManual}).WithPriority(100).Build()
+	if err == nil {
+		t.Error("Expected error, priority out of range")
+	}
+}
+
+func TestBuilderIdempotent(t *testing.T) {
+
+	builder, builder2 := &DummyTaskBuilder{}, &DummyTaskBuilder{}
+
+	task, err := builder.WithBase("testgroup", "testname", "testdescription").WithSchedule(SchedulingData{OrderType: OrderingManual}).WithIdempotent(true).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !task.Idempotent() {
+		t.Error("Unexpected value, task is idempotent")
+	}
+
+	task2, err := builder2.FromTemplate(task).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !task2.Idempotent() {
+		t.Error("Unexpected value, idempotent flag not copied from template")
+	}
+}
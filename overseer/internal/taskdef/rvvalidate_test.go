@@ -103,6 +103,49 @@ func TestOutTicketData(t *testing.T) {
 	}
 }
 
+func TestOutCondition(t *testing.T) {
+
+	for _, when := range []OutCondition{"", OutWhenOK, OutWhenNotOK, OutWhenAny, "RC=0", "RC=1-4", "RC=8-8", "SC=0"} {
+		if err := validator.Valid.Validate(OutTicketData{Name: "ABCDEF", Action: "ADD", When: when}); err != nil {
+			t.Error(when, err)
+		}
+	}
+
+	for _, when := range []OutCondition{"ok", "ALWAYS", "RC=4-1", "RC=", "RC=-1", "SC=1-2", "RC 4"} {
+		if err := validator.Valid.Validate(OutTicketData{Name: "ABCDEF", Action: "ADD", When: when}); err == nil {
+			t.Error("unexpected result:", when)
+		}
+	}
+
+	tdata := []struct {
+		when   OutCondition
+		ok     bool
+		ended  bool
+		rc     int32
+		sc     int32
+		result bool
+	}{
+		{"", true, true, 0, 0, true},
+		{"", false, true, 8, 0, false},
+		{OutWhenOK, false, false, 0, 0, false},
+		{OutWhenNotOK, false, false, 0, 0, true},
+		{OutWhenNotOK, true, true, 0, 0, false},
+		{OutWhenAny, false, false, 0, 0, true},
+		{OutWhenAny, true, true, 0, 0, true},
+		{"RC=1-4", false, true, 4, 0, true},
+		{"RC=1-4", false, true, 5, 0, false},
+		{"RC=0", false, false, 0, 0, false},
+		{"SC=3", false, true, 0, 3, true},
+		{"SC=3", true, true, 0, 0, false},
+	}
+
+	for _, d := range tdata {
+		if r := d.when.Match(d.ok, d.ended, d.rc, d.sc); r != d.result {
+			t.Error("unexpected result:", d.when, d.ok, d.ended, d.rc, d.sc, "expected:", d.result, "actual:", r)
+		}
+	}
+}
+
 func TestFlagData(t *testing.T) {
 
 	flag := FlagData{Name: "ABCDEF", Type: "SHR"}
@@ -124,6 +167,35 @@ func TestFlagData(t *testing.T) {
 	}
 }
 
+func TestScopeData(t *testing.T) {
+
+	for _, scope := range []string{"", GroupScope, "TEAM-A", "ns.1"} {
+		if err := validator.Valid.Validate(InTicketData{Name: "ABCDEF", Scope: scope}); err != nil {
+			t.Error(scope, err)
+		}
+		if err := validator.Valid.Validate(OutTicketData{Name: "ABCDEF", Action: "ADD", Scope: scope}); err != nil {
+			t.Error(scope, err)
+		}
+		if err := validator.Valid.Validate(FlagData{Name: "ABCDEF", Type: "SHR", Scope: scope}); err != nil {
+			t.Error(scope, err)
+		}
+	}
+
+	for _, scope := range []string{"@global", "NS:A", "NS*", "0NS"} {
+		if err := validator.Valid.Validate(InTicketData{Name: "ABCDEF", Scope: scope}); err == nil {
+			t.Error("unexpected result:", scope)
+		}
+	}
+
+	if ResolveScope(GroupScope, "GROUP") != "GROUP" {
+		t.Error("unexpected result:", ResolveScope(GroupScope, "GROUP"))
+	}
+
+	if ResolveScope("TEAM", "GROUP") != "TEAM" || ResolveScope("", "GROUP") != "" {
+		t.Error("unexpected result")
+	}
+}
+
 func TestVariableData(t *testing.T) {
 
 	variable := types.EnvironmentVariable{Name: "%%ABCD", Value: ""}
@@ -30,6 +30,10 @@ type SchedulingOption string
 //Possible values are ADD,REM
 type OutAction string
 
+//OutCondition - Restricts a result of an execution for which an out ticket action is performed.
+//Possible values are OK, NOTOK, ANY, a range of return codes RC=n or RC=n-m and a status code SC=n
+type OutCondition string
+
 //CycleFromOption -
 type CycleFromOption string
 
@@ -47,21 +51,77 @@ type InTicketData struct {
 	Name  string          `json:"name" validate:"required,max=32,resname"`
 	Label string          `json:"label" validate:"omitempty,max=32,resname"`
 	Odate date.OdateValue `json:"odate" validate:"odateval"`
+	Scope string          `json:"scope,omitempty" validate:"omitempty,max=32,resscope"`
 }
 
-//OutTicketData - Holds an action for a given ticket that is performed after the successful execution of a task.
+//OutTicketData - Holds an action for a given ticket that is performed after the execution of a task.
+//By default, the action is performed only after the successful execution, the When condition changes it.
 type OutTicketData struct {
 	Name   string          `json:"name" validate:"required,max=32,resname"`
-	Odate  date.OdateValue `json:"odate" validate:"odateval"`
+	Odate  date.OdateValue `json:"odate" validate:"odateval,odatesingle"`
 	Action OutAction       `json:"action" validate:"required,oneof=ADD REM"`
+	Keep   bool            `json:"keep,omitempty"`
+	Scope  string          `json:"scope,omitempty" validate:"omitempty,max=32,resscope"`
+	When   OutCondition    `json:"when,omitempty" validate:"omitempty,outcond"`
 }
 
 //FlagData - Holds information about required flag resources.
 //If type is set to exclusive then the state of a flag in resources manager can be only none
 //If type is set to shared then the state of a flag in resources manager can be none or shared but not exclusive
 type FlagData struct {
-	Name string   `json:"name" validate:"required,max=32,resname"`
-	Type FlagType `json:"type" validate:"oneof=SHR EXL"`
+	Name  string   `json:"name" validate:"required,max=32,resname"`
+	Type  FlagType `json:"type" validate:"oneof=SHR EXL"`
+	Scope string   `json:"scope,omitempty" validate:"omitempty,max=32,resscope"`
+}
+
+//GroupScope - a scope of a ticket or a flag that refers to the namespace named after the group of a task
+const GroupScope = "@group"
+
+//ResolveScope - returns a namespace of a ticket or a flag, an empty namespace is the global namespace
+func ResolveScope(scope, group string) string {
+
+	if scope == GroupScope {
+		return group
+	}
+
+	return scope
+}
+
+//QuantityData - Holds information about an amount of a quantitative resource that is required by a task.
+//A task can run only if the resource has enough free capacity for the required amount.
+type QuantityData struct {
+	Name     string `json:"name" validate:"required,max=32,resname"`
+	Quantity int    `json:"quantity" validate:"min=1,max=9999"`
+}
+
+//WorkerSelector - Restricts workers on which a task can be executed.
+//Host matches a name or an address of a worker, Pool matches a worker pool and all Tags must be assigned to a worker or its pool.
+type WorkerSelector struct {
+	Host string   `json:"host,omitempty" validate:"omitempty,max=64"`
+	Pool string   `json:"pool,omitempty" validate:"omitempty,max=32"`
+	Tags []string `json:"tags,omitempty" validate:"omitempty,unique,dive,required,max=32"`
+}
+
+//IsEmpty - returns true if a selector does not restrict workers
+func (sel WorkerSelector) IsEmpty() bool {
+	return sel.Host == "" && sel.Pool == "" && len(sel.Tags) == 0
+}
+
+//String - returns a readable representation of a selector
+func (sel WorkerSelector) String() string {
+
+	parts := []string{}
+	if sel.Host != "" {
+		parts = append(parts, "host="+sel.Host)
+	}
+	if sel.Pool != "" {
+		parts = append(parts, "pool="+sel.Pool)
+	}
+	if len(sel.Tags) > 0 {
+		parts = append(parts, "tags="+strings.Join(sel.Tags, ","))
+	}
+
+	return strings.Join(parts, " ")
 }
 
 //SchedulingData - Holds informations how task should be scheduled.
@@ -96,8 +156,12 @@ type baseTaskDefinition struct {
 	Expression    string                        `json:"expr" validate:"omitempty"`
 	InTickets     []InTicketData                `json:"inticket" validate:"omitempty,dive"`
 	FlagsTab      []FlagData                    `json:"flags"  validate:"omitempty,dive"`
+	QuantitiesTab []QuantityData                `json:"quantities"  validate:"omitempty,dive"`
 	OutTickets    []OutTicketData               `json:"outticket"  validate:"omitempty,dive"`
 	TaskVariables types.EnvironmentVariableList `json:"variables"  validate:"omitempty,dive"`
+	Worker        WorkerSelector                `json:"worker,omitempty"`
+	TaskPriority  int                           `json:"priority,omitempty" validate:"min=0,max=99"`
+	IsIdempotent  bool                          `json:"idempotent,omitempty"`
 	Data          json.RawMessage               `json:"spec,omitempty"`
 }
 
@@ -133,6 +197,38 @@ const (
 	OutActionRemove OutAction = "REM"
 )
 
+//Possible conditions of out actions, apart from ranges of return codes and status codes
+const (
+	OutWhenOK    OutCondition = "OK"
+	OutWhenNotOK OutCondition = "NOTOK"
+	OutWhenAny   OutCondition = "ANY"
+)
+
+//Match - checks if a condition is met by a result of an execution. Conditions based on return and status codes
+//are met only if the task has ended and reported its codes.
+func (cond OutCondition) Match(ok, ended bool, rc, sc int32) bool {
+
+	switch cond {
+	case "", OutWhenOK:
+		return ok
+	case OutWhenNotOK:
+		return !ok
+	case OutWhenAny:
+		return true
+	}
+
+	code, from, to, valid := cond.codeRange()
+	if !valid || !ended {
+		return false
+	}
+
+	if code == "SC" {
+		return sc == from
+	}
+
+	return rc >= from && rc <= to
+}
+
 //Relation between input tickets
 //Expect all: COND-1 AND COND-2 AND ...
 //Expect one of them COND-1 OR COND-2 ...
@@ -223,9 +319,10 @@ func (task *baseTaskDefinition) TicketsOut() []OutTicketData {
 	return task.OutTickets
 }
 
-//TaskFlag - Provides information about required flags.
+//TaskFlag - Provides information about required flags and quantitative resources.
 type TaskFlag interface {
 	Flags() []FlagData
+	Quantities() []QuantityData
 }
 
 func (task *baseTaskDefinition) Flags() []FlagData {
@@ -233,6 +330,11 @@ func (task *baseTaskDefinition) Flags() []FlagData {
 	return task.FlagsTab
 }
 
+func (task *baseTaskDefinition) Quantities() []QuantityData {
+
+	return task.QuantitiesTab
+}
+
 //TaskCycling - Provides information about tasks cycle
 type TaskCycling interface {
 	Cyclic() CyclicTaskData
@@ -256,6 +358,9 @@ type TaskDefinition interface {
 	Expr() string
 	Variables() types.EnvironmentVariableList
 	Action() json.RawMessage
+	WorkerSelector() WorkerSelector
+	Priority() int
+	Idempotent() bool
 }
 
 //TypeName - returns task's type
@@ -284,6 +389,21 @@ func (task *baseTaskDefinition) Variables() types.EnvironmentVariableList {
 	return task.TaskVariables
 }
 
+//WorkerSelector - Gets restrictions of workers on which a task can be executed
+func (task *baseTaskDefinition) WorkerSelector() WorkerSelector {
+	return task.Worker
+}
+
+//Priority - Gets a priority of a task, a ready task with a higher priority is started first
+func (task *baseTaskDefinition) Priority() int {
+	return task.TaskPriority
+}
+
+//Idempotent - Can a task be executed again on another worker if its worker is lost
+func (task *baseTaskDefinition) Idempotent() bool {
+	return task.IsIdempotent
+}
+
 //FromPoolDirectory - Load task from file, Wrapper function for load from string
 func FromPoolDirectory(path string) (TaskDefinition, error) {
 	data, err := ioutil.ReadFile(path)
@@ -286,6 +286,42 @@ func TestGetTimeSpan(t *testing.T) {
 	}
 }
 
+func TestQuantities(t *testing.T) {
+
+	schdata := SchedulingData{OrderType: OrderingManual}
+
+	builder := DummyTaskBuilder{}
+	def, err := builder.WithBase("test", "dummy_quantities", "description").
+		WithSchedule(schdata).
+		WithQuantities([]QuantityData{{Name: "DB2_CONNECTIONS", Quantity: 2}}).Build()
+
+	if err != nil {
+		t.Fatal("task builder error", err)
+	}
+
+	if len(def.Quantities()) != 1 || def.Quantities()[0].Quantity != 2 {
+		t.Error("Unexpected values:", def.Quantities())
+	}
+
+	copied, err := (&DummyTaskBuilder{}).FromTemplate(def).Build()
+	if err != nil {
+		t.Fatal("task builder error", err)
+	}
+
+	if len(copied.Quantities()) != 1 || copied.Quantities()[0].Name != "DB2_CONNECTIONS" {
+		t.Error("Unexpected values:", copied.Quantities())
+	}
+
+	builder = DummyTaskBuilder{}
+	_, err = builder.WithBase("test", "dummy_quantities", "description").
+		WithSchedule(schdata).
+		WithQuantities([]QuantityData{{Name: "DB2_CONNECTIONS", Quantity: 0}}).Build()
+
+	if err == nil {
+		t.Error("Unexpected result, invalid quantity")
+	}
+}
+
 func TestCyclicData(t *testing.T) {
 
 	path, _ := filepath.Abs(defDirectory)
@@ -0,0 +1,61 @@
+package taskdef
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/przebro/overseer/common/validator"
+
+	vl "github.com/go-playground/validator/v10"
+)
+
+func init() {
+
+	validator.Valid.RegisterTypeValidator("OutCondition", "outcond", OutConditionValidator)
+}
+
+var outConditionExpr = regexp.MustCompile(`^(RC|SC)=(\d{1,5})(?:-(\d{1,5}))?$`)
+
+//OutConditionValidator - validator function for an OutCondition type
+func OutConditionValidator(fl vl.FieldLevel) bool {
+
+	if actual, ok := fl.Field().Interface().(OutCondition); ok {
+		return actual.validateValue()
+	}
+
+	return false
+}
+
+//validateValue - validates a condition of an out ticket
+func (cond OutCondition) validateValue() bool {
+
+	if cond == OutWhenOK || cond == OutWhenNotOK || cond == OutWhenAny {
+		return true
+	}
+
+	code, from, to, ok := cond.codeRange()
+	if !ok || from > to {
+		return false
+	}
+
+	//status code can't be a range
+	return code == "RC" || from == to
+}
+
+//codeRange - returns a kind of a code (RC or SC) and a range of values from a condition
+func (cond OutCondition) codeRange() (string, int32, int32, bool) {
+
+	match := outConditionExpr.FindStringSubmatch(string(cond))
+	if match == nil {
+		return "", 0, 0, false
+	}
+
+	from, _ := strconv.Atoi(match[2])
+	to := from
+
+	if match[3] != "" {
+		to, _ = strconv.Atoi(match[3])
+	}
+
+	return match[1], int32(from), int32(to), true
+}
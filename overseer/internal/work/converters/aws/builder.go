@@ -10,20 +10,22 @@ import (
 )
 
 type awsTaskDatabuilder interface {
-	build() *actions.AwsTaskAction
+	build() (*actions.AwsTaskAction, error)
 }
 
 type taskDataBuilder struct {
-	action *actions.AwsTaskAction
-	v      types.EnvironmentVariableList
+	action  *actions.AwsTaskAction
+	v       types.EnvironmentVariableList
+	secrets converter.SecretReader
+	err     error
 }
 
-func newBuilder(v types.EnvironmentVariableList) *taskDataBuilder {
-	return &taskDataBuilder{action: &actions.AwsTaskAction{}, v: v}
+func newBuilder(v types.EnvironmentVariableList, secrets converter.SecretReader) *taskDataBuilder {
+	return &taskDataBuilder{action: &actions.AwsTaskAction{}, v: v, secrets: secrets}
 }
 
-func (b *taskDataBuilder) build() *actions.AwsTaskAction {
-	return b.action
+func (b *taskDataBuilder) build() (*actions.AwsTaskAction, error) {
+	return b.action, b.err
 }
 func (b *taskDataBuilder) withLambda(lambda taskdef.AwsLambdaTaskData) *taskDataBuilder {
 
@@ -42,7 +44,10 @@ func (b *taskDataBuilder) withStepFunction(stepfunc taskdef.AwsStepFunctionTaskD
 
 	b.action.Type = actions.AwsTaskAction_stepfunc
 
-	execName := converter.ReplaceVariables(stepfunc.ExecutionName, b.v)
+	execName, err := converter.ReplaceVariablesAndSecrets(stepfunc.ExecutionName, b.v, b.secrets)
+	if err != nil {
+		b.err = err
+	}
 
 	b.action.Execution = &actions.AwsTaskAction_StepFunction{
 		StepFunction: &actions.AwsStepFunctionExecution{
@@ -21,7 +21,7 @@ type awsConverter struct {
 }
 
 //ConvertToMsg - converts aws task specific data to proto message
-func (c *awsConverter) ConvertToMsg(data json.RawMessage, variables types.EnvironmentVariableList) (*any.Any, error) {
+func (c *awsConverter) ConvertToMsg(data json.RawMessage, variables types.EnvironmentVariableList, secrets converter.SecretReader) (*any.Any, error) {
 
 	result := &taskdef.AwsTaskData{}
 
@@ -29,7 +29,7 @@ func (c *awsConverter) ConvertToMsg(data json.RawMessage, variables types.Enviro
 		return nil, err
 	}
 
-	b := newBuilder(variables)
+	b := newBuilder(variables, secrets)
 
 	if v, ok := result.IsConnection_AwsConnectionProperties(); ok {
 
@@ -75,7 +75,10 @@ func (c *awsConverter) ConvertToMsg(data json.RawMessage, variables types.Enviro
 		return nil, errors.New("unknown payload type")
 	}
 
-	act := b.build()
+	act, err := b.build()
+	if err != nil {
+		return nil, err
+	}
 
 	out, err := proto.Marshal(act)
 	if err != nil {
@@ -12,7 +12,7 @@ var input string = `{"type":"lambda","connection":{"profile":"overseer","region"
 func Test_Converter(t *testing.T) {
 
 	awsConverter := &awsConverter{}
-	_, err := awsConverter.ConvertToMsg(json.RawMessage(input), types.EnvironmentVariableList{})
+	_, err := awsConverter.ConvertToMsg(json.RawMessage(input), types.EnvironmentVariableList{}, nil)
 	if err != nil {
 		t.Error("unexpected result:", err)
 	}
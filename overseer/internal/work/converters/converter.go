@@ -3,7 +3,7 @@ package converter
 import (
 	"encoding/json"
 	"errors"
-	"regexp"
+	"fmt"
 	"strings"
 
 	"github.com/przebro/overseer/common/types"
@@ -16,9 +16,15 @@ var converters map[types.TaskType]TaskActionConverter = make(map[types.TaskType]
 //ErrConverterNotRegistered - occurs when converter for given type is not registered
 var ErrConverterNotRegistered error = errors.New("converter not registered for given type")
 
-//TaskActionConverter - converts json raw data to any
+//ErrUndefinedVariable - occurs when data refers to a variable that is not defined
+var ErrUndefinedVariable error = errors.New("undefined variables")
+
+//ErrCyclicVariable - occurs when variables refer to each other in a cycle
+var ErrCyclicVariable error = errors.New("cyclic reference of variables")
+
+//TaskActionConverter - converts json raw data to any, variables should be already resolved and secrets are read from a given reader
 type TaskActionConverter interface {
-	ConvertToMsg(data json.RawMessage, variables types.EnvironmentVariableList) (*any.Any, error)
+	ConvertToMsg(data json.RawMessage, variables types.EnvironmentVariableList, secrets SecretReader) (*any.Any, error)
 }
 
 //RegisterConverter - registers converter for given task type
@@ -27,26 +33,203 @@ func RegisterConverter(taskType types.TaskType, c TaskActionConverter) {
 }
 
 //ConvertToMsg - converts raw json to any
-func ConvertToMsg(taskType types.TaskType, data json.RawMessage, variables types.EnvironmentVariableList) (*any.Any, error) {
+func ConvertToMsg(taskType types.TaskType, data json.RawMessage, variables types.EnvironmentVariableList, secrets SecretReader) (*any.Any, error) {
 
 	converter, ok := converters[taskType]
 	if !ok {
 		return nil, ErrConverterNotRegistered
 	}
 
-	return converter.ConvertToMsg(data, variables)
+	return converter.ConvertToMsg(data, variables, secrets)
+}
+
+//ReplaceVariables - replaces variables and evaluates functions in input data, references between variables are resolved before replacement.
+//Returns an error if input data or any of the variables refer to a variable that is not defined.
+func ReplaceVariables(in string, variables types.EnvironmentVariableList) (string, error) {
+
+	resolved, err := ResolveVariables(variables)
+	if err != nil {
+		return "", err
+	}
+
+	return ReplaceResolvedVariables(in, resolved)
+}
+
+//ReplaceResolvedVariables - replaces variables and evaluates functions in input data, values of variables are inserted
+//without evaluation, so variables should be already resolved with ResolveVariables. References to secrets are left unchanged.
+func ReplaceResolvedVariables(in string, variables types.EnvironmentVariableList) (string, error) {
+
+	return replace(in, variables, nil)
+}
+
+//ReplaceVariablesAndSecrets - replaces variables, evaluates functions and replaces references to secrets with values
+//read from a given reader in a single pass, so escaped text, also in values of variables, is never taken for a reference.
+//Variables should be already resolved with ResolveVariables.
+func ReplaceVariablesAndSecrets(in string, variables types.EnvironmentVariableList, secrets SecretReader) (string, error) {
+
+	return replace(in, variables, readSecret(secrets))
+}
+
+//replace - replaces resolved variables in input data, if secret is set, references to secrets are replaced too
+func replace(in string, variables types.EnvironmentVariableList, secret func(name string) (string, bool, error)) (string, error) {
+
+	values := map[string]string{}
+	for _, v := range variables {
+		values[v.Name] = v.Value
+	}
+
+	e := &evaluator{secret: secret, lookup: func(name string) (string, bool, error) {
+		value, ok := values[name]
+		return value, ok, nil
+	}}
+
+	out, err := e.evaluate(in)
+	if err != nil {
+		return "", err
+	}
+
+	if len(e.undefined) != 0 {
+		return "", fmt.Errorf("%w:%s", ErrUndefinedVariable, strings.Join(unique(e.undefined), ","))
+	}
+
+	if len(e.secrets) != 0 {
+		return "", fmt.Errorf("%w:%s", ErrUndefinedSecret, strings.Join(unique(e.secrets), ","))
+	}
+
+	return out, nil
+}
+
+//ReplaceAction - replaces variables in all string values of an action of a task. The action is decoded first,
+//so variables and functions are evaluated in values as they are written in a definition, not in encoded json.
+func ReplaceAction(data json.RawMessage, variables types.EnvironmentVariableList) (json.RawMessage, error) {
+
+	if len(data) == 0 {
+		return data, nil
+	}
+
+	var action interface{}
+	if err := json.Unmarshal(data, &action); err != nil {
+		return nil, err
+	}
+
+	action, err := replaceValues(action, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(action)
+}
+
+//replaceValues - replaces variables in a decoded json value
+func replaceValues(value interface{}, variables types.EnvironmentVariableList) (interface{}, error) {
+
+	var err error
+
+	switch v := value.(type) {
+	case string:
+		return ReplaceResolvedVariables(v, variables)
+	case []interface{}:
+		for i := range v {
+			if v[i], err = replaceValues(v[i], variables); err != nil {
+				return nil, err
+			}
+		}
+	case map[string]interface{}:
+		for k := range v {
+			if v[k], err = replaceValues(v[k], variables); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return value, nil
+}
+
+//ResolveVariables - returns a list of variables with values that contain references to other variables resolved,
+//escaped %% and references to secrets are kept in values until they are inserted into data sent to a worker
+func ResolveVariables(variables types.EnvironmentVariableList) (types.EnvironmentVariableList, error) {
+
+	resolved, err := resolve(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(types.EnvironmentVariableList, len(variables))
+	for i, v := range variables {
+		result[i] = types.EnvironmentVariable{Name: v.Name, Value: resolved[v.Name]}
+	}
+
+	return result, nil
+}
+
+//resolve - resolves values of variables, if a variable is defined more than once, the last definition is used
+func resolve(variables types.EnvironmentVariableList) (map[string]string, error) {
+
+	values := map[string]string{}
+	for _, v := range variables {
+		values[v.Name] = v.Value
+	}
+
+	resolved := map[string]string{}
+	undefined := []string{}
+
+	var expand func(name string, path []string) (string, error)
+	expand = func(name string, path []string) (string, error) {
+
+		if value, ok := resolved[name]; ok {
+			return value, nil
+		}
+
+		for _, p := range path {
+			if p == name {
+				return "", fmt.Errorf("%w:%s", ErrCyclicVariable, strings.Join(append(path, name), "->"))
+			}
+		}
+
+		path = append(path, name)
+
+		e := &evaluator{keep: true, lookup: func(ref string) (string, bool, error) {
+			if _, ok := values[ref]; !ok {
+				return "", false, nil
+			}
+			v, err := expand(ref, path)
+			return v, true, err
+		}}
+
+		value, err := e.evaluate(values[name])
+		undefined = append(undefined, e.undefined...)
+		if err != nil {
+			return "", err
+		}
+
+		resolved[name] = value
+		return value, nil
+	}
+
+	for _, v := range variables {
+		if _, err := expand(v.Name, []string{}); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(undefined) != 0 {
+		return nil, fmt.Errorf("%w:%s", ErrUndefinedVariable, strings.Join(unique(undefined), ","))
+	}
+
+	return resolved, nil
 }
 
-//ReplaceVariables - replace variables in input data
-func ReplaceVariables(in string, variables types.EnvironmentVariableList) string {
-	reg := regexp.MustCompile(`\%\%[A-Z0-9_]+`)
+func unique(names []string) []string {
+
+	result := []string{}
+	exists := map[string]bool{}
 
-	out := in
-	for _, n := range variables {
-		if reg.MatchString(n.Name) {
-			out = strings.Replace(out, n.Name, n.Value, -1)
+	for _, n := range names {
+		if !exists[n] {
+			exists[n] = true
+			result = append(result, n)
 		}
 	}
 
-	return out
+	return result
 }
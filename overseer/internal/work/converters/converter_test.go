@@ -0,0 +1,307 @@
+package converter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/przebro/overseer/common/types"
+)
+
+func TestReplaceVariables(t *testing.T) {
+
+	vars := types.EnvironmentVariableList{
+		{Name: "%%ODATE", Value: "20201115"},
+		{Name: "%%HOME", Value: "/home/%%USER"},
+		{Name: "%%USER", Value: "overseer"},
+		{Name: "%%LOG", Value: "%%HOME/log/%%ODATE.log"},
+	}
+
+	out, err := ReplaceVariables("cat %%LOG > %%HOME/%%ODATE", vars)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expected := "cat /home/overseer/log/20201115.log > /home/overseer/20201115"
+	if out != expected {
+		t.Error("unexpected result:", out, " expected:", expected)
+	}
+
+	out, err = ReplaceVariables("echo %%ODATEX", append(vars, types.EnvironmentVariable{Name: "%%ODATEX", Value: "X"}))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if out != "echo X" {
+		t.Error("unexpected result:", out, " expected:", "echo X")
+	}
+
+	out, err = ReplaceVariables("echo %%ODATE", append(vars, types.EnvironmentVariable{Name: "%%ODATE", Value: "20201116"}))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if out != "echo 20201116" {
+		t.Error("unexpected result:", out, " expected:", "echo 20201116")
+	}
+}
+
+func TestReplaceVariables_Undefined(t *testing.T) {
+
+	vars := types.EnvironmentVariableList{
+		{Name: "%%HOME", Value: "/home/%%USER"},
+	}
+
+	if _, err := ReplaceVariables("ls %%HOME", vars); !errors.Is(err, ErrUndefinedVariable) {
+		t.Error("unexpected result:", err, " expected:", ErrUndefinedVariable)
+	}
+
+	vars = append(vars, types.EnvironmentVariable{Name: "%%USER", Value: "overseer"})
+
+	if _, err := ReplaceVariables("ls %%HOME %%DIR", vars); !errors.Is(err, ErrUndefinedVariable) {
+		t.Error("unexpected result:", err, " expected:", ErrUndefinedVariable)
+	}
+}
+
+func TestReplaceVariables_Cycle(t *testing.T) {
+
+	vars := types.EnvironmentVariableList{
+		{Name: "%%A", Value: "%%B"},
+		{Name: "%%B", Value: "%%C"},
+		{Name: "%%C", Value: "%%A"},
+	}
+
+	if _, err := ReplaceVariables("echo %%A", vars); !errors.Is(err, ErrCyclicVariable) {
+		t.Error("unexpected result:", err, " expected:", ErrCyclicVariable)
+	}
+}
+
+func TestResolveVariables(t *testing.T) {
+
+	vars := types.EnvironmentVariableList{
+		{Name: "%%LOG", Value: "%%HOME/log"},
+		{Name: "%%HOME", Value: "/home/overseer"},
+	}
+
+	result, err := ResolveVariables(vars)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(result) != 2 || result[0].Name != "%%LOG" || result[0].Value != "/home/overseer/log" {
+		t.Error("unexpected result:", result)
+	}
+}
+
+func TestReplaceVariables_Escape(t *testing.T) {
+
+	vars := types.EnvironmentVariableList{
+		{Name: "%%ODATE", Value: "20201115"},
+		{Name: "%%HOUR", Value: "+%%%%H"},
+	}
+
+	out, err := ReplaceVariables("date +%%%%H_%%ODATE %%HOUR %%%%ODATE", vars)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expected := "date +%%H_20201115 +%%H %%ODATE"
+	if out != expected {
+		t.Error("unexpected result:", out, " expected:", expected)
+	}
+
+	if _, err := ReplaceVariables("date +%%H", vars); !errors.Is(err, ErrUndefinedVariable) {
+		t.Error("unexpected result:", err, " expected:", ErrUndefinedVariable)
+	}
+}
+
+func TestReplaceAction(t *testing.T) {
+
+	vars, err := ResolveVariables(types.EnvironmentVariableList{
+		{Name: "%%ODATE", Value: "20201115"},
+		{Name: "%%HOUR", Value: "+%%%%H"},
+	})
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	action := `{"type":"command","command":"echo %%$FORMAT(%%ODATE,\"2006/01/02\") $(date %%HOUR) %%%%X","args":["%%ODATE",1]}`
+
+	out, err := ReplaceAction([]byte(action), vars)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expected := `{"args":["20201115",1],"command":"echo 2020/11/15 $(date +%%H) %%X","type":"command"}`
+	if string(out) != expected {
+		t.Error("unexpected result:", string(out), " expected:", expected)
+	}
+
+	if _, err := ReplaceAction([]byte(`{"command":"date +%%H"}`), vars); !errors.Is(err, ErrUndefinedVariable) {
+		t.Error("unexpected result:", err, " expected:", ErrUndefinedVariable)
+	}
+}
+
+func TestReplaceVariables_Functions(t *testing.T) {
+
+	vars := types.EnvironmentVariableList{
+		{Name: "%%ODATE", Value: "201115"},
+		{Name: "%%NAME", Value: "Overseer"},
+		{Name: "%%YESTERDAY", Value: "%%$CALCDATE(%%ODATE,-1)"},
+	}
+
+	tdata := []struct {
+		in       string
+		expected string
+	}{
+		{in: "%%$CALCDATE(%%ODATE,-1)", expected: "201114"},
+		{in: "%%$CALCDATE(20201231, 1)", expected: "20210101"},
+		{in: "%%YESTERDAY", expected: "201114"},
+		{in: `%%$FORMAT(%%ODATE,"2006/01/02")`, expected: "2020/11/15"},
+		{in: `%%$FORMAT(%%$CALCDATE(%%ODATE,-15),"2006-01-02")`, expected: "2020-10-31"},
+		{in: "week %%$WEEK", expected: "week 46"},
+		{in: "%%$WEEK(20210104)", expected: "01"},
+		{in: "%%$MONTHEND", expected: "201130"},
+		{in: "%%$MONTHEND(20200215)", expected: "20200229"},
+		{in: "%%$SUBSTR(%%NAME,1,4)", expected: "Over"},
+		{in: "%%$SUBSTR(%%NAME,5)", expected: "seer"},
+		{in: "%%$SUBSTR(%%NAME,5,10)", expected: "seer"},
+		{in: "%%$UPPER(%%NAME)_%%$LOWER(%%NAME)", expected: "OVERSEER_overseer"},
+		{in: `%%$UPPER("a,b(c)\"")`, expected: `A,B(C)"`},
+	}
+
+	for _, d := range tdata {
+		out, err := ReplaceVariables(d.in, vars)
+		if err != nil {
+			t.Error("unexpected error:", d.in, err)
+			continue
+		}
+		if out != d.expected {
+			t.Error("unexpected result:", out, " expected:", d.expected)
+		}
+	}
+}
+
+func TestReplaceVariables_FunctionErrors(t *testing.T) {
+
+	vars := types.EnvironmentVariableList{
+		{Name: "%%ODATE", Value: "201115"},
+	}
+
+	tdata := []string{
+		"%%$CALCDATE(%%ODATE,-1",
+		"%%$CALCDATE(%%ODATE)",
+		"%%$CALCDATE(%%ODATE,X)",
+		"%%$CALCDATE(20201332,1)",
+		"%%$UNKNOWN(1)",
+		"%%$(1)",
+		`%%$UPPER("abc)`,
+		"%%$SUBSTR(abc,0)",
+		"%%$SUBSTR(abc,1,-1)",
+	}
+
+	for _, in := range tdata {
+		if _, err := ReplaceVariables(in, vars); !errors.Is(err, ErrInvalidFunction) {
+			t.Error("unexpected result:", in, err, " expected:", ErrInvalidFunction)
+		}
+	}
+
+	if _, err := ReplaceVariables("%%$WEEK", types.EnvironmentVariableList{}); !errors.Is(err, ErrInvalidFunction) {
+		t.Error("unexpected result:", err, " expected:", ErrInvalidFunction)
+	}
+
+	if _, err := ReplaceVariables("%%$CALCDATE(%%DATE,1)", vars); !errors.Is(err, ErrUndefinedVariable) {
+		t.Error("unexpected result:", err, " expected:", ErrUndefinedVariable)
+	}
+}
+
+type mockSecretReader struct {
+	secrets map[string]string
+}
+
+func (m *mockSecretReader) Secret(name string) (string, bool, error) {
+	value, ok := m.secrets[name]
+	return value, ok, nil
+}
+
+func TestReplaceSecrets(t *testing.T) {
+
+	vars := types.EnvironmentVariableList{
+		{Name: "%%USER", Value: "overseer"},
+		{Name: "%%PASS", Value: "%%SECRET(DB-PASS)"},
+	}
+
+	out, err := ReplaceVariables("login %%USER %%PASS %%SECRET(DB-PASS)", vars)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if out != "login overseer %%SECRET(DB-PASS) %%SECRET(DB-PASS)" {
+		t.Error("unexpected result, secret resolved with variables:", out)
+	}
+
+	if _, err := ReplaceSecrets(out, nil); !errors.Is(err, ErrUndefinedSecret) {
+		t.Error("unexpected result:", err, " expected:", ErrUndefinedSecret)
+	}
+
+	reader := &mockSecretReader{secrets: map[string]string{"DB-PASS": "P@ssw0rd"}}
+
+	if out, err = ReplaceSecrets(out, reader); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if out != "login overseer P@ssw0rd P@ssw0rd" {
+		t.Error("unexpected result:", out)
+	}
+
+	if _, err := ReplaceSecrets("login %%SECRET(DB-USER)", reader); !errors.Is(err, ErrUndefinedSecret) {
+		t.Error("unexpected result:", err, " expected:", ErrUndefinedSecret)
+	}
+
+	if _, err := ReplaceVariables("%%$UPPER(%%PASS)", vars); !errors.Is(err, ErrInvalidFunction) {
+		t.Error("unexpected result:", err, " expected:", ErrInvalidFunction)
+	}
+
+	if _, err := ReplaceVariables("%%SECRET(-invalid)", vars); !errors.Is(err, ErrInvalidFunction) {
+		t.Error("unexpected result:", err, " expected:", ErrInvalidFunction)
+	}
+}
+
+func TestReplaceVariablesAndSecrets_Escape(t *testing.T) {
+
+	vars, err := ResolveVariables(types.EnvironmentVariableList{
+		{Name: "%%PASS", Value: "%%SECRET(DB-PASS)"},
+		{Name: "%%PATTERN", Value: "%%%%SECRET(DB-PASS)"},
+		{Name: "%%ORDERED", Value: "%%PATTERN"},
+	})
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if vars[2].Value != "%%%%SECRET(DB-PASS)" {
+		t.Error("unexpected result, escape not kept:", vars[2].Value)
+	}
+
+	reader := &mockSecretReader{secrets: map[string]string{"DB-PASS": "P@ssw0rd"}}
+
+	out, err := ReplaceVariablesAndSecrets("grep %%%%SECRET(DB-PASS) %%ORDERED %%PASS %%SECRET(DB-PASS)", vars, reader)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expected := "grep %%SECRET(DB-PASS) %%SECRET(DB-PASS) P@ssw0rd P@ssw0rd"
+	if out != expected {
+		t.Error("unexpected result:", out, " expected:", expected)
+	}
+
+	if out, err = ReplaceSecrets(vars[2].Value, reader); err != nil || out != "%%SECRET(DB-PASS)" {
+		t.Error("unexpected result:", out, err)
+	}
+
+	if _, err := ReplaceVariablesAndSecrets("login %%SECRET(DB-USER)", vars, reader); !errors.Is(err, ErrUndefinedSecret) {
+		t.Error("unexpected result:", err, " expected:", ErrUndefinedSecret)
+	}
+
+	if _, err := ReplaceVariablesAndSecrets("%%$UPPER(%%ORDERED)", vars, reader); err != nil {
+		t.Error("unexpected error:", err)
+	}
+}
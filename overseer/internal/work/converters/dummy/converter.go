@@ -19,7 +19,7 @@ func init() {
 type dummyConverter struct {
 }
 
-func (c *dummyConverter) ConvertToMsg(data json.RawMessage, variables types.EnvironmentVariableList) (*any.Any, error) {
+func (c *dummyConverter) ConvertToMsg(data json.RawMessage, variables types.EnvironmentVariableList, secrets converter.SecretReader) (*any.Any, error) {
 
 	cmd := &actions.DummyTaskAction{Data: ""}
 	act, err := proto.Marshal(cmd)
@@ -0,0 +1,458 @@
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/przebro/overseer/common/types/date"
+)
+
+//ErrInvalidFunction - occurs when data contains a function with invalid syntax, name or arguments
+var ErrInvalidFunction error = errors.New("invalid function")
+
+const (
+	escapePrefix   = "%%%%"
+	functionPrefix = "%%$"
+	odateVariable  = "%%ODATE"
+	shortDateLen   = 6
+	longDateLen    = 8
+	century        = "20"
+)
+
+var variableToken = regexp.MustCompile(`^\%\%[A-Z0-9_]+`)
+var functionName = regexp.MustCompile(`^[A-Z][A-Z0-9]*`)
+
+type function func(e *evaluator, name string, args []string) (string, error)
+
+var functions = map[string]function{
+	"CALCDATE": calcdate,
+	"FORMAT":   format,
+	"WEEK":     week,
+	"MONTHEND": monthend,
+	"SUBSTR":   substr,
+	"UPPER":    upper,
+	"LOWER":    lower,
+}
+
+//evaluator - replaces variables and evaluates functions in input data. If keep is set, escaped %% and references
+//to secrets are kept, so values of variables can be resolved before they are inserted into data. If secret is set,
+//references to secrets are replaced with values in the same pass, so escaped text is never taken for a reference.
+type evaluator struct {
+	lookup    func(name string) (string, bool, error)
+	secret    func(name string) (string, bool, error)
+	keep      bool
+	undefined []string
+	secrets   []string
+}
+
+//evaluate - returns input data with variables replaced and functions evaluated,
+//references to undefined variables are left unchanged and collected.
+func (e *evaluator) evaluate(in string) (string, error) {
+
+	out, _, err := e.parse(in, 0, false)
+	return out, err
+}
+
+//parse - parses input from a given position, if arg is true, parsing stops at an argument separator or a closing parenthesis.
+func (e *evaluator) parse(in string, pos int, arg bool) (string, int, error) {
+
+	var out strings.Builder
+
+	for pos < len(in) {
+
+		c := in[pos]
+
+		switch {
+		case strings.HasPrefix(in[pos:], escapePrefix):
+			out.WriteString(e.escape())
+			pos += len(escapePrefix)
+		case strings.HasPrefix(in[pos:], functionPrefix):
+			value, next, err := e.function(in, pos)
+			if err != nil {
+				return "", next, err
+			}
+			out.WriteString(value)
+			pos = next
+		case strings.HasPrefix(in[pos:], secretPrefix):
+			ref := secretToken.FindString(in[pos:])
+			if ref == "" {
+				return "", pos, fmt.Errorf("%w:invalid reference to a secret at position %d", ErrInvalidFunction, pos)
+			}
+			value, err := e.secretRef(ref, arg)
+			if err != nil {
+				return "", pos, err
+			}
+			out.WriteString(value)
+			pos += len(ref)
+		case c == '%' && variableToken.MatchString(in[pos:]):
+			name := variableToken.FindString(in[pos:])
+			value, err := e.variable(name)
+			if err != nil {
+				return "", pos, err
+			}
+			if value, err = e.literal(value, arg); err != nil {
+				return "", pos, err
+			}
+			out.WriteString(value)
+			pos += len(name)
+		case arg && c == '"':
+			value, next, err := quoted(in, pos)
+			if err != nil {
+				return "", next, err
+			}
+			if strings.Contains(value, secretPrefix) {
+				return "", next, fmt.Errorf("%w:secret cannot be used as an argument", ErrInvalidFunction)
+			}
+			out.WriteString(value)
+			pos = next
+		case arg && (c == ',' || c == ')'):
+			return out.String(), pos, nil
+		default:
+			out.WriteByte(c)
+			pos++
+		}
+	}
+
+	return out.String(), pos, nil
+}
+
+//escape - returns an escaped %%, it is kept until data is sent to a worker
+func (e *evaluator) escape() string {
+
+	if e.keep {
+		return escapePrefix
+	}
+
+	return "%%"
+}
+
+//secretRef - returns the value of a referenced secret, if secrets are not resolved the reference is left unchanged.
+//A secret cannot be used as an argument of a function, so its value never becomes a part of a result of a function.
+func (e *evaluator) secretRef(ref string, arg bool) (string, error) {
+
+	if arg {
+		return "", fmt.Errorf("%w:secret cannot be used as an argument", ErrInvalidFunction)
+	}
+
+	if e.secret == nil {
+		return ref, nil
+	}
+
+	name := ref[len(secretPrefix) : len(ref)-1]
+	value, ok, err := e.secret(name)
+	if err != nil {
+		return "", err
+	}
+
+	if !ok {
+		e.secrets = append(e.secrets, name)
+		return ref, nil
+	}
+
+	return value, nil
+}
+
+//literal - handles escapes and references to secrets in the value of a variable, the value is already resolved,
+//so variables and functions in it are not evaluated again
+func (e *evaluator) literal(in string, arg bool) (string, error) {
+
+	var out strings.Builder
+
+	for pos := 0; pos < len(in); {
+
+		switch {
+		case strings.HasPrefix(in[pos:], escapePrefix):
+			out.WriteString(e.escape())
+			pos += len(escapePrefix)
+		case strings.HasPrefix(in[pos:], secretPrefix) && secretToken.MatchString(in[pos:]):
+			ref := secretToken.FindString(in[pos:])
+			value, err := e.secretRef(ref, arg)
+			if err != nil {
+				return "", err
+			}
+			out.WriteString(value)
+			pos += len(ref)
+		default:
+			out.WriteByte(in[pos])
+			pos++
+		}
+	}
+
+	return out.String(), nil
+}
+
+//variable - returns the value of a variable, if the variable is not defined its name is returned
+func (e *evaluator) variable(name string) (string, error) {
+
+	value, ok, err := e.lookup(name)
+	if err != nil {
+		return "", err
+	}
+
+	if !ok {
+		e.undefined = append(e.undefined, name)
+		return name, nil
+	}
+
+	return value, nil
+}
+
+//function - parses and evaluates a function that starts at a given position
+func (e *evaluator) function(in string, pos int) (string, int, error) {
+
+	start := pos
+	pos += len(functionPrefix)
+
+	name := functionName.FindString(in[pos:])
+	if name == "" {
+		return "", pos, fmt.Errorf("%w:missing function name at position %d", ErrInvalidFunction, start)
+	}
+	pos += len(name)
+
+	fn, ok := functions[name]
+	if !ok {
+		return "", pos, fmt.Errorf("%w:%s, unknown function", ErrInvalidFunction, name)
+	}
+
+	undefined := len(e.undefined)
+	args := []string{}
+
+	if pos < len(in) && in[pos] == '(' {
+		pos++
+		for {
+			value, next, err := e.parse(in, pos, true)
+			if err != nil {
+				return "", next, err
+			}
+			if next >= len(in) {
+				return "", next, fmt.Errorf("%w:%s, missing closing parenthesis", ErrInvalidFunction, name)
+			}
+			args = append(args, value)
+			pos = next + 1
+			if in[next] == ')' {
+				break
+			}
+		}
+		if len(args) == 1 && args[0] == "" {
+			args = []string{}
+		}
+	}
+
+	//arguments refer to undefined variables, the function is left unevaluated so the undefined variables are reported
+	if len(e.undefined) > undefined {
+		return in[start:pos], pos, nil
+	}
+
+	value, err := fn(e, name, args)
+	return value, pos, err
+}
+
+//quoted - returns a quoted string that starts at a given position, quotation mark and backslash can be escaped with backslash
+func quoted(in string, pos int) (string, int, error) {
+
+	var out strings.Builder
+
+	for pos++; pos < len(in); pos++ {
+		switch in[pos] {
+		case '\\':
+			if pos+1 < len(in) && (in[pos+1] == '"' || in[pos+1] == '\\') {
+				pos++
+			}
+			out.WriteByte(in[pos])
+		case '"':
+			return out.String(), pos + 1, nil
+		default:
+			out.WriteByte(in[pos])
+		}
+	}
+
+	return "", pos, fmt.Errorf("%w:missing closing quotation mark", ErrInvalidFunction)
+}
+
+//checkArgs - checks if the number of arguments is within a given range
+func checkArgs(name string, args []string, min, max int) error {
+
+	if len(args) < min || len(args) > max {
+		return fmt.Errorf("%w:%s, invalid number of arguments:%d", ErrInvalidFunction, name, len(args))
+	}
+
+	return nil
+}
+
+//dateArg - returns a date from arguments, if the argument is omitted, the value of %%ODATE is used.
+//Date can be given in the YYYYMMDD or YYMMDD format, whether the date is short is returned to keep the format of the result.
+func (e *evaluator) dateArg(name string, args []string, n int) (date.Odate, bool, error) {
+
+	var value string
+
+	if len(args) > n {
+		value = strings.TrimSpace(args[n])
+	} else {
+		v, ok, err := e.lookup(odateVariable)
+		if err != nil {
+			return "", false, err
+		}
+		if !ok {
+			return "", false, fmt.Errorf("%w:%s, date argument is required", ErrInvalidFunction, name)
+		}
+		value = v
+	}
+
+	short := len(value) == shortDateLen
+	odate := date.Odate(value)
+	if short {
+		odate = date.Odate(century + value)
+	}
+
+	if len(odate) != longDateLen || date.FromDateString(odate.FormatDate()) != odate {
+		return "", false, fmt.Errorf("%w:%s, invalid date:%s", ErrInvalidFunction, name, value)
+	}
+
+	return odate, short, nil
+}
+
+//dateResult - returns a date in the format of an argument
+func dateResult(odate date.Odate, short bool) string {
+
+	if short {
+		return odate.ODATE()
+	}
+
+	return string(odate)
+}
+
+//intArg - returns an integer argument
+func intArg(name string, args []string, n int) (int, error) {
+
+	value, err := strconv.Atoi(strings.TrimSpace(args[n]))
+	if err != nil {
+		return 0, fmt.Errorf("%w:%s, invalid number:%s", ErrInvalidFunction, name, args[n])
+	}
+
+	return value, nil
+}
+
+//calcdate - %%$CALCDATE(date,days) adds a number of days to a date
+func calcdate(e *evaluator, name string, args []string) (string, error) {
+
+	if err := checkArgs(name, args, 2, 2); err != nil {
+		return "", err
+	}
+
+	odate, short, err := e.dateArg(name, args, 0)
+	if err != nil {
+		return "", err
+	}
+
+	days, err := intArg(name, args, 1)
+	if err != nil {
+		return "", err
+	}
+
+	return dateResult(date.AddDays(odate, days), short), nil
+}
+
+//format - %%$FORMAT(date,layout) formats a date with a go time layout
+func format(e *evaluator, name string, args []string) (string, error) {
+
+	if err := checkArgs(name, args, 2, 2); err != nil {
+		return "", err
+	}
+
+	odate, _, err := e.dateArg(name, args, 0)
+	if err != nil {
+		return "", err
+	}
+
+	y, m, d := odate.Ymd()
+	return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.Local).Format(args[1]), nil
+}
+
+//week - %%$WEEK or %%$WEEK(date) returns the ISO week number of a date
+func week(e *evaluator, name string, args []string) (string, error) {
+
+	if err := checkArgs(name, args, 0, 1); err != nil {
+		return "", err
+	}
+
+	odate, _, err := e.dateArg(name, args, 0)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%02d", odate.Woyear()), nil
+}
+
+//monthend - %%$MONTHEND or %%$MONTHEND(date) returns the last day of the month of a date
+func monthend(e *evaluator, name string, args []string) (string, error) {
+
+	if err := checkArgs(name, args, 0, 1); err != nil {
+		return "", err
+	}
+
+	odate, short, err := e.dateArg(name, args, 0)
+	if err != nil {
+		return "", err
+	}
+
+	return dateResult(date.MonthEnd(odate), short), nil
+}
+
+//substr - %%$SUBSTR(str,start[,length]) returns a part of a string, start is counted from 1
+func substr(e *evaluator, name string, args []string) (string, error) {
+
+	if err := checkArgs(name, args, 2, 3); err != nil {
+		return "", err
+	}
+
+	runes := []rune(args[0])
+
+	start, err := intArg(name, args, 1)
+	if err != nil {
+		return "", err
+	}
+
+	if start < 1 || start > len(runes)+1 {
+		return "", fmt.Errorf("%w:%s, start out of range:%d", ErrInvalidFunction, name, start)
+	}
+
+	end := len(runes)
+	if len(args) == 3 {
+		length, err := intArg(name, args, 2)
+		if err != nil {
+			return "", err
+		}
+		if length < 0 {
+			return "", fmt.Errorf("%w:%s, invalid length:%d", ErrInvalidFunction, name, length)
+		}
+		if start-1+length < end {
+			end = start - 1 + length
+		}
+	}
+
+	return string(runes[start-1 : end]), nil
+}
+
+//upper - %%$UPPER(str) returns a string with all letters in upper case
+func upper(e *evaluator, name string, args []string) (string, error) {
+
+	if err := checkArgs(name, args, 1, 1); err != nil {
+		return "", err
+	}
+
+	return strings.ToUpper(args[0]), nil
+}
+
+//lower - %%$LOWER(str) returns a string with all letters in lower case
+func lower(e *evaluator, name string, args []string) (string, error) {
+
+	if err := checkArgs(name, args, 1, 1); err != nil {
+		return "", err
+	}
+
+	return strings.ToLower(args[0]), nil
+}
@@ -21,13 +21,16 @@ type osConverter struct {
 }
 
 //ConvertToMsg - converts os specific data to proto message
-func (c *osConverter) ConvertToMsg(data json.RawMessage, variables types.EnvironmentVariableList) (*any.Any, error) {
+func (c *osConverter) ConvertToMsg(data json.RawMessage, variables types.EnvironmentVariableList, secrets converter.SecretReader) (*any.Any, error) {
 
 	result := &taskdef.OsTaskData{}
 	if err := json.Unmarshal(data, result); err != nil {
 		return nil, err
 	}
-	cmdLine := converter.ReplaceVariables(result.CommandLine, variables)
+	cmdLine, err := converter.ReplaceVariablesAndSecrets(result.CommandLine, variables, secrets)
+	if err != nil {
+		return nil, err
+	}
 
 	var taskType actions.OsTaskAction_OsType
 
@@ -0,0 +1,50 @@
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+//ErrUndefinedSecret - occurs when data refers to a secret that is not defined
+var ErrUndefinedSecret error = errors.New("undefined secrets")
+
+const secretPrefix = "%%SECRET("
+
+var secretToken = regexp.MustCompile(`^\%\%SECRET\([A-Za-z][\w\-\.]*\)`)
+
+//SecretReader - provides decrypted values of secrets
+type SecretReader interface {
+	Secret(name string) (string, bool, error)
+}
+
+//ReplaceSecrets - replaces references to secrets in the form of %%SECRET(name) in the value of a resolved variable with
+//their values read from a given reader. Escaped %% is replaced in the same pass, so escaped text is never taken for a reference.
+//Secrets are resolved only when a task is dispatched to a worker, so their values are never stored with a task.
+func ReplaceSecrets(in string, secrets SecretReader) (string, error) {
+
+	e := &evaluator{secret: readSecret(secrets)}
+
+	out, err := e.literal(in, false)
+	if err != nil {
+		return "", err
+	}
+
+	if len(e.secrets) != 0 {
+		return "", fmt.Errorf("%w:%s", ErrUndefinedSecret, strings.Join(unique(e.secrets), ","))
+	}
+
+	return out, nil
+}
+
+//readSecret - returns a function that reads secrets from a given reader, if there is no reader, all secrets are undefined
+func readSecret(secrets SecretReader) func(name string) (string, bool, error) {
+
+	return func(name string) (string, bool, error) {
+		if secrets == nil {
+			return "", false, nil
+		}
+		return secrets.Secret(name)
+	}
+}
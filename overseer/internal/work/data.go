@@ -3,6 +3,7 @@ package work
 import (
 	"github.com/przebro/overseer/common/types"
 	"github.com/przebro/overseer/overseer/internal/events"
+	"github.com/przebro/overseer/overseer/internal/taskdef"
 	"github.com/przebro/overseer/overseer/internal/unique"
 	"github.com/przebro/overseer/proto/wservices"
 )
@@ -32,6 +33,7 @@ type workerStatus struct {
 	cpu        int
 	memused    int
 	memtotal   int
+	diskfree   int64
 	tasks      int
 	tasksLimit int
 }
@@ -39,6 +41,42 @@ type workerStatus struct {
 type availableWorker struct {
 	name      string
 	freeTasks int
+	cpu       int
+	weight    int
+}
+
+//workerPlacement - describes where a worker belongs, tags contain tags of a worker and tags of its pool.
+//Capabilities are task types supported by a registered worker, a worker without capabilities accepts any task.
+type workerPlacement struct {
+	host         string
+	pool         string
+	tags         map[string]struct{}
+	capabilities []string
+	weight       int
+}
+
+//matches - checks if a worker meets restrictions of a task
+func (p workerPlacement) matches(name string, ttype types.TaskType, sel taskdef.WorkerSelector) bool {
+
+	if !p.supports(ttype) {
+		return false
+	}
+
+	if sel.Host != "" && sel.Host != name && sel.Host != p.host {
+		return false
+	}
+
+	if sel.Pool != "" && sel.Pool != p.pool {
+		return false
+	}
+
+	for _, t := range sel.Tags {
+		if _, ok := p.tags[t]; !ok {
+			return false
+		}
+	}
+
+	return true
 }
 
 var reverseStatusMap = map[wservices.TaskExecutionResponseMsg_TaskStatus]types.WorkerTaskStatus{
@@ -50,3 +88,19 @@ var reverseStatusMap = map[wservices.TaskExecutionResponseMsg_TaskStatus]types.W
 	wservices.TaskExecutionResponseMsg_IDLE:      types.WorkerTaskStatusIdle,
 	wservices.TaskExecutionResponseMsg_STARTING:  types.WorkerTaskStatusStarting,
 }
+
+//supports - checks if a worker can execute a task of a given type
+func (p workerPlacement) supports(ttype types.TaskType) bool {
+
+	if len(p.capabilities) == 0 {
+		return true
+	}
+
+	for _, c := range p.capabilities {
+		if types.TaskType(c) == ttype {
+			return true
+		}
+	}
+
+	return false
+}
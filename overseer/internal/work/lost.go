@@ -0,0 +1,143 @@
+package work
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/przebro/overseer/common/types"
+	"github.com/przebro/overseer/overseer/internal/events"
+	"github.com/przebro/overseer/overseer/internal/journal"
+	"github.com/przebro/overseer/overseer/internal/unique"
+
+	"go.uber.org/zap"
+)
+
+const (
+	//LostWorkerWait - tasks of a lost worker wait until the worker is available again
+	LostWorkerWait = "wait"
+	//LostWorkerFail - tasks of a lost worker fail
+	LostWorkerFail = "fail"
+	//LostWorkerRedispatch - idempotent tasks of a lost worker are sent to another worker, other tasks fail
+	LostWorkerRedispatch = "redispatch"
+)
+
+//reasonWorkerLost - a reason of a failure of a task whose worker was lost
+const reasonWorkerLost = "worker lost"
+
+//handleLostWorkers - applies the lost worker policy to executions of workers that are lost
+func (w *workerManager) handleLostWorkers() {
+
+	lost := map[string]bool{}
+
+	w.wlock.RLock()
+	for name, entry := range w.workers {
+		lost[name] = entry.lost
+	}
+	w.wlock.RUnlock()
+
+	defer w.lock.Unlock()
+	w.lock.Lock()
+
+	for execID, s := range w.status {
+
+		if s.Status == types.WorkerTaskStatusEnded || s.Status == types.WorkerTaskStatusFailed || s.WorkerName == "" {
+			continue
+		}
+
+		worker, handled := w.lostExecutions[execID]
+
+		if !lost[s.WorkerName] {
+			//the worker is back before the task was re-dispatched
+			if handled && worker == s.WorkerName {
+				delete(w.lostExecutions, execID)
+				w.journal(s.OrderID, execID, fmt.Sprintf(journal.TaskWorkerRecovered, s.WorkerName))
+			}
+			continue
+		}
+
+		if !handled {
+			w.lostExecutions[execID] = s.WorkerName
+			w.journal(s.OrderID, execID, fmt.Sprintf(journal.TaskWorkerLost, s.WorkerName))
+			w.log.Desugar().Warn("task worker lost", zap.String("orderID", string(s.OrderID)), zap.String("executionID", execID),
+				zap.String("worker", s.WorkerName), zap.String("policy", w.lostPolicy))
+		}
+
+		switch w.lostPolicy {
+		case LostWorkerWait:
+			continue
+		case LostWorkerRedispatch:
+			if msg, ok := w.launched[execID]; ok && msg.Idempotent {
+				w.redispatch(msg, s.WorkerName, !handled)
+				continue
+			}
+		}
+
+		w.failLost(s.OrderID, execID, s.WorkerName)
+	}
+}
+
+//failLost - fails an execution of a lost worker, the task ends the next time it asks for its status
+func (w *workerManager) failLost(orderID unique.TaskOrderID, execID, worker string) {
+
+	w.status[execID] = events.RouteWorkResponseMsg{
+		Status:      types.WorkerTaskStatusFailed,
+		OrderID:     orderID,
+		ExecutionID: execID,
+		WorkerName:  worker,
+		Reason:      reasonWorkerLost,
+	}
+
+	delete(w.lostExecutions, execID)
+	w.journal(orderID, execID, journal.TaskWorkerLostFailed)
+}
+
+//redispatch - sends an idempotent task to another worker, if there is no available worker, the task waits for the next attempt
+func (w *workerManager) redispatch(msg events.RouteTaskExecutionMsg, lost string, first bool) {
+
+	name, reason, err := w.getWorker(msg.Type, msg.Worker)
+	if err != nil {
+		if first {
+			if reason == "" {
+				reason = err.Error()
+			}
+			w.journal(msg.OrderID, msg.ExecutionID, fmt.Sprintf(journal.TaskWorkerLostWaiting, reason))
+		}
+		return
+	}
+
+	mediator, exists := w.mediator(name)
+	if !exists {
+		return
+	}
+
+	w.status[msg.ExecutionID] = events.RouteWorkResponseMsg{
+		Status:      types.WorkerTaskStatusStarting,
+		OrderID:     msg.OrderID,
+		ExecutionID: msg.ExecutionID,
+		WorkerName:  name,
+	}
+
+	delete(w.lostExecutions, msg.ExecutionID)
+	w.journal(msg.OrderID, msg.ExecutionID, fmt.Sprintf(journal.TaskWorkerLostRedispatch, name))
+	w.log.Desugar().Info("task re-dispatched", zap.String("orderID", string(msg.OrderID)), zap.String("executionID", msg.ExecutionID),
+		zap.String("lost", lost), zap.String("worker", name))
+
+	go func() { mediator.StartTask(msg) }()
+}
+
+//journal - writes a message to the journal of a task
+func (w *workerManager) journal(orderID unique.TaskOrderID, execID, msg string) {
+
+	if w.dispatcher == nil {
+		return
+	}
+
+	jmsg := events.RouteJournalMsg{
+		Time:        time.Now(),
+		OrderID:     orderID,
+		ExecutionID: execID,
+		Msg:         msg,
+	}
+
+	w.dispatcher.PushEvent(nil, events.RoutTaskJournal, events.NewMsg(jmsg))
+}
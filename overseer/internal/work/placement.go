@@ -0,0 +1,148 @@
+package work
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+const (
+	//PlacementSlots - selects a worker with the most free slots
+	PlacementSlots = "slots"
+	//PlacementCPU - selects a worker with the lowest cpu load
+	PlacementCPU = "cpu"
+	//PlacementRoundRobin - selects workers in turn
+	PlacementRoundRobin = "roundrobin"
+	//PlacementWeighted - selects workers in turn, proportionally to their weights
+	PlacementWeighted = "weighted"
+)
+
+//PlacementStrategy - selects a worker for a task. Candidates are connected workers that meet restrictions of a task
+//and have at least one free slot, they are sorted by a name and there is at least one candidate.
+type PlacementStrategy interface {
+	Name() string
+	Select(candidates []availableWorker) availableWorker
+}
+
+var (
+	strategyLock sync.Mutex
+	strategies   = map[string]func() PlacementStrategy{
+		PlacementSlots:      func() PlacementStrategy { return slotsStrategy{} },
+		PlacementCPU:        func() PlacementStrategy { return cpuStrategy{} },
+		PlacementRoundRobin: func() PlacementStrategy { return &roundRobinStrategy{} },
+		PlacementWeighted:   func() PlacementStrategy { return &weightedStrategy{current: map[string]int{}} },
+	}
+)
+
+//RegisterPlacementStrategy - registers a factory of a placement strategy under a given name
+func RegisterPlacementStrategy(name string, factory func() PlacementStrategy) {
+
+	strategyLock.Lock()
+	defer strategyLock.Unlock()
+	strategies[name] = factory
+}
+
+//newPlacementStrategy - creates a placement strategy, an empty name returns the default strategy
+func newPlacementStrategy(name string) (PlacementStrategy, error) {
+
+	if name == "" {
+		name = PlacementSlots
+	}
+
+	strategyLock.Lock()
+	defer strategyLock.Unlock()
+
+	factory, exists := strategies[name]
+	if !exists {
+		return nil, fmt.Errorf("unknown placement strategy: %s", name)
+	}
+
+	return factory(), nil
+}
+
+//slotsStrategy - selects a worker with the most free slots
+type slotsStrategy struct{}
+
+func (s slotsStrategy) Name() string { return PlacementSlots }
+
+func (s slotsStrategy) Select(candidates []availableWorker) availableWorker {
+
+	selected := candidates[0]
+	for _, c := range candidates[1:] {
+		if c.freeTasks > selected.freeTasks {
+			selected = c
+		}
+	}
+
+	return selected
+}
+
+//cpuStrategy - selects a worker with the lowest cpu load, if loads are equal then a worker with more free slots is selected
+type cpuStrategy struct{}
+
+func (s cpuStrategy) Name() string { return PlacementCPU }
+
+func (s cpuStrategy) Select(candidates []availableWorker) availableWorker {
+
+	selected := candidates[0]
+	for _, c := range candidates[1:] {
+		if c.cpu < selected.cpu || (c.cpu == selected.cpu && c.freeTasks > selected.freeTasks) {
+			selected = c
+		}
+	}
+
+	return selected
+}
+
+//roundRobinStrategy - selects a worker that follows the previously selected one
+type roundRobinStrategy struct {
+	lock sync.Mutex
+	last string
+}
+
+func (s *roundRobinStrategy) Name() string { return PlacementRoundRobin }
+
+func (s *roundRobinStrategy) Select(candidates []availableWorker) availableWorker {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	idx := sort.Search(len(candidates), func(i int) bool { return candidates[i].name > s.last })
+	if idx == len(candidates) {
+		idx = 0
+	}
+
+	s.last = candidates[idx].name
+
+	return candidates[idx]
+}
+
+//weightedStrategy - a smooth weighted round-robin, a worker with the weight 2 receives twice as many tasks
+//as a worker with the weight 1 and tasks are spread evenly among workers
+type weightedStrategy struct {
+	lock    sync.Mutex
+	current map[string]int
+}
+
+func (s *weightedStrategy) Name() string { return PlacementWeighted }
+
+func (s *weightedStrategy) Select(candidates []availableWorker) availableWorker {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	total := 0
+	selected := -1
+
+	for i, c := range candidates {
+		s.current[c.name] += c.weight
+		total += c.weight
+		if selected == -1 || s.current[c.name] > s.current[candidates[selected].name] {
+			selected = i
+		}
+	}
+
+	s.current[candidates[selected].name] -= total
+
+	return candidates[selected]
+}
@@ -0,0 +1,111 @@
+package work
+
+import (
+	"testing"
+
+	"github.com/przebro/overseer/common/types"
+	"github.com/przebro/overseer/overseer/config"
+	"github.com/przebro/overseer/overseer/internal/taskdef"
+)
+
+func selectMany(s PlacementStrategy, candidates []availableWorker, n int) []string {
+
+	result := []string{}
+	for i := 0; i < n; i++ {
+		result = append(result, s.Select(candidates).name)
+	}
+
+	return result
+}
+
+func TestNewPlacementStrategy(t *testing.T) {
+
+	for _, name := range []string{"", PlacementSlots, PlacementCPU, PlacementRoundRobin, PlacementWeighted} {
+		s, err := newPlacementStrategy(name)
+		if err != nil {
+			t.Error("unexpected error:", name, err)
+			continue
+		}
+		if name != "" && s.Name() != name {
+			t.Error("unexpected strategy:", s.Name(), "expected:", name)
+		}
+	}
+
+	if _, err := newPlacementStrategy("random"); err == nil {
+		t.Error("expected error for an unknown strategy")
+	}
+
+	RegisterPlacementStrategy("first", func() PlacementStrategy { return slotsStrategy{} })
+	if _, err := newPlacementStrategy("first"); err != nil {
+		t.Error("unexpected error:", err)
+	}
+}
+
+func TestPlacementStrategies(t *testing.T) {
+
+	candidates := []availableWorker{
+		{name: "worker_01", freeTasks: 2, cpu: 80, weight: 1},
+		{name: "worker_02", freeTasks: 4, cpu: 30, weight: 2},
+		{name: "worker_03", freeTasks: 4, cpu: 10, weight: 1},
+	}
+
+	if n := (slotsStrategy{}).Select(candidates).name; n != "worker_02" {
+		t.Error("slots strategy, unexpected worker:", n)
+	}
+
+	if n := (cpuStrategy{}).Select(candidates).name; n != "worker_03" {
+		t.Error("cpu strategy, unexpected worker:", n)
+	}
+
+	expected := []string{"worker_01", "worker_02", "worker_03", "worker_01"}
+	result := selectMany(&roundRobinStrategy{}, candidates, 4)
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Error("round robin strategy, unexpected order:", result, "expected:", expected)
+			break
+		}
+	}
+
+	expected = []string{"worker_02", "worker_01", "worker_03", "worker_02"}
+	result = selectMany(&weightedStrategy{current: map[string]int{}}, candidates, 4)
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Error("weighted strategy, unexpected order:", result, "expected:", expected)
+			break
+		}
+	}
+}
+
+func TestGetWorker_Strategy(t *testing.T) {
+
+	workers := []config.WorkerConfiguration{
+		{WorkerName: "worker_01", WorkerHost: "10.0.0.1", WorkerWeight: 3},
+		{WorkerName: "worker_02", WorkerHost: "10.0.0.2"},
+	}
+	status := map[string]workerStatus{
+		"worker_01": {connected: true, tasksLimit: 4, tasks: 0, cpu: 90},
+		"worker_02": {connected: true, tasksLimit: 4, tasks: 3, cpu: 20},
+	}
+
+	w := newTestManager(workers, nil, status)
+
+	if name, _, _ := w.getWorker(types.TypeDummy, taskdef.WorkerSelector{}); name != "worker_01" {
+		t.Error("slots strategy, unexpected worker:", name)
+	}
+
+	w.strategy = cpuStrategy{}
+	if name, _, _ := w.getWorker(types.TypeDummy, taskdef.WorkerSelector{}); name != "worker_02" {
+		t.Error("cpu strategy, unexpected worker:", name)
+	}
+
+	w.strategy = &weightedStrategy{current: map[string]int{}}
+	counts := map[string]int{}
+	for i := 0; i < 8; i++ {
+		name, _, _ := w.getWorker(types.TypeDummy, taskdef.WorkerSelector{})
+		counts[name]++
+	}
+
+	if counts["worker_01"] != 6 || counts["worker_02"] != 2 {
+		t.Error("weighted strategy, unexpected distribution:", counts)
+	}
+}
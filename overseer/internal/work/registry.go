@@ -0,0 +1,368 @@
+package work
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/przebro/overseer/common/validator"
+	"github.com/przebro/overseer/overseer/config"
+
+	"go.uber.org/zap"
+)
+
+//WorkerState - describes whether a worker receives new tasks
+type WorkerState string
+
+const (
+	//WorkerStateActive - a worker is connected and receives new tasks
+	WorkerStateActive WorkerState = "active"
+	//WorkerStateDraining - a worker does not receive new tasks but it still executes tasks
+	WorkerStateDraining WorkerState = "draining"
+	//WorkerStateDrained - a worker does not receive new tasks and it does not execute any task
+	WorkerStateDrained WorkerState = "drained"
+	//WorkerStateLost - a worker is not connected or it stopped sending heartbeats
+	WorkerStateLost WorkerState = "lost"
+)
+
+//defaultHeartbeat - interval in seconds between heartbeats of registered workers if it is not set in the configuration
+const defaultHeartbeat = 10
+
+//defaultRefreshInterval - interval between refreshes of workers if it is not set in the configuration
+const defaultRefreshInterval = 5 * time.Second
+
+//missedHeartbeats - a number of missed heartbeats after which a worker is lost if it is not set in the configuration
+const missedHeartbeats = 3
+
+var (
+	//ErrWorkerNotFound - a worker with a given name does not exist
+	ErrWorkerNotFound = errors.New("worker not found")
+	//ErrWorkerExists - a worker with the same name and a different address is defined in the configuration
+	ErrWorkerExists = errors.New("worker with the same name is defined in the configuration")
+	//ErrWorkerAddress - a worker with the same name is registered with a different address and the new worker did not prove its name
+	ErrWorkerAddress = errors.New("worker with the same name is registered with a different address")
+	//ErrWorkerHasTasks - a worker can't be removed because it executes tasks
+	ErrWorkerHasTasks = errors.New("worker has tasks in progress, drain it first")
+)
+
+//WorkerRegistration - information sent by a worker that registers itself
+type WorkerRegistration struct {
+	Name         string   `validate:"required,max=32"`
+	Host         string   `validate:"required,max=253"`
+	Port         int      `validate:"min=1024,max=65535"`
+	TasksLimit   int      `validate:"min=0,max=128"`
+	Capabilities []string `validate:"omitempty,dive,required,max=16"`
+	Pool         string   `validate:"omitempty,max=32"`
+	Tags         []string `validate:"omitempty,dive,required,max=32"`
+	Weight       int      `validate:"min=0,max=100"`
+	//Identified - a worker proved its name with a certificate, only such a worker can change the address of a registered worker
+	Identified bool
+}
+
+//WorkerInfo - describes a worker known to the worker manager
+type WorkerInfo struct {
+	Name         string
+	Address      string
+	State        WorkerState
+	Registered   bool
+	Capabilities []string
+	Tasks        int
+	TasksLimit   int
+	Executions   int
+	Pool         string
+	Tags         []string
+	Weight       int
+	CPU          int
+	MemUsed      int
+	MemTotal     int
+	DiskFree     int64
+	LastSeen     time.Time
+}
+
+//WorkerRegistry - manages workers that register themselves and workers from the configuration
+type WorkerRegistry interface {
+	Register(reg WorkerRegistration) error
+	Heartbeat(name string) bool
+	HeartbeatInterval() int
+	Workers(filter string) []WorkerInfo
+	Drain(name string) error
+	Undrain(name string) error
+	Remove(name string) error
+}
+
+//workerEntry - a worker known to the worker manager
+type workerEntry struct {
+	mediator   WorkerMediator
+	placement  workerPlacement
+	address    string
+	tasksLimit int
+	registered bool
+	drained    bool
+	lost       bool
+	lastSeen   time.Time
+}
+
+//state - returns a state of a worker, executions is a number of tasks sent to a worker that are not completed yet
+func (e *workerEntry) state(executions int) WorkerState {
+
+	if e.lost || !e.mediator.Active().connected {
+		return WorkerStateLost
+	}
+
+	if e.drained && executions > 0 {
+		return WorkerStateDraining
+	}
+
+	if e.drained {
+		return WorkerStateDrained
+	}
+
+	return WorkerStateActive
+}
+
+//Register - adds a worker that registered itself or updates it if it is already known.
+func (w *workerManager) Register(reg WorkerRegistration) error {
+
+	if err := validator.Valid.Validate(reg); err != nil {
+		return err
+	}
+
+	conf := config.WorkerConfiguration{
+		WorkerName:   reg.Name,
+		WorkerHost:   reg.Host,
+		WorkerPort:   reg.Port,
+		WorkerPool:   reg.Pool,
+		WorkerTags:   reg.Tags,
+		WorkerWeight: reg.Weight,
+	}
+	address := fmt.Sprintf("%s:%d", reg.Host, reg.Port)
+
+	w.wlock.RLock()
+	current, exists := w.workers[reg.Name]
+	w.wlock.RUnlock()
+
+	if exists && !current.registered && current.address != address {
+		return ErrWorkerExists
+	}
+
+	if exists && current.registered && current.address != address && !reg.Identified {
+		w.log.Desugar().Warn("worker registration rejected, address changed", zap.String("worker", reg.Name), zap.String("address", current.address), zap.String("new", address))
+		return ErrWorkerAddress
+	}
+
+	var mediator WorkerMediator
+	if exists && current.address == address {
+		mediator = current.mediator
+	} else {
+		//a connection is established outside of the lock, it may take a while
+		mediator = w.newMediator(conf)
+	}
+
+	entry := &workerEntry{
+		mediator:   mediator,
+		placement:  newWorkerPlacement(conf, w.pools, w.log),
+		address:    address,
+		tasksLimit: reg.TasksLimit,
+		registered: true,
+		lastSeen:   time.Now(),
+	}
+	entry.placement.capabilities = reg.Capabilities
+
+	w.wlock.Lock()
+	if current, exists = w.workers[reg.Name]; exists {
+		entry.drained = current.drained
+		if current.mediator != mediator {
+			current.mediator.Close()
+		}
+	}
+	w.workers[reg.Name] = entry
+	w.wlock.Unlock()
+
+	w.log.Desugar().Info("worker registered", zap.String("worker", reg.Name), zap.String("address", address), zap.Strings("capabilities", reg.Capabilities))
+	mediator.Available()
+
+	return nil
+}
+
+//Heartbeat - confirms that a worker is alive, returns false if a worker is not known and it has to register again.
+func (w *workerManager) Heartbeat(name string) bool {
+
+	w.wlock.Lock()
+	defer w.wlock.Unlock()
+
+	entry, exists := w.workers[name]
+	if !exists {
+		return false
+	}
+
+	if entry.lost {
+		w.log.Desugar().Info("worker is alive again", zap.String("worker", name))
+	}
+
+	entry.lastSeen = time.Now()
+	entry.lost = false
+
+	return true
+}
+
+//HeartbeatInterval - returns an interval in seconds between heartbeats
+func (w *workerManager) HeartbeatInterval() int {
+	return w.heartbeat
+}
+
+//Workers - returns workers whose names match a filter, the filter can contain wildcards
+func (w *workerManager) Workers(filter string) []WorkerInfo {
+
+	executions := w.executions()
+
+	w.wlock.RLock()
+	defer w.wlock.RUnlock()
+
+	result := []WorkerInfo{}
+
+	for name, entry := range w.workers {
+
+		if filter != "" {
+			if match, _ := path.Match(filter, name); !match {
+				continue
+			}
+		}
+
+		act := entry.mediator.Active()
+		info := WorkerInfo{
+			Name:         name,
+			Address:      entry.address,
+			State:        entry.state(executions[name]),
+			Registered:   entry.registered,
+			Capabilities: entry.placement.capabilities,
+			Tasks:        act.tasks,
+			TasksLimit:   act.tasksLimit,
+			Executions:   executions[name],
+			Pool:         entry.placement.pool,
+			Tags:         []string{},
+			Weight:       entry.placement.weight,
+			CPU:          act.cpu,
+			MemUsed:      act.memused,
+			MemTotal:     act.memtotal,
+			DiskFree:     act.diskfree,
+			LastSeen:     entry.lastSeen,
+		}
+
+		if !act.connected {
+			info.TasksLimit = entry.tasksLimit
+		}
+
+		for t := range entry.placement.tags {
+			info.Tags = append(info.Tags, t)
+		}
+		sort.Strings(info.Tags)
+
+		result = append(result, info)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	return result
+}
+
+//Drain - stops sending new tasks to a worker, tasks that are already executed by the worker are completed as usual
+func (w *workerManager) Drain(name string) error {
+	return w.setDrained(name, true)
+}
+
+//Undrain - resumes sending new tasks to a drained worker
+func (w *workerManager) Undrain(name string) error {
+	return w.setDrained(name, false)
+}
+
+func (w *workerManager) setDrained(name string, drained bool) error {
+
+	w.wlock.Lock()
+	defer w.wlock.Unlock()
+
+	entry, exists := w.workers[name]
+	if !exists {
+		return ErrWorkerNotFound
+	}
+
+	entry.drained = drained
+	w.log.Desugar().Info("worker drain changed", zap.String("worker", name), zap.Bool("drained", drained))
+
+	return nil
+}
+
+//executions - returns a number of executions in progress for each worker
+func (w *workerManager) executions() map[string]int {
+
+	defer w.lock.Unlock()
+	w.lock.Lock()
+
+	result := map[string]int{}
+	for _, s := range w.status {
+		if s.WorkerName != "" {
+			result[s.WorkerName]++
+		}
+	}
+
+	return result
+}
+
+//Remove - removes a worker that does not execute any task. A worker defined in the configuration returns after restart.
+func (w *workerManager) Remove(name string) error {
+
+	defer w.lock.Unlock()
+	w.lock.Lock()
+
+	for _, s := range w.status {
+		if s.WorkerName == name {
+			return ErrWorkerHasTasks
+		}
+	}
+
+	w.wlock.Lock()
+	defer w.wlock.Unlock()
+
+	entry, exists := w.workers[name]
+	if !exists {
+		return ErrWorkerNotFound
+	}
+
+	entry.mediator.Close()
+	delete(w.workers, name)
+	w.log.Desugar().Info("worker removed", zap.String("worker", name))
+
+	return nil
+}
+
+//checkHeartbeats - marks workers that stopped sending heartbeats as lost. A worker from the configuration does not send
+//heartbeats, it is seen as long as a connection with it is active.
+func (w *workerManager) checkHeartbeats(now time.Time) {
+
+	w.wlock.Lock()
+	defer w.wlock.Unlock()
+
+	missed := w.missed
+	if missed == 0 {
+		missed = missedHeartbeats
+	}
+
+	timeout := time.Duration(w.heartbeat*missed) * time.Second
+
+	for name, entry := range w.workers {
+
+		if !entry.registered && (entry.lastSeen.IsZero() || entry.mediator.Active().connected) {
+			if entry.lost {
+				w.log.Desugar().Info("worker is connected again", zap.String("worker", name))
+			}
+			entry.lastSeen = now
+			entry.lost = false
+		}
+
+		if !entry.lost && now.Sub(entry.lastSeen) > timeout {
+			entry.lost = true
+			w.log.Desugar().Warn("worker lost, heartbeat not received", zap.String("worker", name), zap.Time("lastSeen", entry.lastSeen))
+		}
+	}
+}
@@ -24,6 +24,7 @@ import (
 
 type workerMediator struct {
 	config     config.WorkerConfiguration
+	conn       *grpc.ClientConn
 	client     wservices.TaskExecutionServiceClient
 	log        logger.AppLogger
 	taskStatus chan events.RouteWorkResponseMsg
@@ -31,13 +32,20 @@ type workerMediator struct {
 	wdata      workerStatus
 	lock       sync.Mutex
 	security   config.ServerSecurityConfiguration
+	done       chan struct{}
+	closeOnce  sync.Once
+	secrets    converter.SecretReader
 }
 
+//watchRetry - interval between attempts to open a stream of statuses, meanwhile statuses are polled
+var watchRetry = 5 * time.Second
+
 //NewWorkerMediator - Creates a new WorkerMediator.
 func NewWorkerMediator(conf config.WorkerConfiguration,
 	security config.ServerSecurityConfiguration,
 	timeout int,
 	status chan events.RouteWorkResponseMsg,
+	secrets converter.SecretReader,
 	log logger.AppLogger) WorkerMediator {
 
 	worker := &workerMediator{
@@ -48,15 +56,19 @@ func NewWorkerMediator(conf config.WorkerConfiguration,
 		wdata:      workerStatus{},
 		lock:       sync.Mutex{},
 		security:   security,
+		done:       make(chan struct{}),
+		secrets:    secrets,
 	}
 
-	if client := worker.connect(conf, security.SecurityLevel, security.ClientCertPolicy, worker.timeout); client == nil {
+	if conn := worker.connect(conf, security.SecurityLevel, security.ClientCertPolicy, worker.timeout); conn == nil {
 		worker.wdata.connected = false
 	} else {
-		worker.client = client
+		worker.setConnection(conn)
 		worker.wdata.connected = true
 	}
 
+	go worker.watch()
+
 	return worker
 }
 
@@ -69,6 +81,7 @@ type WorkerMediator interface {
 	RequestTaskStatusFromWorker(taskID unique.TaskOrderID, executionID string)
 	TerminateTask(taskID unique.TaskOrderID, executionID string)
 	CompleteTask(taskID unique.TaskOrderID, executionID string)
+	Close()
 }
 
 //Name - Returns name of a worker
@@ -77,7 +90,7 @@ func (worker *workerMediator) Name() string {
 }
 
 func (worker *workerMediator) connect(conf config.WorkerConfiguration, level types.ConnectionSecurityLevel, policy types.CertPolicy,
-	timeout int) wservices.TaskExecutionServiceClient {
+	timeout int) *grpc.ClientConn {
 
 	opt := []grpc.DialOption{
 		grpc.WithBlock(),
@@ -112,7 +125,22 @@ func (worker *workerMediator) connect(conf config.WorkerConfiguration, level typ
 		return nil
 	}
 
-	return wservices.NewTaskExecutionServiceClient(conn)
+	return conn
+}
+
+//setConnection - sets a connection with a worker, the connection is closed if the mediator is already closed
+func (worker *workerMediator) setConnection(conn *grpc.ClientConn) {
+
+	worker.lock.Lock()
+	defer worker.lock.Unlock()
+
+	select {
+	case <-worker.done:
+		conn.Close()
+	default:
+		worker.conn = conn
+		worker.client = wservices.NewTaskExecutionServiceClient(conn)
+	}
 }
 
 //Available - Gets a status of a worker
@@ -126,12 +154,10 @@ func (worker *workerMediator) Available() {
 		w.lock.Unlock()
 
 		if client == nil {
-			if client := w.connect(w.config, w.security.SecurityLevel, w.security.ClientCertPolicy, w.timeout); client == nil {
+			if conn := w.connect(w.config, w.security.SecurityLevel, w.security.ClientCertPolicy, w.timeout); conn == nil {
 				worker.log.Desugar().Error("Available", zap.String("error", "connect with worker failed"))
 			} else {
-				w.lock.Lock()
-				worker.client = client
-				w.lock.Unlock()
+				w.setConnection(conn)
 			}
 
 		} else {
@@ -153,6 +179,7 @@ func (worker *workerMediator) Available() {
 					cpu:        int(result.Cpuload),
 					memused:    int(result.Memused),
 					memtotal:   int(result.Memtotal),
+					diskfree:   result.Diskfree,
 					tasks:      int(result.Tasks),
 					tasksLimit: int(result.TasksLimit),
 				}
@@ -185,13 +212,17 @@ func (worker *workerMediator) StartTask(msg events.RouteTaskExecutionMsg) {
 
 	smsg.Variables = map[string]string{}
 
+	var err error
 	for _, n := range msg.Variables {
-		smsg.Variables[n.Expand()] = n.Value
-
+		if smsg.Variables[n.Expand()], err = converter.ReplaceSecrets(n.Value, worker.secrets); err != nil {
+			worker.log.Desugar().Error("StartTask", zap.String("error", err.Error()))
+			status.Status = types.WorkerTaskStatusFailed
+			worker.taskStatus <- status
+			return
+		}
 	}
-	var err error
-	//TODO remove or convert
-	smsg.Command, err = converter.ConvertToMsg(msg.Type, msg.Command, types.EnvironmentVariableList{})
+
+	smsg.Command, err = converter.ConvertToMsg(msg.Type, msg.Command, msg.Variables, worker.secrets)
 	if err != nil {
 		worker.log.Desugar().Error("StartTask", zap.String("error", err.Error()))
 		status.Status = types.WorkerTaskStatusFailed
@@ -233,17 +264,37 @@ func (worker *workerMediator) RequestTaskStatusFromWorker(taskID unique.TaskOrde
 
 	result := events.RouteWorkResponseMsg{OrderID: taskID, ExecutionID: executionID}
 
-	resp, err := worker.client.TaskStatus(context.Background(), &wservices.TaskIdMsg{TaskID: string(taskID), ExecutionID: executionID})
+	worker.lock.Lock()
+	client := worker.client
+	worker.lock.Unlock()
+
+	if client == nil {
+		return
+	}
+
+	ctx, cancel := context.Background(), func() {}
+	if worker.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(worker.timeout)*time.Second)
+	}
+	defer cancel()
+
+	resp, err := client.TaskStatus(ctx, &wservices.TaskIdMsg{TaskID: string(taskID), ExecutionID: executionID})
 	if err != nil {
-		if s, ok := status.FromError(err); ok {
-			//something really bad happen with worker and task is lost
-			if s.Code() == codes.NotFound {
-				worker.log.Desugar().Error("RequestTaskStatusFromWorker", zap.String("error", err.Error()))
-				result.Status = types.WorkerTaskStatusFailed
-				result.WorkerName = worker.config.WorkerName
-				worker.taskStatus <- result
-			}
+		worker.log.Desugar().Error("RequestTaskStatusFromWorker", zap.String("worker", worker.config.WorkerName), zap.String("error", err.Error()))
+
+		//something really bad happen with worker and task is lost
+		if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
+			result.Status = types.WorkerTaskStatusFailed
+			result.WorkerName = worker.config.WorkerName
+			worker.taskStatus <- result
+			return
 		}
+
+		//a worker is unavailable, if it does not come back, its tasks are handled by the lost worker policy
+		worker.lock.Lock()
+		worker.wdata.connected = false
+		worker.lock.Unlock()
+
 	} else {
 
 		result.Status = reverseStatusMap[resp.Status]
@@ -268,6 +319,13 @@ func (worker *workerMediator) TerminateTask(taskID unique.TaskOrderID, execution
 //CompleteTask - sends information that the task is complete and all resources can be released
 func (worker *workerMediator) CompleteTask(taskID unique.TaskOrderID, executionID string) {
 
+	//a slot is freed at once, so a waiting task can start without waiting for a response of the worker
+	worker.lock.Lock()
+	if worker.wdata.tasks > 0 {
+		worker.wdata.tasks--
+	}
+	worker.lock.Unlock()
+
 	go func(w *workerMediator) {
 		resp, err := worker.client.CompleteTask(context.Background(), &wservices.TaskIdMsg{TaskID: string(taskID), ExecutionID: executionID})
 		if err != nil {
@@ -280,6 +338,86 @@ func (worker *workerMediator) CompleteTask(taskID unique.TaskOrderID, executionI
 	}(worker)
 }
 
+//Close - stops receiving statuses from a worker that is no longer used and closes the connection with the worker
+func (worker *workerMediator) Close() {
+	worker.closeOnce.Do(func() {
+		worker.lock.Lock()
+		defer worker.lock.Unlock()
+
+		close(worker.done)
+		if worker.conn != nil {
+			worker.conn.Close()
+		}
+	})
+}
+
+//watch - receives statuses pushed by a worker, so a task learns about a change without waiting for the next poll.
+//Polling remains a fallback if a stream is broken, and the only source of statuses if a worker does not support streams.
+func (worker *workerMediator) watch() {
+
+	for {
+		worker.lock.Lock()
+		client := worker.client
+		worker.lock.Unlock()
+
+		if client != nil {
+			err := worker.receive(client)
+			if status.Code(err) == codes.Unimplemented {
+				worker.log.Desugar().Warn("watch", zap.String("worker", worker.config.WorkerName), zap.String("error", "worker does not push statuses, statuses are polled"))
+				return
+			}
+			if err != nil {
+				worker.log.Desugar().Debug("watch", zap.String("worker", worker.config.WorkerName), zap.String("error", err.Error()))
+			}
+		}
+
+		select {
+		case <-worker.done:
+			return
+		case <-time.After(watchRetry):
+		}
+	}
+}
+
+//receive - forwards statuses from a stream until the stream is broken or the mediator is closed
+func (worker *workerMediator) receive(client wservices.TaskExecutionServiceClient) error {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-worker.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	stream, err := client.TaskStatusUpdates(ctx, &empty.Empty{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		worker.taskStatus <- events.RouteWorkResponseMsg{
+			OrderID:     unique.TaskOrderID(msg.TaskID.GetTaskID()),
+			ExecutionID: msg.TaskID.GetExecutionID(),
+			WorkerName:  worker.config.WorkerName,
+			Status:      reverseStatusMap[msg.Status.GetStatus()],
+			ReturnCode:  msg.Status.GetReturnCode(),
+			StatusCode:  msg.Status.GetStatusCode(),
+			Reason:      msg.Status.GetReason(),
+		}
+
+		worker.setTaskInfo(int(msg.Status.GetTasksLimit()), int(msg.Status.GetTasks()))
+	}
+}
+
 func (worker *workerMediator) setTaskInfo(limit, tasks int) {
 	defer worker.lock.Unlock()
 	worker.lock.Lock()
@@ -0,0 +1,117 @@
+package work
+
+import (
+	"context"
+	"testing"
+
+	"github.com/przebro/overseer/common/logger"
+	"github.com/przebro/overseer/common/types"
+	"github.com/przebro/overseer/overseer/internal/events"
+	converter "github.com/przebro/overseer/overseer/internal/work/converters"
+	"github.com/przebro/overseer/proto/actions"
+	"github.com/przebro/overseer/proto/wservices"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMediatorClose(t *testing.T) {
+
+	worker := &workerMediator{done: make(chan struct{})}
+
+	conn, err := grpc.Dial("127.0.0.1:7099", grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worker.setConnection(conn)
+	if worker.client == nil {
+		t.Fatal("unexpected result, client not set")
+	}
+
+	worker.Close()
+	worker.Close()
+
+	if state := conn.GetState(); state != connectivity.Shutdown {
+		t.Error("unexpected result, connection not closed:", state)
+	}
+
+	late, err := grpc.Dial("127.0.0.1:7099", grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worker.setConnection(late)
+	if state := late.GetState(); state != connectivity.Shutdown || worker.conn != conn {
+		t.Error("unexpected result, connection set after close:", state)
+	}
+}
+
+type recordingSecretReader struct {
+	requested []string
+}
+
+func (r *recordingSecretReader) Secret(name string) (string, bool, error) {
+	r.requested = append(r.requested, name)
+	return "P@ss", true, nil
+}
+
+type recordingExecutionClient struct {
+	wservices.TaskExecutionServiceClient
+	started chan *wservices.StartTaskMsg
+}
+
+func (c *recordingExecutionClient) StartTask(ctx context.Context, in *wservices.StartTaskMsg, opts ...grpc.CallOption) (*wservices.TaskExecutionResponseMsg, error) {
+	c.started <- in
+	return &wservices.TaskExecutionResponseMsg{}, nil
+}
+
+func TestStartTask_EscapedSecret(t *testing.T) {
+
+	reader := &recordingSecretReader{}
+	client := &recordingExecutionClient{started: make(chan *wservices.StartTaskMsg, 1)}
+	worker := &workerMediator{
+		client:     client,
+		log:        logger.NewTestLogger(),
+		taskStatus: make(chan events.RouteWorkResponseMsg, 2),
+		done:       make(chan struct{}),
+		secrets:    reader,
+	}
+
+	variables, err := converter.ResolveVariables(types.EnvironmentVariableList{
+		{Name: "%%PATTERN", Value: "%%%%SECRET(x)"},
+		{Name: "%%ORDERED", Value: "%%PATTERN"},
+	})
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	worker.StartTask(events.RouteTaskExecutionMsg{
+		OrderID:     "00001",
+		ExecutionID: "exec01",
+		Type:        types.TypeOs,
+		Command:     []byte(`{"type":"command","command":"grep %%%%SECRET(x) %%ORDERED"}`),
+		Variables:   variables,
+	})
+
+	msg := <-client.started
+
+	action := &actions.OsTaskAction{}
+	if err := proto.Unmarshal(msg.Command.Value, action); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if expected := "grep %%SECRET(x) %%SECRET(x)"; action.CommandLine != expected {
+		t.Error("unexpected result:", action.CommandLine, " expected:", expected)
+	}
+
+	for _, v := range variables {
+		if value := msg.Variables[v.Expand()]; value != "%%SECRET(x)" {
+			t.Error("unexpected result:", v.Name, value, " expected: %%SECRET(x)")
+		}
+	}
+
+	if len(reader.requested) != 0 {
+		t.Error("unexpected result, escaped text read as a secret:", reader.requested)
+	}
+}
@@ -3,6 +3,7 @@ package work
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -10,7 +11,11 @@ import (
 	"github.com/przebro/overseer/common/types"
 	"github.com/przebro/overseer/overseer/config"
 	"github.com/przebro/overseer/overseer/internal/events"
+	"github.com/przebro/overseer/overseer/internal/taskdef"
 	"github.com/przebro/overseer/overseer/internal/unique"
+	converter "github.com/przebro/overseer/overseer/internal/work/converters"
+
+	"go.uber.org/zap"
 )
 
 var errWorkerBusy = errors.New("no available workers")
@@ -22,15 +27,26 @@ type workerManager struct {
 	cleanChannel  chan taskCleanMsg
 	workStatus    chan struct{}
 
-	log     logger.AppLogger
-	workers map[string]WorkerMediator
-	status  map[string]events.RouteWorkResponseMsg
-	lock    sync.Mutex
+	log            logger.AppLogger
+	dispatcher     events.Dispatcher
+	workers        map[string]*workerEntry
+	pools          map[string][]string
+	status         map[string]events.RouteWorkResponseMsg
+	launched       map[string]events.RouteTaskExecutionMsg
+	lostExecutions map[string]string
+	lock           sync.Mutex
+	wlock          sync.RWMutex
+	heartbeat      int
+	missed         int
+	lostPolicy     string
+	strategy       PlacementStrategy
+	newMediator    func(conf config.WorkerConfiguration) WorkerMediator
 }
 
 //WorkerManager - Manages actions between
 type WorkerManager interface {
 	Run() error
+	WorkerRegistry
 }
 
 //NewWorkerManager - Creates a new WorkerManager
@@ -38,37 +54,69 @@ func NewWorkerManager(d events.Dispatcher,
 	conf config.WorkerManagerConfiguration,
 	log logger.AppLogger,
 	security config.ServerSecurityConfiguration,
-) WorkerManager {
+	secrets converter.SecretReader,
+) (WorkerManager, error) {
+
+	strategy, err := newPlacementStrategy(conf.Placement)
+	if err != nil {
+		return nil, err
+	}
 
-	w := &workerManager{}
+	w := &workerManager{strategy: strategy, dispatcher: d, missed: conf.LostWorker.MissedHeartbeats, lostPolicy: conf.LostWorker.Policy}
 	w.log = log
 	w.askChannel = make(chan taskGetStatusMsg)
 	w.resultChannel = make(chan events.RouteWorkResponseMsg)
 	w.launchChannel = make(chan taskExecuteMsg)
 	w.cleanChannel = make(chan taskCleanMsg)
-	w.workers = make(map[string]WorkerMediator)
+	w.workers = make(map[string]*workerEntry)
 	w.workStatus = make(chan struct{})
 	w.lock = sync.Mutex{}
 	w.status = map[string]events.RouteWorkResponseMsg{}
+	w.launched = map[string]events.RouteTaskExecutionMsg{}
+	w.lostExecutions = map[string]string{}
+	w.newMediator = func(n config.WorkerConfiguration) WorkerMediator {
+		return NewWorkerMediator(n, security, conf.Timeout, w.resultChannel, secrets, log)
+	}
+
+	if w.heartbeat = conf.Heartbeat; w.heartbeat == 0 {
+		w.heartbeat = defaultHeartbeat
+	}
+
+	if w.lostPolicy == "" {
+		w.lostPolicy = LostWorkerFail
+	}
 
 	if d != nil {
 		d.Subscribe(events.RouteWorkLaunch, w)
 		d.Subscribe(events.RouteWorkCheck, w)
-		d.Subscribe(events.RouteTimeOut, w)
 		d.Subscribe(events.RouteTaskClean, w)
 	}
 
+	w.pools = map[string][]string{}
+	for _, p := range conf.Pools {
+		w.pools[p.Name] = p.Tags
+	}
+
 	for _, n := range conf.Workers {
 		w.log.Info("Creating service worker:", n.WorkerName, ",", n.WorkerHost, ":", n.WorkerPort)
-		sworker := NewWorkerMediator(n, security, conf.Timeout, w.resultChannel, log)
-		w.workers[n.WorkerName] = sworker
+		w.workers[n.WorkerName] = &workerEntry{
+			mediator:  w.newMediator(n),
+			placement: newWorkerPlacement(n, w.pools, log),
+			address:   fmt.Sprintf("%s:%d", n.WorkerHost, n.WorkerPort),
+			lastSeen:  time.Now(),
+		}
+	}
+
+	interval := config.Interval(conf.RefreshInterval, conf.WorkerInterval)
+	if interval == 0 {
+		interval = defaultRefreshInterval
 	}
 
 	go func() {
-		w.updateWorkers(conf.WorkerInterval)
+		w.updateWorkers(interval)
 	}()
 
-	return w
+	return w, nil
 }
 func (w *workerManager) Run() error {
 
@@ -98,7 +146,7 @@ func (w *workerManager) Run() error {
 				}
 			case <-w.workStatus:
 				{
-					//time out,request workers for actual task statuses
+					//refresh of workers, request workers for actual task statuses
 					w.requestTaskStatus()
 				}
 			}
@@ -111,15 +159,16 @@ func (w *workerManager) Run() error {
 func (w *workerManager) startTask(msg events.RouteTaskExecutionMsg) events.RouteWorkResponseMsg {
 
 	var response events.RouteWorkResponseMsg
-	var wname string
+	var wname, reason string
 	var err error
 
-	if wname, err = w.getWorker(); err == errWorkerBusy {
+	if wname, reason, err = w.getWorker(msg.Type, msg.Worker); err == errWorkerBusy {
 		response = events.RouteWorkResponseMsg{
 			Status:      types.WorkerTaskStatusWorkerBusy,
 			OrderID:     msg.OrderID,
 			ExecutionID: msg.ExecutionID,
 			WorkerName:  "",
+			Reason:      reason,
 		}
 		return response
 	}
@@ -138,6 +187,17 @@ func (w *workerManager) startTask(msg events.RouteTaskExecutionMsg) events.Route
 	defer w.lock.Unlock()
 	w.lock.Lock()
 
+	//a worker could be removed in the meantime
+	mediator, exists := w.mediator(wname)
+	if !exists {
+		return events.RouteWorkResponseMsg{
+			Status:      types.WorkerTaskStatusWorkerBusy,
+			OrderID:     msg.OrderID,
+			ExecutionID: msg.ExecutionID,
+			Reason:      "waiting for a worker, selected worker was removed",
+		}
+	}
+
 	response = events.RouteWorkResponseMsg{
 		Status:      types.WorkerTaskStatusStarting,
 		OrderID:     msg.OrderID,
@@ -146,50 +206,98 @@ func (w *workerManager) startTask(msg events.RouteTaskExecutionMsg) events.Route
 	}
 
 	w.status[msg.ExecutionID] = response
+	w.launched[msg.ExecutionID] = msg
 
-	go func() { w.workers[wname].StartTask(msg) }()
+	go func() { mediator.StartTask(msg) }()
 
 	return response
 }
 
-func (w *workerManager) getWorker() (string, error) {
+//newWorkerPlacement - builds a placement of a worker, a worker inherits tags of its pool
+func newWorkerPlacement(conf config.WorkerConfiguration, pools map[string][]string, log logger.AppLogger) workerPlacement {
+
+	p := workerPlacement{host: conf.WorkerHost, pool: conf.WorkerPool, tags: map[string]struct{}{}, weight: conf.WorkerWeight}
+
+	if p.weight == 0 {
+		p.weight = 1
+	}
+
+	for _, t := range conf.WorkerTags {
+		p.tags[t] = struct{}{}
+	}
+
+	if conf.WorkerPool == "" {
+		return p
+	}
+
+	ptags, ok := pools[conf.WorkerPool]
+	if !ok {
+		log.Desugar().Warn("worker pool is not defined", zap.String("worker", conf.WorkerName), zap.String("pool", conf.WorkerPool))
+	}
+
+	for _, t := range ptags {
+		p.tags[t] = struct{}{}
+	}
+
+	return p
+}
+
+//getWorker - returns a name of a connected worker that meets restrictions of a task and has a free slot,
+//the worker is selected by a placement strategy. If there is no such worker, errWorkerBusy is returned along with a reason.
+func (w *workerManager) getWorker(ttype types.TaskType, sel taskdef.WorkerSelector) (string, string, error) {
 
 	limit := 1
-	cIdleWorkerTasks := 0
-	sWorkerName := ""
 	aworkers := []availableWorker{}
 	connected := false
+	matching := false
+
+	w.wlock.RLock()
+	defer w.wlock.RUnlock()
 
-	for name, wrkr := range w.workers {
-		act := wrkr.Active()
-		if act.connected {
+	for name, entry := range w.workers {
+		act := entry.mediator.Active()
+		if act.connected && !entry.lost {
 			connected = true
+			if entry.drained || !entry.placement.matches(name, ttype, sel) {
+				continue
+			}
+			matching = true
 			ftasks := act.tasksLimit - act.tasks
 			if ftasks >= limit {
-				aworkers = append(aworkers, availableWorker{name: name, freeTasks: ftasks})
+				aworkers = append(aworkers, availableWorker{name: name, freeTasks: ftasks, cpu: act.cpu, weight: entry.placement.weight})
 			}
 		}
 	}
 
 	if !connected {
-		return "", fmt.Errorf("no connected workers")
+		return "", "", fmt.Errorf("no connected workers")
 	}
 
-	if len(aworkers) == 0 {
-		return "", errWorkerBusy
+	if !matching {
+		return "", fmt.Sprintf("waiting for a worker, no active worker supports %s task that matches %s", ttype, sel), errWorkerBusy
 	}
 
-	cIdleWorkerTasks = aworkers[0].freeTasks
-	sWorkerName = aworkers[0].name
-
-	for _, w := range aworkers {
-		if w.freeTasks > cIdleWorkerTasks {
-			sWorkerName = w.name
-			cIdleWorkerTasks = w.freeTasks
+	if len(aworkers) == 0 {
+		if sel.IsEmpty() {
+			return "", "waiting for a worker, all workers are busy", errWorkerBusy
 		}
+		return "", fmt.Sprintf("waiting for a worker, all workers that match %s are busy", sel), errWorkerBusy
 	}
 
-	return sWorkerName, nil
+	sort.Slice(aworkers, func(i, j int) bool { return aworkers[i].name < aworkers[j].name })
+	selected := w.strategy.Select(aworkers)
+
+	w.log.Desugar().Info("worker selected",
+		zap.String("strategy", w.strategy.Name()),
+		zap.String("type", string(ttype)),
+		zap.String("worker", selected.name),
+		zap.Int("freeTasks", selected.freeTasks),
+		zap.Int("cpu", selected.cpu),
+		zap.Int("weight", selected.weight),
+		zap.Int("candidates", len(aworkers)),
+	)
+
+	return selected.name, "", nil
 }
 
 func (w *workerManager) updateTaskStatus(msg events.RouteWorkResponseMsg) {
@@ -197,7 +305,47 @@ func (w *workerManager) updateTaskStatus(msg events.RouteWorkResponseMsg) {
 	defer w.lock.Unlock()
 	w.lock.Lock()
 
+	current, exists := w.status[msg.ExecutionID]
+
+	//a task could be re-dispatched in the meantime, a status from a previous worker is out of date
+	if exists && msg.WorkerName != "" && current.WorkerName != "" && current.WorkerName != msg.WorkerName {
+		w.log.Desugar().Info("status from a previous worker ignored", zap.String("executionID", msg.ExecutionID),
+			zap.String("worker", msg.WorkerName), zap.String("current", current.WorkerName))
+		return
+	}
+
+	//a task already failed because its worker was lost
+	if exists && current.Status == types.WorkerTaskStatusFailed && current.Reason == reasonWorkerLost {
+		return
+	}
+
+	//statuses are pushed and polled, so a pushed result can be followed by an older, polled status
+	if exists && isFinal(current.Status) && !isFinal(msg.Status) {
+		return
+	}
+
 	w.status[msg.ExecutionID] = msg
+
+	if isFinal(msg.Status) && (!exists || !isFinal(current.Status)) {
+		w.notify(msg)
+	}
+}
+
+func isFinal(s types.WorkerTaskStatus) bool {
+	return s == types.WorkerTaskStatusEnded || s == types.WorkerTaskStatusFailed
+}
+
+//notify - informs a task that its execution ended, so the task does not wait for the next cycle of the pool
+func (w *workerManager) notify(msg events.RouteWorkResponseMsg) {
+
+	if w.dispatcher == nil {
+		return
+	}
+
+	data := events.RouteWorkNotifyMsg{OrderID: msg.OrderID, ExecutionID: msg.ExecutionID}
+
+	//the dispatcher delivers a message asynchronously, so it can be pushed under the lock although the task asks the manager for its status
+	w.dispatcher.PushEvent(nil, events.RouteWorkNotify, events.NewMsg(data))
 }
 
 func (w *workerManager) getTaskStatus(workername string, ExecutionID string, orderID unique.TaskOrderID) events.RouteWorkResponseMsg {
@@ -213,9 +361,13 @@ func (w *workerManager) requestTaskStatus() {
 	w.lock.Lock()
 
 	for execid, msg := range w.status {
-		go func(orderID unique.TaskOrderID, executionID string, workername string) {
-			w.workers[workername].RequestTaskStatusFromWorker(orderID, executionID)
-		}(msg.OrderID, execid, msg.WorkerName)
+		mediator, exists := w.mediator(msg.WorkerName)
+		if !exists {
+			continue
+		}
+		go func(orderID unique.TaskOrderID, executionID string, mediator WorkerMediator) {
+			mediator.RequestTaskStatusFromWorker(orderID, executionID)
+		}(msg.OrderID, execid, mediator)
 
 	}
 }
@@ -227,25 +379,61 @@ func (w *workerManager) cleanupTask(worker string, orderID unique.TaskOrderID, e
 	w.log.Debug("WORKER CLEAN:", orderID, executionID, worker)
 
 	delete(w.status, executionID)
+	delete(w.launched, executionID)
+	delete(w.lostExecutions, executionID)
 
-	if worker == "" {
+	mediator, exists := w.mediator(worker)
+	if !exists {
 		return
 	}
 
 	if terminate {
-		w.workers[worker].TerminateTask(orderID, executionID)
+		mediator.TerminateTask(orderID, executionID)
 	} else {
-		w.workers[worker].CompleteTask(orderID, executionID)
+		mediator.CompleteTask(orderID, executionID)
 	}
 }
 
-func (w *workerManager) updateWorkers(interval int) {
-	for {
-		time.Sleep(time.Duration(interval) * time.Second)
-		for _, worker := range w.workers {
+//mediator - returns a mediator of a worker with a given name
+func (w *workerManager) mediator(name string) (WorkerMediator, bool) {
+
+	w.wlock.RLock()
+	defer w.wlock.RUnlock()
+
+	entry, exists := w.workers[name]
+	if !exists {
+		return nil, false
+	}
+
+	return entry.mediator, true
+}
+
+//updateWorkers - periodically checks workers and asks them for statuses of tasks
+func (w *workerManager) updateWorkers(interval time.Duration) {
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for now := range t.C {
+		w.checkHeartbeats(now)
+		w.handleLostWorkers()
+
+		w.wlock.RLock()
+		mediators := make([]WorkerMediator, 0, len(w.workers))
+		for _, entry := range w.workers {
+			mediators = append(mediators, entry.mediator)
+		}
+		w.wlock.RUnlock()
+
+		for _, worker := range mediators {
 			worker.Available()
 		}
 
+		//a refresh is skipped if the previous one is still pending
+		select {
+		case w.workStatus <- struct{}{}:
+		default:
+		}
 	}
 }
 
@@ -281,10 +469,6 @@ func (w *workerManager) Process(receiver events.EventReceiver, routename events.
 
 			w.cleanChannel <- taskCleanMsg{terminate: data.Terminate, orderID: data.OrderID, executionID: data.ExecutionID, workername: data.WorkerName}
 		}
-	case events.RouteTimeOut:
-		{
-			w.workStatus <- struct{}{}
-		}
 	default:
 		{
 			events.ResponseToReceiver(receiver, "")
@@ -0,0 +1,443 @@
+package work
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/przebro/overseer/common/logger"
+	"github.com/przebro/overseer/common/types"
+	"github.com/przebro/overseer/overseer/config"
+	"github.com/przebro/overseer/overseer/internal/events"
+	"github.com/przebro/overseer/overseer/internal/taskdef"
+	"github.com/przebro/overseer/overseer/internal/unique"
+)
+
+type mockMediator struct {
+	name   string
+	status workerStatus
+	lock   sync.Mutex
+	calls  []string
+}
+
+func (m *mockMediator) record(call string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.calls = append(m.calls, call)
+}
+
+func (m *mockMediator) recorded() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return append([]string{}, m.calls...)
+}
+
+func (m *mockMediator) Available()           {}
+func (m *mockMediator) Active() workerStatus { return m.status }
+func (m *mockMediator) Name() string         { return m.name }
+func (m *mockMediator) StartTask(msg events.RouteTaskExecutionMsg) {
+}
+func (m *mockMediator) RequestTaskStatusFromWorker(taskID unique.TaskOrderID, executionID string) {
+	m.record("status:" + executionID)
+}
+func (m *mockMediator) TerminateTask(taskID unique.TaskOrderID, executionID string) {}
+func (m *mockMediator) Close()                                                      { m.record("close") }
+func (m *mockMediator) CompleteTask(taskID unique.TaskOrderID, executionID string) {
+	m.record("complete:" + executionID)
+}
+
+func newTestManager(workers []config.WorkerConfiguration, pools []config.WorkerPoolConfiguration, status map[string]workerStatus) *workerManager {
+
+	w := &workerManager{
+		log:       logger.NewTestLogger(),
+		workers:   map[string]*workerEntry{},
+		pools:     map[string][]string{},
+		status:    map[string]events.RouteWorkResponseMsg{},
+		heartbeat: defaultHeartbeat,
+		strategy:  slotsStrategy{},
+
+		launched:       map[string]events.RouteTaskExecutionMsg{},
+		lostExecutions: map[string]string{},
+		lostPolicy:     LostWorkerFail,
+	}
+
+	w.newMediator = func(n config.WorkerConfiguration) WorkerMediator {
+		return &mockMediator{name: n.WorkerName, status: workerStatus{connected: true, tasksLimit: 2}}
+	}
+
+	for _, p := range pools {
+		w.pools[p.Name] = p.Tags
+	}
+
+	for _, n := range workers {
+		w.workers[n.WorkerName] = &workerEntry{
+			mediator:  &mockMediator{name: n.WorkerName, status: status[n.WorkerName]},
+			placement: newWorkerPlacement(n, w.pools, w.log),
+			address:   fmt.Sprintf("%s:%d", n.WorkerHost, n.WorkerPort),
+		}
+	}
+
+	return w
+}
+
+func TestGetWorker_Selector(t *testing.T) {
+
+	workers := []config.WorkerConfiguration{
+		{WorkerName: "worker_01", WorkerHost: "10.0.0.1", WorkerPool: "aws"},
+		{WorkerName: "worker_02", WorkerHost: "10.0.0.2", WorkerPool: "aws", WorkerTags: []string{"large"}},
+		{WorkerName: "worker_03", WorkerHost: "10.0.0.3", WorkerTags: []string{"linux"}},
+	}
+	pools := []config.WorkerPoolConfiguration{{Name: "aws", Tags: []string{"credentials"}}}
+	status := map[string]workerStatus{
+		"worker_01": {connected: true, tasksLimit: 4, tasks: 0},
+		"worker_02": {connected: true, tasksLimit: 4, tasks: 3},
+		"worker_03": {connected: true, tasksLimit: 4, tasks: 2},
+	}
+
+	w := newTestManager(workers, pools, status)
+
+	tests := []struct {
+		sel      taskdef.WorkerSelector
+		expected string
+	}{
+		{taskdef.WorkerSelector{}, "worker_01"},
+		{taskdef.WorkerSelector{Pool: "aws", Tags: []string{"large"}}, "worker_02"},
+		{taskdef.WorkerSelector{Tags: []string{"credentials"}}, "worker_01"},
+		{taskdef.WorkerSelector{Host: "10.0.0.3"}, "worker_03"},
+		{taskdef.WorkerSelector{Host: "worker_02"}, "worker_02"},
+		{taskdef.WorkerSelector{Tags: []string{"linux"}}, "worker_03"},
+	}
+
+	for _, tc := range tests {
+		name, reason, err := w.getWorker(types.TypeDummy, tc.sel)
+		if err != nil || name != tc.expected || reason != "" {
+			t.Error("selector:", tc.sel, "unexpected result:", name, reason, err, "expected:", tc.expected)
+		}
+	}
+}
+
+func TestGetWorker_Busy(t *testing.T) {
+
+	workers := []config.WorkerConfiguration{
+		{WorkerName: "worker_01", WorkerHost: "10.0.0.1", WorkerPool: "aws"},
+		{WorkerName: "worker_02", WorkerHost: "10.0.0.2"},
+	}
+	status := map[string]workerStatus{
+		"worker_01": {connected: true, tasksLimit: 2, tasks: 2},
+		"worker_02": {connected: true, tasksLimit: 2, tasks: 0},
+	}
+
+	w := newTestManager(workers, nil, status)
+
+	_, reason, err := w.getWorker(types.TypeDummy, taskdef.WorkerSelector{Pool: "aws"})
+	if err != errWorkerBusy || reason == "" {
+		t.Error("unexpected result:", reason, err)
+	}
+
+	_, reason, err = w.getWorker(types.TypeDummy, taskdef.WorkerSelector{Pool: "gcp"})
+	if err != errWorkerBusy || reason == "" {
+		t.Error("unexpected result:", reason, err)
+	}
+
+	result := w.startTask(events.RouteTaskExecutionMsg{OrderID: "12345", ExecutionID: "ex1", Worker: taskdef.WorkerSelector{Pool: "aws"}})
+	if result.Status != types.WorkerTaskStatusWorkerBusy || result.Reason == "" {
+		t.Error("unexpected result:", result)
+	}
+
+	w = newTestManager(workers, nil, map[string]workerStatus{})
+	if _, _, err = w.getWorker(types.TypeDummy, taskdef.WorkerSelector{}); err == nil || err == errWorkerBusy {
+		t.Error("unexpected result:", err)
+	}
+}
+
+func TestRegister(t *testing.T) {
+
+	workers := []config.WorkerConfiguration{
+		{WorkerName: "worker_01", WorkerHost: "10.0.0.1", WorkerPort: 7055},
+	}
+	status := map[string]workerStatus{
+		"worker_01": {connected: true, tasksLimit: 2, tasks: 2},
+	}
+
+	w := newTestManager(workers, nil, status)
+
+	if err := w.Register(WorkerRegistration{Name: "worker_01", Host: "10.0.0.9", Port: 7055}); err != ErrWorkerExists {
+		t.Error("unexpected result:", err)
+	}
+
+	if err := w.Register(WorkerRegistration{Name: "", Host: "10.0.0.9", Port: 7055}); err == nil {
+		t.Error("expected validation error")
+	}
+
+	if err := w.Register(WorkerRegistration{Name: "worker_02", Host: "10.0.0.2", Port: 7055, TasksLimit: 2, Capabilities: []string{"os"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := w.getWorker(types.TypeDummy, taskdef.WorkerSelector{}); err != errWorkerBusy {
+		t.Error("unexpected result, worker does not support dummy tasks:", err)
+	}
+
+	if name, _, err := w.getWorker(types.TypeOs, taskdef.WorkerSelector{}); err != nil || name != "worker_02" {
+		t.Error("unexpected result:", name, err)
+	}
+
+	result := w.Workers("worker_0*")
+	if len(result) != 2 || result[1].Name != "worker_02" || !result[1].Registered || result[1].State != WorkerStateActive || result[1].Address != "10.0.0.2:7055" {
+		t.Error("unexpected result:", result)
+	}
+
+	if !w.Heartbeat("worker_02") || w.Heartbeat("worker_03") {
+		t.Error("unexpected heartbeat result")
+	}
+
+	w.checkHeartbeats(time.Now().Add(time.Duration(defaultHeartbeat*missedHeartbeats+1) * time.Second))
+
+	if result = w.Workers("worker_02"); len(result) != 1 || result[0].State != WorkerStateLost {
+		t.Error("unexpected result:", result)
+	}
+
+	if _, _, err := w.getWorker(types.TypeOs, taskdef.WorkerSelector{}); err != errWorkerBusy {
+		t.Error("unexpected result, worker is lost:", err)
+	}
+
+	w.Heartbeat("worker_02")
+	if result = w.Workers("worker_02"); len(result) != 1 || result[0].State != WorkerStateActive {
+		t.Error("unexpected result:", result)
+	}
+
+	if err := w.Register(WorkerRegistration{Name: "worker_02", Host: "10.0.0.3", Port: 7055, TasksLimit: 2, Capabilities: []string{"os"}}); err != ErrWorkerAddress {
+		t.Error("unexpected result:", err)
+	}
+
+	if result = w.Workers("worker_02"); len(result) != 1 || result[0].Address != "10.0.0.2:7055" {
+		t.Error("unexpected result, address changed by an unidentified worker:", result)
+	}
+
+	if err := w.Register(WorkerRegistration{Name: "worker_02", Host: "10.0.0.3", Port: 7055, TasksLimit: 2, Capabilities: []string{"os"}, Identified: true}); err != nil {
+		t.Error(err)
+	}
+
+	if result = w.Workers("worker_02"); len(result) != 1 || result[0].Address != "10.0.0.3:7055" {
+		t.Error("unexpected result:", result)
+	}
+}
+
+func TestDrainRemove(t *testing.T) {
+
+	workers := []config.WorkerConfiguration{
+		{WorkerName: "worker_01", WorkerHost: "10.0.0.1", WorkerPort: 7055},
+		{WorkerName: "worker_02", WorkerHost: "10.0.0.2", WorkerPort: 7055},
+	}
+	status := map[string]workerStatus{
+		"worker_01": {connected: true, tasksLimit: 4, tasks: 0},
+		"worker_02": {connected: true, tasksLimit: 4, tasks: 3},
+	}
+
+	w := newTestManager(workers, nil, status)
+
+	if err := w.Drain("worker_03"); err != ErrWorkerNotFound {
+		t.Error("unexpected result:", err)
+	}
+
+	if err := w.Drain("worker_01"); err != nil {
+		t.Error(err)
+	}
+
+	if name, _, err := w.getWorker(types.TypeDummy, taskdef.WorkerSelector{}); err != nil || name != "worker_02" {
+		t.Error("unexpected result:", name, err)
+	}
+
+	result := w.startTask(events.RouteTaskExecutionMsg{OrderID: "12345", ExecutionID: "ex1"})
+	if result.WorkerName != "worker_02" {
+		t.Fatal("unexpected result:", result)
+	}
+
+	if err := w.Remove("worker_02"); err != ErrWorkerHasTasks {
+		t.Error("unexpected result:", err)
+	}
+
+	if err := w.Remove("worker_01"); err != nil {
+		t.Error(err)
+	}
+
+	if err := w.Remove("worker_01"); err != ErrWorkerNotFound {
+		t.Error("unexpected result:", err)
+	}
+}
+
+func TestDrainUndrain(t *testing.T) {
+
+	workers := []config.WorkerConfiguration{
+		{WorkerName: "worker_01", WorkerHost: "10.0.0.1", WorkerPort: 7055},
+		{WorkerName: "worker_02", WorkerHost: "10.0.0.2", WorkerPort: 7055},
+	}
+	status := map[string]workerStatus{
+		"worker_01": {connected: true, tasksLimit: 4, tasks: 0},
+		"worker_02": {connected: true, tasksLimit: 4, tasks: 3},
+	}
+
+	w := newTestManager(workers, nil, status)
+
+	result := w.startTask(events.RouteTaskExecutionMsg{OrderID: "12345", ExecutionID: "ex1"})
+	if result.WorkerName != "worker_01" {
+		t.Fatal("unexpected result:", result)
+	}
+
+	if err := w.Drain("worker_01"); err != nil {
+		t.Fatal(err)
+	}
+
+	info := w.Workers("worker_01")
+	if len(info) != 1 || info[0].State != WorkerStateDraining || info[0].Executions != 1 {
+		t.Error("unexpected result:", info)
+	}
+
+	if result = w.startTask(events.RouteTaskExecutionMsg{OrderID: "12346", ExecutionID: "ex2"}); result.WorkerName != "worker_02" {
+		t.Error("unexpected result, worker is drained:", result)
+	}
+
+	//a draining worker is still asked for a status of its tasks and it completes them
+	w.requestTaskStatus()
+	w.cleanupTask("worker_01", "12345", "ex1", false)
+
+	mediator := w.workers["worker_01"].mediator.(*mockMediator)
+	for i := 0; i < 10 && len(mediator.recorded()) < 2; i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	calls := mediator.recorded()
+	sort.Strings(calls)
+	if len(calls) != 2 || calls[0] != "complete:ex1" || calls[1] != "status:ex1" {
+		t.Error("unexpected calls:", calls)
+	}
+
+	if info = w.Workers("worker_01"); info[0].State != WorkerStateDrained || info[0].Executions != 0 {
+		t.Error("unexpected result:", info)
+	}
+
+	if err := w.Undrain("worker_01"); err != nil {
+		t.Fatal(err)
+	}
+
+	if info = w.Workers("worker_01"); info[0].State != WorkerStateActive {
+		t.Error("unexpected result:", info)
+	}
+
+	if err := w.Undrain("worker_03"); err != ErrWorkerNotFound {
+		t.Error("unexpected result:", err)
+	}
+}
+
+func newLostTestManager(t *testing.T, policy string) *workerManager {
+
+	w := newTestManager(nil, nil, nil)
+	w.lostPolicy = policy
+
+	for _, name := range []string{"worker_01", "worker_02"} {
+		if err := w.Register(WorkerRegistration{Name: name, Host: "10.0.0.1", Port: 7055, TasksLimit: 2, Capabilities: []string{"dummy"}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return w
+}
+
+func loseWorker(w *workerManager, name string) {
+
+	w.workers[name].lastSeen = time.Now().Add(-time.Duration(defaultHeartbeat*missedHeartbeats+1) * time.Second)
+	w.checkHeartbeats(time.Now())
+	w.handleLostWorkers()
+}
+
+func TestLostWorker_Fail(t *testing.T) {
+
+	w := newLostTestManager(t, LostWorkerFail)
+
+	result := w.startTask(events.RouteTaskExecutionMsg{OrderID: "12345", ExecutionID: "ex1", Type: types.TypeDummy, Idempotent: true})
+	if result.WorkerName != "worker_01" {
+		t.Fatal("unexpected result:", result)
+	}
+
+	w.updateTaskStatus(events.RouteWorkResponseMsg{OrderID: "12345", ExecutionID: "ex1", WorkerName: "worker_01", Status: types.WorkerTaskStatusExecuting})
+	loseWorker(w, "worker_01")
+
+	status := w.getTaskStatus("worker_01", "ex1", "12345")
+	if status.Status != types.WorkerTaskStatusFailed || status.Reason != reasonWorkerLost || status.WorkerName != "worker_01" {
+		t.Error("unexpected result:", status)
+	}
+
+	//a late status from the lost worker does not change the result
+	w.updateTaskStatus(events.RouteWorkResponseMsg{OrderID: "12345", ExecutionID: "ex1", WorkerName: "worker_01", Status: types.WorkerTaskStatusExecuting})
+	if status = w.getTaskStatus("worker_01", "ex1", "12345"); status.Status != types.WorkerTaskStatusFailed {
+		t.Error("unexpected result:", status)
+	}
+}
+
+func TestLostWorker_Redispatch(t *testing.T) {
+
+	w := newLostTestManager(t, LostWorkerRedispatch)
+
+	w.startTask(events.RouteTaskExecutionMsg{OrderID: "12345", ExecutionID: "ex1", Type: types.TypeDummy, Idempotent: true})
+	w.startTask(events.RouteTaskExecutionMsg{OrderID: "12346", ExecutionID: "ex2", Type: types.TypeDummy})
+
+	loseWorker(w, "worker_01")
+
+	if status := w.getTaskStatus("", "ex1", "12345"); status.Status != types.WorkerTaskStatusStarting || status.WorkerName != "worker_02" {
+		t.Error("unexpected result, idempotent task should be re-dispatched:", status)
+	}
+
+	if status := w.getTaskStatus("", "ex2", "12346"); status.Status != types.WorkerTaskStatusFailed || status.WorkerName != "worker_01" {
+		t.Error("unexpected result, task is not idempotent:", status)
+	}
+
+	//a status from the previous worker is ignored
+	w.updateTaskStatus(events.RouteWorkResponseMsg{OrderID: "12345", ExecutionID: "ex1", WorkerName: "worker_01", Status: types.WorkerTaskStatusEnded})
+	if status := w.getTaskStatus("", "ex1", "12345"); status.Status != types.WorkerTaskStatusStarting {
+		t.Error("unexpected result:", status)
+	}
+
+	w.updateTaskStatus(events.RouteWorkResponseMsg{OrderID: "12345", ExecutionID: "ex1", WorkerName: "worker_02", Status: types.WorkerTaskStatusEnded})
+	if status := w.getTaskStatus("", "ex1", "12345"); status.Status != types.WorkerTaskStatusEnded {
+		t.Error("unexpected result:", status)
+	}
+}
+
+func TestLostWorker_Wait(t *testing.T) {
+
+	w := newLostTestManager(t, LostWorkerWait)
+
+	w.startTask(events.RouteTaskExecutionMsg{OrderID: "12345", ExecutionID: "ex1", Type: types.TypeDummy, Idempotent: true})
+	loseWorker(w, "worker_01")
+
+	if status := w.getTaskStatus("", "ex1", "12345"); status.Status != types.WorkerTaskStatusStarting || status.WorkerName != "worker_01" {
+		t.Error("unexpected result:", status)
+	}
+
+	if w.lostExecutions["ex1"] != "worker_01" {
+		t.Error("unexpected result, execution should be marked as lost:", w.lostExecutions)
+	}
+
+	w.Heartbeat("worker_01")
+	w.handleLostWorkers()
+
+	if len(w.lostExecutions) != 0 {
+		t.Error("unexpected result, worker is available again:", w.lostExecutions)
+	}
+}
+
+func TestUpdateTaskStatus_PushedResult(t *testing.T) {
+
+	w := newTestManager(nil, nil, nil)
+
+	w.updateTaskStatus(events.RouteWorkResponseMsg{OrderID: "12345", ExecutionID: "ex1", WorkerName: "worker_01", Status: types.WorkerTaskStatusStarting})
+	w.updateTaskStatus(events.RouteWorkResponseMsg{OrderID: "12345", ExecutionID: "ex1", WorkerName: "worker_01", Status: types.WorkerTaskStatusEnded, ReturnCode: 4})
+
+	//a response to a start request can arrive after a pushed result
+	w.updateTaskStatus(events.RouteWorkResponseMsg{OrderID: "12345", ExecutionID: "ex1", WorkerName: "worker_01", Status: types.WorkerTaskStatusRecieved})
+
+	if status := w.getTaskStatus("worker_01", "ex1", "12345"); status.Status != types.WorkerTaskStatusEnded || status.ReturnCode != 4 {
+		t.Error("unexpected result:", status)
+	}
+}
@@ -41,6 +41,7 @@ func New(config config.OverseerConfiguration, lg logger.AppLogger, quiesce bool)
 	var dm taskdef.TaskDefinitionManager
 	var rm resources.ResourceManager
 	var jn journal.TaskJournal
+	var daily *pool.DailyExecutor
 	var gs *services.OvsGrpcServer
 	var ds = events.NewDispatcher(lg)
 
@@ -56,6 +57,10 @@ func New(config config.OverseerConfiguration, lg logger.AppLogger, quiesce bool)
 		defPath = config.DefinitionDirectory
 	}
 
+	if config.Resources.SecretSource.Key == "" {
+		config.Resources.SecretSource.Key = config.Security.Secret
+	}
+
 	if rm, err = resources.NewManager(ds, lg, config.Resources, dataProvider); err != nil {
 		return nil, err
 	}
@@ -64,7 +69,7 @@ func New(config config.OverseerConfiguration, lg logger.AppLogger, quiesce bool)
 		return nil, err
 	}
 
-	if pl, err = pool.NewTaskPool(ds, config.PoolConfiguration, dataProvider, !quiesce, lg, dm); err != nil {
+	if pl, err = pool.NewTaskPool(ds, config.PoolConfiguration, dataProvider, !quiesce, lg, dm, rm); err != nil {
 		return nil, err
 	}
 
@@ -76,15 +81,22 @@ func New(config config.OverseerConfiguration, lg logger.AppLogger, quiesce bool)
 		return nil, err
 	}
 
-	daily := pool.NewDailyExecutor(ds, pm, pl, lg)
+	if daily, err = pool.NewDailyExecutor(ds, pm, pl, rm, dataProvider, lg); err != nil {
+		return nil, err
+	}
+
+	daily.CatchUp()
 
 	if config.PoolConfiguration.ForceNewDayProc {
 		daily.DailyProcedure()
 	}
 
-	wrunner := work.NewWorkerManager(ds, config.WorkerManager, lg, config.Server.Security)
+	wrunner, err := work.NewWorkerManager(ds, config.WorkerManager, lg, config.Server.Security, rm)
+	if err != nil {
+		return nil, err
+	}
 
-	if gs, err = createServiceServer(config.Server, ds, rm, dm, pm, pl, jn, dataProvider, config.Security, lg); err != nil {
+	if gs, err = createServiceServer(config.Server, ds, rm, dm, pm, pl, daily, jn, wrunner, config.WorkerManager.JoinToken, dataProvider, config.Security, lg); err != nil {
 		return nil, err
 	}
 
@@ -107,7 +119,10 @@ func createServiceServer(config config.ServerConfiguration,
 	dm taskdef.TaskDefinitionManager,
 	tm *pool.ActiveTaskPoolManager,
 	pv *pool.ActiveTaskPool,
+	daily *pool.DailyExecutor,
 	jrnl journal.TaskJournal,
+	wm work.WorkerManager,
+	joinToken string,
 	provider *datastore.Provider,
 	sec config.SecurityConfiguration,
 	log logger.AppLogger,
@@ -153,9 +168,10 @@ func createServiceServer(config config.ServerConfiguration,
 
 	rservice := services.NewResourceService(rm, log)
 	dservice := services.NewDefinistionService(dm, log)
-	tservice := services.NewTaskService(tm, pv, jrnl, log)
-	admservice := services.NewAdministrationService(um, rmn, am, log, pv)
+	tservice := services.NewTaskService(tm, pv, jrnl, rm, log)
+	admservice := services.NewAdministrationService(um, rmn, am, daily, rm, pv, wm, pv.Limits(), log, pv)
 	statservice := services.NewStatusService(log)
+	wregservice := services.NewWorkerRegistrationService(wm, joinToken, log)
 
 	grpcsrv := services.NewOvsGrpcServer(disp,
 		rservice,
@@ -164,6 +180,7 @@ func createServiceServer(config config.ServerConfiguration,
 		aservice,
 		admservice,
 		statservice,
+		wregservice,
 		config,
 		log,
 	)
@@ -178,7 +195,8 @@ func (s *Overseer) Start() error {
 	s.wmanager.Run()
 
 	timer := overseerTimer{s.logger}
-	timer.tickerFunc(s.dispatcher, s.conf.TimeInterval)
+	timer.tickerFunc(s.dispatcher, events.RouteTimeOut, config.Interval(s.conf.PoolConfiguration.EvaluationInterval, int(s.conf.TimeInterval)))
+	timer.tickerFunc(s.dispatcher, events.RouteDailyCheck, config.Interval(s.conf.PoolConfiguration.DailyCheckInterval, int(s.conf.TimeInterval)))
 
 	s.logger.Info("starting task journal")
 	s.journalComponent.Start()
@@ -3,8 +3,10 @@ package services
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/przebro/overseer/common/logger"
+	"github.com/przebro/overseer/common/types"
 	"github.com/przebro/overseer/common/types/date"
 	"github.com/przebro/overseer/common/validator"
 	"github.com/przebro/overseer/overseer/auth"
@@ -25,16 +27,22 @@ type ovsActiveTaskService struct {
 	manager  *pool.ActiveTaskPoolManager
 	poolView pool.TaskViewer
 	jrnal    journal.TaskLogReader
+	secrets  SecretMasker
 	log      logger.AppLogger
 	services.UnimplementedTaskServiceServer
 }
 
+//SecretMasker - masks values of secrets in a text returned to a client
+type SecretMasker interface {
+	MaskSecrets(in string) string
+}
+
 const errInvalidUser = "invalid user"
 
 //NewTaskService - New task service
-func NewTaskService(m *pool.ActiveTaskPoolManager, p pool.TaskViewer, j journal.TaskJournal, log logger.AppLogger) services.TaskServiceServer {
+func NewTaskService(m *pool.ActiveTaskPoolManager, p pool.TaskViewer, j journal.TaskJournal, s SecretMasker, log logger.AppLogger) services.TaskServiceServer {
 
-	tservice := &ovsActiveTaskService{manager: m, poolView: p, log: log, jrnal: j}
+	tservice := &ovsActiveTaskService{manager: m, poolView: p, log: log, jrnal: j, secrets: s}
 
 	return tservice
 }
@@ -142,6 +150,15 @@ func (srv *ovsActiveTaskService) OrderTask(ctx context.Context, in *services.Tas
 		return response, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	variables, err := orderVariables(in.Variables)
+	if err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validator.Valid.ValidateTag(in.Priority, "min=0,max=99"); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	var username string
 	var ok bool
 
@@ -149,7 +166,7 @@ func (srv *ovsActiveTaskService) OrderTask(ctx context.Context, in *services.Tas
 		return response, status.Error(codes.Unauthenticated, errInvalidUser)
 	}
 
-	result, err := srv.manager.Order(data, odate, username)
+	result, err := srv.manager.Order(data, odate, username, variables, int(in.Priority))
 	if err != nil {
 		return response, status.Error(codes.Internal, err.Error())
 	}
@@ -179,13 +196,22 @@ func (srv *ovsActiveTaskService) ForceTask(ctx context.Context, in *services.Tas
 		return response, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	variables, err := orderVariables(in.Variables)
+	if err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validator.Valid.ValidateTag(in.Priority, "min=0,max=99"); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	var username string
 	var ok bool
 	if username, ok = ctx.Value("username").(string); !ok {
 		return response, status.Error(codes.Unauthenticated, errInvalidUser)
 	}
 
-	result, err := srv.manager.Force(data, odate, username)
+	result, err := srv.manager.Force(data, odate, username, variables, int(in.Priority))
 	if err != nil {
 		return response, status.Error(codes.Internal, err.Error())
 	}
@@ -365,7 +391,7 @@ func (srv *ovsActiveTaskService) ListTasks(in *services.TaskFilterMsg, ltask ser
 			TaskId:     string(r.TaskID),
 			TaskStatus: r.State,
 			OrderDate:  string(r.Odate),
-			Waiting:    "",
+			Waiting:    r.WaitingInfo,
 			RunNumber:  r.RunNumber,
 			Confirmed:  r.Confirmed,
 			Held:       r.Held,
@@ -395,7 +421,7 @@ func (srv *ovsActiveTaskService) TaskDetail(ctx context.Context, in *services.Ta
 		TaskId:     string(result.TaskID),
 		TaskName:   result.Name,
 		TaskStatus: result.State,
-		Waiting:    "",
+		Waiting:    result.WaitingInfo,
 		Confirmed:  result.Confirmed,
 		Held:       result.Held,
 		RunNumber:  result.RunNumber,
@@ -416,6 +442,11 @@ func (srv *ovsActiveTaskService) TaskDetail(ctx context.Context, in *services.Ta
 	response.From = result.From
 	response.To = result.To
 
+	response.Variables = map[string]string{}
+	for _, v := range result.Variables {
+		response.Variables[v.Name] = srv.mask(v.Value)
+	}
+
 	response.Resources = []*services.TaskResourcesMsg{}
 	for _, ticket := range result.Tickets {
 		response.Resources = append(response.Resources, &services.TaskResourcesMsg{
@@ -427,6 +458,14 @@ func (srv *ovsActiveTaskService) TaskDetail(ctx context.Context, in *services.Ta
 
 	}
 
+	for _, r := range result.Resources {
+		response.Resources = append(response.Resources, &services.TaskResourcesMsg{
+			Type:      r.Type,
+			Name:      r.Name,
+			Satisfied: !r.Blocked,
+		})
+	}
+
 	response.Result = &services.ActionResultMsg{Success: true, Message: ""}
 
 	return response, nil
@@ -455,7 +494,7 @@ func (srv *ovsActiveTaskService) TaskLog(ctx context.Context, in *services.TaskA
 
 	entries := srv.jrnal.ReadLog(orderID)
 	for _, n := range entries {
-		response.Output = append(response.Output, fmt.Sprintf("%s:%s", n.Time.Format("2006-01-02 15:04:05.000000"), n.Message))
+		response.Output = append(response.Output, fmt.Sprintf("%s:%s", n.Time.Format("2006-01-02 15:04:05.000000"), srv.mask(n.Message)))
 	}
 
 	return response, nil
@@ -502,6 +541,41 @@ func (srv *ovsActiveTaskService) GetAllowedAction(method string) auth.UserAction
 	return action
 }
 
+//mask - masks values of secrets
+func (srv *ovsActiveTaskService) mask(in string) string {
+
+	if srv.secrets == nil {
+		return in
+	}
+
+	return srv.secrets.MaskSecrets(in)
+}
+
+//orderVariables - converts variables passed with an order request, the %% prefix is added to a name if it is omitted
+func orderVariables(vars map[string]string) (types.EnvironmentVariableList, error) {
+
+	result := types.EnvironmentVariableList{}
+
+	for k, v := range vars {
+
+		name := k
+		if !strings.HasPrefix(name, "%%") {
+			name = "%%" + name
+		}
+
+		variable := types.EnvironmentVariable{Name: name, Value: v}
+		if err := validator.Valid.Validate(variable); err != nil {
+			return nil, err
+		}
+
+		result = append(result, variable)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	return result, nil
+}
+
 func setErrorResponse(msg string, err error) error {
 
 	errmsg := ""
@@ -56,7 +56,7 @@ func createTaskService(t *testing.T) services.TaskServiceClient {
 	listener := bufconn.Listen(1)
 	mocksrv := &mockBuffconnServer{grpcServer: grpc.NewServer(buildUnaryChain(), buildStreamChain())}
 
-	srvc := NewTaskService(activeTaskManagerT, taskPoolT, jrnl, logger.NewTestLogger())
+	srvc := NewTaskService(activeTaskManagerT, taskPoolT, jrnl, resmanager, logger.NewTestLogger())
 	tsrvs = srvc.(*ovsActiveTaskService)
 
 	services.RegisterTaskServiceServer(mocksrv.grpcServer, srvc)
@@ -109,6 +109,11 @@ func TestOrderTask_Errors(t *testing.T) {
 		t.Error("unexpected result:", code, "expected:", codes.InvalidArgument)
 	}
 
+	_, err = service.OrderTask(ctx, &services.TaskOrderMsg{TaskGroup: "test", Odate: string(date.CurrentOdate()), TaskName: "dummy_01", Priority: 100})
+	if ok, code := matchExpectedStatusFromError(err, codes.InvalidArgument); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.InvalidArgument)
+	}
+
 }
 func TestOrderTask(t *testing.T) {
 
@@ -6,8 +6,12 @@ import (
 
 	"github.com/przebro/overseer/common/core"
 	"github.com/przebro/overseer/common/logger"
+	"github.com/przebro/overseer/common/types/date"
 	"github.com/przebro/overseer/common/validator"
 	"github.com/przebro/overseer/overseer/auth"
+	"github.com/przebro/overseer/overseer/internal/pool"
+	"github.com/przebro/overseer/overseer/internal/resources"
+	"github.com/przebro/overseer/overseer/internal/work"
 	"github.com/przebro/overseer/proto/services"
 
 	"google.golang.org/grpc/codes"
@@ -20,14 +24,24 @@ type ovsAdministrationService struct {
 	umanager    *auth.UserManager
 	rmanager    *auth.RoleManager
 	amanager    *auth.RoleAssociationManager
+	daily       *pool.DailyExecutor
+	secrets     resources.SecretManager
+	reconciler  ResourceReconciler
+	workers     work.WorkerRegistry
+	limits      pool.ConcurrencyLimits
 	qcomponents []core.ComponentQuiescer
 	services.UnimplementedAdministrationServiceServer
 }
 
+//ResourceReconciler - reconciles resources held by tasks with the state of the active pool
+type ResourceReconciler interface {
+	ReconcileResources() ([]string, error)
+}
+
 //NewAdministrationService - returns a new instance of ovsAdministrationService
-func NewAdministrationService(u *auth.UserManager, r *auth.RoleManager, a *auth.RoleAssociationManager, log logger.AppLogger, q ...core.ComponentQuiescer) services.AdministrationServiceServer {
+func NewAdministrationService(u *auth.UserManager, r *auth.RoleManager, a *auth.RoleAssociationManager, d *pool.DailyExecutor, s resources.SecretManager, rc ResourceReconciler, w work.WorkerRegistry, l pool.ConcurrencyLimits, log logger.AppLogger, q ...core.ComponentQuiescer) services.AdministrationServiceServer {
 
-	return &ovsAdministrationService{umanager: u, rmanager: r, amanager: a, log: log}
+	return &ovsAdministrationService{umanager: u, rmanager: r, amanager: a, daily: d, secrets: s, reconciler: rc, workers: w, limits: l, log: log}
 }
 
 //CreateUser - Creates a new user
@@ -268,6 +282,8 @@ func (srv *ovsAdministrationService) CreateRole(ctx context.Context, msg *servic
 		Bypass:         msg.Bypass,
 		Hold:           msg.Hold,
 		Free:           msg.Free,
+		Variables:      msg.Variables,
+		Namespaces:     msg.Namespaces,
 	}
 
 	if err := validator.Valid.Validate(model); err != nil {
@@ -317,6 +333,8 @@ func (srv *ovsAdministrationService) ModifyRole(ctx context.Context, msg *servic
 		Bypass:         msg.Bypass,
 		Hold:           msg.Hold,
 		Free:           msg.Free,
+		Variables:      msg.Variables,
+		Namespaces:     msg.Namespaces,
 	}
 
 	if err := srv.rmanager.Modify(model); err != nil {
@@ -403,6 +421,8 @@ func (srv *ovsAdministrationService) GetRole(ctx context.Context, msg *services.
 		Bypass:         model.Bypass,
 		Hold:           model.Hold,
 		Free:           model.Free,
+		Variables:      model.Variables,
+		Namespaces:     model.Namespaces,
 	}
 	result := &services.RoleResultMsg{Role: role}
 
@@ -427,6 +447,278 @@ func (srv *ovsAdministrationService) Resume(ctx context.Context, msg *empty.Empt
 	return nil, status.Error(codes.Unimplemented, "not implemented")
 }
 
+//NewDayProcedure - runs the new day procedure for a given ODATE, if ODATE is omitted then the current ODATE is used
+func (srv *ovsAdministrationService) NewDayProcedure(ctx context.Context, msg *services.NewDayProcMsg) (*services.ActionResultMsg, error) {
+
+	response := &services.ActionResultMsg{}
+	odate := date.Odate(msg.Odate)
+
+	if err := validator.Valid.Validate(odate); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if odate == date.OdateNone {
+		odate = date.CurrentOdate()
+	}
+
+	var username string
+	var ok bool
+	if username, ok = ctx.Value("username").(string); !ok {
+		return response, status.Error(codes.Unauthenticated, errInvalidUser)
+	}
+
+	if srv.daily == nil {
+		return response, status.Error(codes.Unavailable, "new day procedure is not available")
+	}
+
+	srv.log.Info("new day procedure requested, odate:", odate, " user:", username)
+
+	result, err := srv.daily.RunProcedure(odate, username)
+	if err != nil {
+		return response, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	response.Success = true
+	response.Message = fmt.Sprintf("new day procedure for odate:%s completed, deleted:%d, ordered:%d, purged tickets:%d", odate, result.Deleted, result.Ordered, result.Purged)
+
+	return response, nil
+}
+
+//SetSecret - encrypts and stores a secret, if the secret exists its value is replaced
+func (srv *ovsAdministrationService) SetSecret(ctx context.Context, msg *services.SecretMsg) (*services.ActionResultMsg, error) {
+
+	response := &services.ActionResultMsg{}
+
+	if err := validator.Valid.ValidateTag(msg.Name, "required,resname,max=32"); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validator.Valid.ValidateTag(msg.Value, "required"); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if srv.secrets == nil {
+		return response, status.Error(codes.Unavailable, "secret store is not available")
+	}
+
+	if _, err := srv.secrets.SetSecret(msg.Name, msg.Value); err != nil {
+		return response, status.Error(codes.Internal, err.Error())
+	}
+
+	response.Success = true
+	response.Message = fmt.Sprintf("secret %s set", msg.Name)
+
+	return response, nil
+}
+
+//DeleteSecret - removes a secret
+func (srv *ovsAdministrationService) DeleteSecret(ctx context.Context, msg *services.SecretMsg) (*services.ActionResultMsg, error) {
+
+	response := &services.ActionResultMsg{}
+
+	if err := validator.Valid.ValidateTag(msg.Name, "required,resname,max=32"); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if srv.secrets == nil {
+		return response, status.Error(codes.Unavailable, "secret store is not available")
+	}
+
+	if _, err := srv.secrets.DeleteSecret(msg.Name); err != nil {
+		return response, status.Error(codes.NotFound, err.Error())
+	}
+
+	response.Success = true
+	response.Message = fmt.Sprintf("secret %s deleted", msg.Name)
+
+	return response, nil
+}
+
+//ListSecrets - returns names of secrets, values of secrets are never returned
+func (srv *ovsAdministrationService) ListSecrets(ctx context.Context, msg *services.FilterMsg) (*services.ListEntityResultMsg, error) {
+
+	if err := validator.Valid.ValidateTag(msg.Filter, "omitempty,resvalue,max=32"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if srv.secrets == nil {
+		return nil, status.Error(codes.Unavailable, "secret store is not available")
+	}
+
+	result := &services.ListEntityResultMsg{}
+	for _, name := range srv.secrets.ListSecrets(msg.Filter) {
+		result.Entity = append(result.Entity, &services.EntityMsg{Name: name})
+	}
+
+	return result, nil
+}
+
+//ReconcileResources - recomputes the state of flags and quantitative resources from tasks that are starting or executing
+func (srv *ovsAdministrationService) ReconcileResources(ctx context.Context, msg *empty.Empty) (*services.ListEntityResultMsg, error) {
+
+	if srv.reconciler == nil {
+		return nil, status.Error(codes.Unavailable, "reconciliation is not available")
+	}
+
+	fixes, err := srv.reconciler.ReconcileResources()
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	result := &services.ListEntityResultMsg{}
+	for _, f := range fixes {
+		result.Entity = append(result.Entity, &services.EntityMsg{Name: f})
+	}
+
+	return result, nil
+}
+
+//ListWorkers - returns workers whose names match a filter
+func (srv *ovsAdministrationService) ListWorkers(ctx context.Context, msg *services.FilterMsg) (*services.WorkerListResultMsg, error) {
+
+	if err := validator.Valid.ValidateTag(msg.Filter, "omitempty,max=32"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if srv.workers == nil {
+		return nil, status.Error(codes.Unavailable, "worker registry is not available")
+	}
+
+	result := &services.WorkerListResultMsg{}
+	for _, w := range srv.workers.Workers(msg.Filter) {
+
+		info := &services.WorkerInfoMsg{
+			Name:         w.Name,
+			Address:      w.Address,
+			State:        string(w.State),
+			Registered:   w.Registered,
+			Capabilities: w.Capabilities,
+			Tasks:        int32(w.Tasks),
+			TasksLimit:   int32(w.TasksLimit),
+			Executions:   int32(w.Executions),
+			Pool:         w.Pool,
+			Tags:         w.Tags,
+			Weight:       int32(w.Weight),
+			Cpuload:      int32(w.CPU),
+			Memused:      int32(w.MemUsed),
+			Memtotal:     int32(w.MemTotal),
+			Diskfree:     w.DiskFree,
+		}
+
+		if !w.LastSeen.IsZero() {
+			info.LastSeen = w.LastSeen.Format("2006-01-02 15:04:05")
+		}
+
+		result.Workers = append(result.Workers, info)
+	}
+
+	return result, nil
+}
+
+//DrainWorker - stops sending new tasks to a worker
+func (srv *ovsAdministrationService) DrainWorker(ctx context.Context, msg *services.WorkerMsg) (*services.ActionResultMsg, error) {
+
+	if srv.workers == nil {
+		return nil, status.Error(codes.Unavailable, "worker registry is not available")
+	}
+
+	if err := validator.Valid.ValidateTag(msg.Name, "required,max=32"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := srv.workers.Drain(msg.Name); err != nil {
+		return nil, workerError(err)
+	}
+
+	return &services.ActionResultMsg{Success: true, Message: fmt.Sprintf("worker %s drained", msg.Name)}, nil
+}
+
+//UndrainWorker - resumes sending new tasks to a drained worker
+func (srv *ovsAdministrationService) UndrainWorker(ctx context.Context, msg *services.WorkerMsg) (*services.ActionResultMsg, error) {
+
+	if srv.workers == nil {
+		return nil, status.Error(codes.Unavailable, "worker registry is not available")
+	}
+
+	if err := validator.Valid.ValidateTag(msg.Name, "required,max=32"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := srv.workers.Undrain(msg.Name); err != nil {
+		return nil, workerError(err)
+	}
+
+	return &services.ActionResultMsg{Success: true, Message: fmt.Sprintf("worker %s undrained", msg.Name)}, nil
+}
+
+//RemoveWorker - removes a worker that does not execute any task
+func (srv *ovsAdministrationService) RemoveWorker(ctx context.Context, msg *services.WorkerMsg) (*services.ActionResultMsg, error) {
+
+	if srv.workers == nil {
+		return nil, status.Error(codes.Unavailable, "worker registry is not available")
+	}
+
+	if err := validator.Valid.ValidateTag(msg.Name, "required,max=32"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := srv.workers.Remove(msg.Name); err != nil {
+		return nil, workerError(err)
+	}
+
+	return &services.ActionResultMsg{Success: true, Message: fmt.Sprintf("worker %s removed", msg.Name)}, nil
+}
+
+//SetConcurrencyLimit - sets a maximum number of tasks executed at the same time, the change is not stored in the configuration
+func (srv *ovsAdministrationService) SetConcurrencyLimit(ctx context.Context, msg *services.ConcurrencyLimitMsg) (*services.ActionResultMsg, error) {
+
+	if srv.limits == nil {
+		return nil, status.Error(codes.Unavailable, "concurrency limits are not available")
+	}
+
+	if err := validator.Valid.ValidateTag(msg.Name, "omitempty,max=32"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := srv.limits.SetLimit(pool.LimitKind(msg.Kind), msg.Name, int(msg.Limit)); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	srv.log.Info("concurrency limit changed:", msg.Kind, " ", msg.Name, " ", msg.Limit)
+
+	return &services.ActionResultMsg{Success: true, Message: fmt.Sprintf("%s limit %s set to %d", msg.Kind, msg.Name, msg.Limit)}, nil
+}
+
+//ListConcurrencyLimits - returns concurrency limits along with numbers of executed tasks
+func (srv *ovsAdministrationService) ListConcurrencyLimits(ctx context.Context, msg *empty.Empty) (*services.ConcurrencyLimitListMsg, error) {
+
+	if srv.limits == nil {
+		return nil, status.Error(codes.Unavailable, "concurrency limits are not available")
+	}
+
+	result := &services.ConcurrencyLimitListMsg{}
+	for _, l := range srv.limits.Limits() {
+		result.Limits = append(result.Limits, &services.ConcurrencyLimitMsg{Kind: string(l.Kind), Name: l.Name, Limit: int32(l.Limit), Running: int32(l.Running)})
+	}
+
+	return result, nil
+}
+
+//workerError - converts an error of the worker registry to a status error
+func workerError(err error) error {
+
+	switch err {
+	case work.ErrWorkerNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case work.ErrWorkerHasTasks:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case work.ErrWorkerExists, work.ErrWorkerAddress:
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
 //GetAllowedAction - returns allowed action for given method. Implementation of handlers.AccessRestricter
 func (srv *ovsAdministrationService) GetAllowedAction(method string) auth.UserAction {
 
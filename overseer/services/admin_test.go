@@ -3,14 +3,20 @@ package services
 import (
 	"context"
 	"net"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/przebro/overseer/common/logger"
 	"github.com/przebro/overseer/overseer/auth"
+	"github.com/przebro/overseer/overseer/internal/pool"
+	"github.com/przebro/overseer/overseer/internal/work"
 	"github.com/przebro/overseer/proto/services"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/test/bufconn"
+	empty "google.golang.org/protobuf/types/known/emptypb"
 )
 
 var acl services.AdministrationServiceClient
@@ -43,7 +49,7 @@ func createAdminCLient(t *testing.T) services.AdministrationServiceClient {
 		t.Fatal("unable to create association manager:", err)
 	}
 
-	admservice := NewAdministrationService(umanager, rmanager, amanager, logger.NewTestLogger())
+	admservice := NewAdministrationService(umanager, rmanager, amanager, nil, resmanager, &mockReconciler{}, &mockRegistry{}, &mockLimits{}, logger.NewTestLogger())
 	srvc = admservice.(*ovsAdministrationService)
 
 	services.RegisterAdministrationServiceServer(mocksrv.grpcServer, admservice)
@@ -489,3 +495,219 @@ func TestListRoles(t *testing.T) {
 		}
 	}
 }
+
+func TestSecrets(t *testing.T) {
+
+	client := createAdminCLient(t)
+
+	if _, err := client.SetSecret(context.Background(), &services.SecretMsg{Name: "*invalid", Value: "value"}); err == nil {
+		t.Error("unexpected result, expected error")
+	}
+
+	if _, err := client.SetSecret(context.Background(), &services.SecretMsg{Name: "ADM-SECRET", Value: ""}); err == nil {
+		t.Error("unexpected result, expected error")
+	}
+
+	r, err := client.SetSecret(context.Background(), &services.SecretMsg{Name: "ADM-SECRET", Value: "value"})
+	if err != nil || !r.Success {
+		t.Error("unexpected result:", r, err)
+	}
+
+	l, err := client.ListSecrets(context.Background(), &services.FilterMsg{Filter: "ADM*"})
+	if err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+
+	if len(l.Entity) != 1 || l.Entity[0].Name != "ADM-SECRET" || l.Entity[0].Description != "" {
+		t.Error("unexpected result:", l.Entity)
+	}
+
+	r, err = client.DeleteSecret(context.Background(), &services.SecretMsg{Name: "ADM-SECRET"})
+	if err != nil || !r.Success {
+		t.Error("unexpected result:", r, err)
+	}
+
+	if _, err = client.DeleteSecret(context.Background(), &services.SecretMsg{Name: "ADM-SECRET"}); err == nil {
+		t.Error("unexpected result, expected error")
+	}
+}
+
+type mockReconciler struct {
+	fixes []string
+}
+
+func (m *mockReconciler) ReconcileResources() ([]string, error) {
+	return m.fixes, nil
+}
+
+func TestReconcileResources(t *testing.T) {
+
+	client := createAdminCLient(t)
+
+	srvc.reconciler = &mockReconciler{fixes: []string{"00010:FLAG SMPL-FLAG RELEASED BY RECONCILIATION"}}
+	defer func() { srvc.reconciler = &mockReconciler{} }()
+
+	r, err := client.ReconcileResources(context.Background(), &empty.Empty{})
+	if err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+
+	if len(r.Entity) != 1 || r.Entity[0].Name != "00010:FLAG SMPL-FLAG RELEASED BY RECONCILIATION" {
+		t.Error("unexpected result:", r.Entity)
+	}
+}
+
+type mockRegistry struct {
+	registered []work.WorkerRegistration
+	heartbeats []string
+	drained    []string
+}
+
+func (m *mockRegistry) Register(reg work.WorkerRegistration) error {
+	if reg.Name == "worker_01" {
+		return work.ErrWorkerExists
+	}
+	m.registered = append(m.registered, reg)
+	return nil
+}
+
+func (m *mockRegistry) Heartbeat(name string) bool {
+	m.heartbeats = append(m.heartbeats, name)
+	return name != "unknown"
+}
+
+func (m *mockRegistry) HeartbeatInterval() int {
+	return 10
+}
+
+func (m *mockRegistry) Workers(filter string) []work.WorkerInfo {
+	return []work.WorkerInfo{
+		{Name: "worker_01", Address: "127.0.0.1:7055", State: work.WorkerStateDraining, Tasks: 1, TasksLimit: 8, Executions: 1},
+		{Name: "worker_02", Address: "127.0.0.2:7055", State: work.WorkerStateLost, Registered: true, Capabilities: []string{"os"}, LastSeen: time.Date(2021, 1, 8, 10, 0, 0, 0, time.UTC)},
+	}
+}
+
+func (m *mockRegistry) Drain(name string) error {
+	if name != "worker_01" {
+		return work.ErrWorkerNotFound
+	}
+	m.drained = append(m.drained, name)
+	return nil
+}
+
+func (m *mockRegistry) Undrain(name string) error {
+	for i, n := range m.drained {
+		if n == name {
+			m.drained = append(m.drained[:i], m.drained[i+1:]...)
+			return nil
+		}
+	}
+	return work.ErrWorkerNotFound
+}
+
+func (m *mockRegistry) Remove(name string) error {
+	if name == "worker_01" {
+		return work.ErrWorkerHasTasks
+	}
+	return nil
+}
+
+func TestWorkers(t *testing.T) {
+
+	client := createAdminCLient(t)
+
+	r, err := client.ListWorkers(context.Background(), &services.FilterMsg{})
+	if err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+
+	if len(r.Workers) != 2 || r.Workers[0].State != "draining" || r.Workers[0].Executions != 1 || r.Workers[0].LastSeen != "" || r.Workers[1].LastSeen != "2021-01-08 10:00:00" || !r.Workers[1].Registered {
+		t.Error("unexpected result:", r.Workers)
+	}
+
+	if _, err = client.DrainWorker(context.Background(), &services.WorkerMsg{Name: "worker_01"}); err != nil {
+		t.Error("unexpected result:", err)
+	}
+
+	if _, err = client.UndrainWorker(context.Background(), &services.WorkerMsg{Name: "worker_01"}); err != nil {
+		t.Error("unexpected result:", err)
+	}
+
+	_, err = client.UndrainWorker(context.Background(), &services.WorkerMsg{Name: "worker_01"})
+	if ok, code := matchExpectedStatusFromError(err, codes.NotFound); !ok {
+		t.Error("unexpected result:", code)
+	}
+
+	_, err = client.DrainWorker(context.Background(), &services.WorkerMsg{Name: "worker_03"})
+	if ok, code := matchExpectedStatusFromError(err, codes.NotFound); !ok {
+		t.Error("unexpected result:", code)
+	}
+
+	_, err = client.RemoveWorker(context.Background(), &services.WorkerMsg{Name: "worker_01"})
+	if ok, code := matchExpectedStatusFromError(err, codes.FailedPrecondition); !ok {
+		t.Error("unexpected result:", code)
+	}
+
+	if _, err = client.RemoveWorker(context.Background(), &services.WorkerMsg{Name: "worker_02"}); err != nil {
+		t.Error("unexpected result:", err)
+	}
+
+	for _, name := range []string{"", strings.Repeat("w", 33)} {
+
+		msg := &services.WorkerMsg{Name: name}
+
+		_, err = client.DrainWorker(context.Background(), msg)
+		if ok, code := matchExpectedStatusFromError(err, codes.InvalidArgument); !ok {
+			t.Error("unexpected result:", name, code)
+		}
+
+		_, err = client.UndrainWorker(context.Background(), msg)
+		if ok, code := matchExpectedStatusFromError(err, codes.InvalidArgument); !ok {
+			t.Error("unexpected result:", name, code)
+		}
+
+		_, err = client.RemoveWorker(context.Background(), msg)
+		if ok, code := matchExpectedStatusFromError(err, codes.InvalidArgument); !ok {
+			t.Error("unexpected result:", name, code)
+		}
+	}
+}
+
+type mockLimits struct {
+	limits []pool.ConcurrencyLimit
+}
+
+func (m *mockLimits) SetLimit(kind pool.LimitKind, name string, limit int) error {
+	if kind != pool.LimitGlobal && kind != pool.LimitGroup && kind != pool.LimitType {
+		return pool.ErrInvalidLimitKind
+	}
+	m.limits = append(m.limits, pool.ConcurrencyLimit{Kind: kind, Name: name, Limit: limit})
+	return nil
+}
+
+func (m *mockLimits) Limits() []pool.ConcurrencyLimit {
+	return m.limits
+}
+
+func TestConcurrencyLimits(t *testing.T) {
+
+	client := createAdminCLient(t)
+
+	if _, err := client.SetConcurrencyLimit(context.Background(), &services.ConcurrencyLimitMsg{Kind: "group", Name: "billing", Limit: 3}); err != nil {
+		t.Error("unexpected result:", err)
+	}
+
+	_, err := client.SetConcurrencyLimit(context.Background(), &services.ConcurrencyLimitMsg{Kind: "worker", Name: "billing", Limit: 3})
+	if ok, code := matchExpectedStatusFromError(err, codes.InvalidArgument); !ok {
+		t.Error("unexpected result:", code)
+	}
+
+	r, err := client.ListConcurrencyLimits(context.Background(), &empty.Empty{})
+	if err != nil {
+		t.Error("unexpected result:", err)
+	}
+
+	if len(r.Limits) != 1 || r.Limits[0].Name != "billing" || r.Limits[0].Limit != 3 {
+		t.Error("unexpected result:", r.Limits)
+	}
+}
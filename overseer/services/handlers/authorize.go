@@ -20,6 +20,7 @@ import (
 const bearer = "Bearer "
 const authorization string = "authorization"
 const usernameKey string = "username"
+const namespacesKey string = "namespaces"
 const anonymousKey string = "<ANONYMOUS>"
 
 var (
@@ -89,7 +90,7 @@ func (ap *ServiceAuthorizeHandler) Authorize(ctx context.Context, req interface{
 		return nil, status.Error(codes.PermissionDenied, err.Error())
 	}
 
-	nctx := context.WithValue(ctx, usernameKey, username)
+	nctx := ap.userContext(ctx, restrictedService.GetAllowedAction(info.FullMethod), username)
 
 	return handler(nctx, req)
 }
@@ -123,7 +124,7 @@ func (ap *ServiceAuthorizeHandler) StreamAuthorize(srv interface{}, ss grpc.Serv
 		return status.Error(codes.PermissionDenied, err.Error())
 	}
 
-	nctx := context.WithValue(ss.Context(), usernameKey, username)
+	nctx := ap.userContext(ss.Context(), restrictedService.GetAllowedAction(info.FullMethod), username)
 	wrapped := wrapServerStream(ss)
 	wrapped.WrappedContext = nctx
 
@@ -158,6 +159,29 @@ func (ap *ServiceAuthorizeHandler) verifyAccess(ctx context.Context, rservice Ac
 	return username, nil
 }
 
+//userContext - returns a context with the username, for ticket actions it also contains namespaces
+//in which the user is allowed to manage tickets, a missing value means that there are no restrictions
+func (ap *ServiceAuthorizeHandler) userContext(ctx context.Context, action auth.UserAction, username string) context.Context {
+
+	nctx := context.WithValue(ctx, usernameKey, username)
+
+	if action != auth.ActionAddTicket && action != auth.ActionRemoveTicket {
+		return nctx
+	}
+
+	namespaces, err := ap.authman.AllowedNamespaces(ctx, username)
+	if err != nil {
+		ap.log.Error("unable to get namespaces for user:", username, err)
+		namespaces = []string{}
+	}
+
+	if namespaces == nil {
+		return nctx
+	}
+
+	return context.WithValue(nctx, namespacesKey, namespaces)
+}
+
 func (ap *ServiceAuthorizeHandler) getTokenFromContext(ctx context.Context) string {
 
 	var meta metadata.MD
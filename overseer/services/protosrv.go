@@ -11,6 +11,7 @@ import (
 	"github.com/przebro/overseer/overseer/internal/events"
 	"github.com/przebro/overseer/overseer/services/middleware"
 	"github.com/przebro/overseer/proto/services"
+	"github.com/przebro/overseer/proto/wservices"
 
 	"google.golang.org/grpc"
 )
@@ -31,6 +32,7 @@ func NewOvsGrpcServer(disp events.Dispatcher,
 	a services.AuthenticateServiceServer,
 	adm services.AdministrationServiceServer,
 	stat services.StatusServiceServer,
+	wreg wservices.WorkerRegistrationServiceServer,
 	config config.ServerConfiguration,
 	log logger.AppLogger,
 
@@ -54,6 +56,9 @@ func NewOvsGrpcServer(disp events.Dispatcher,
 	services.RegisterAuthenticateServiceServer(srv.grpcServer, a)
 	services.RegisterAdministrationServiceServer(srv.grpcServer, adm)
 	services.RegisterStatusServiceServer(srv.grpcServer, stat)
+	if wreg != nil {
+		wservices.RegisterWorkerRegistrationServiceServer(srv.grpcServer, wreg)
+	}
 	srv.dispatcher = disp
 
 	return srv
@@ -6,16 +6,21 @@ import (
 	"strings"
 
 	"github.com/przebro/overseer/common/logger"
+	"github.com/przebro/overseer/common/types"
 	"github.com/przebro/overseer/common/types/date"
 	"github.com/przebro/overseer/common/validator"
 	"github.com/przebro/overseer/overseer/auth"
 	"github.com/przebro/overseer/overseer/internal/resources"
+	"github.com/przebro/overseer/overseer/internal/unique"
 	"github.com/przebro/overseer/proto/services"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+//ticketTimeLayout - layout of the time of creation of a ticket and the time of a change in the audit trail
+const ticketTimeLayout = "2006-01-02 15:04:05"
+
 type ovsResourceService struct {
 	resManager resources.ResourceManager
 	log        logger.AppLogger
@@ -41,15 +46,31 @@ func (srv *ovsResourceService) AddTicket(ctx context.Context, msg *services.Tick
 		return response, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	result, err := srv.resManager.Add(name, odate)
+	if err := validateScope(msg.GetScope()); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := allowedNamespace(ctx, msg.GetScope()); err != nil {
+		return response, err
+	}
+
+	username, _ := ctx.Value("username").(string)
+
+	result, err := srv.resManager.AddTicket(resources.ScopedName(msg.GetScope(), name), odate, msg.GetKeep(), resources.TicketOrigin{Username: username})
 	srv.log.Info(result)
 
-	respmsg = fmt.Sprintf("ticket: %s with odate:%s added", msg.GetName(), msg.GetOdate())
+	tname := resources.ScopedName(msg.GetScope(), name)
+
+	respmsg = fmt.Sprintf("ticket: %s with odate:%s added", tname, msg.GetOdate())
 	if !result {
-		respmsg = fmt.Sprintf("%s, ticket: %s odate:%s", err, msg.GetName(), msg.GetOdate())
+		respmsg = fmt.Sprintf("%s, ticket: %s odate:%s", err, tname, msg.GetOdate())
 		return response, status.Error(codes.FailedPrecondition, respmsg)
 	}
 
+	if msg.GetKeep() {
+		respmsg = fmt.Sprintf("ticket: %s with odate:%s added, the ticket never expires", tname, msg.GetOdate())
+	}
+
 	response.Success = result
 	response.Message = respmsg
 
@@ -66,13 +87,24 @@ func (srv *ovsResourceService) DeleteTicket(ctx context.Context, msg *services.T
 		return response, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	result, _ := srv.resManager.Delete(name, odate)
+	if err := validateScope(msg.GetScope()); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := allowedNamespace(ctx, msg.GetScope()); err != nil {
+		return response, err
+	}
+
+	username, _ := ctx.Value("username").(string)
+	tname := resources.ScopedName(msg.GetScope(), name)
+
+	result, _ := srv.resManager.DeleteTicket(tname, odate, resources.TicketOrigin{Username: username})
 	srv.log.Info(result)
 
-	respmsg := fmt.Sprintf("ticket: %s with odate:%s deleted", msg.GetName(), msg.GetOdate())
+	respmsg := fmt.Sprintf("ticket: %s with odate:%s deleted", tname, msg.GetOdate())
 
 	if !result {
-		respmsg = fmt.Sprintf("ticket: %s with odate:%s does not exists", msg.GetName(), msg.GetOdate())
+		respmsg = fmt.Sprintf("ticket: %s with odate:%s does not exists", tname, msg.GetOdate())
 		return response, status.Error(codes.FailedPrecondition, respmsg)
 	}
 
@@ -92,13 +124,21 @@ func (srv *ovsResourceService) CheckTicket(ctx context.Context, msg *services.Ti
 		return response, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	result := srv.resManager.Check(name, odate)
+	if err := validateScope(msg.GetScope()); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tname := resources.ScopedName(msg.GetScope(), name)
+	ticket, result := srv.resManager.GetTicket(tname, odate)
 
-	respmsg := fmt.Sprintf("ticket: %s with odate:%s does not exists", msg.GetName(), msg.GetOdate())
+	respmsg := fmt.Sprintf("ticket: %s with odate:%s does not exists", tname, msg.GetOdate())
 	srv.log.Info(result)
 
 	if result {
-		respmsg = fmt.Sprintf("ticket: %s with odate:%s exists", msg.GetName(), msg.GetOdate())
+		respmsg = fmt.Sprintf("ticket: %s with odate:%s exists, added by %s", tname, msg.GetOdate(), ticket.Origin)
+		if !ticket.Created.IsZero() {
+			respmsg = fmt.Sprintf("%s at %s", respmsg, ticket.Created.Format(ticketTimeLayout))
+		}
 	}
 
 	response.Success = result
@@ -121,11 +161,142 @@ func (srv *ovsResourceService) ListTickets(msg *services.TicketActionMsg, lflags
 		return err
 	}
 
-	data := srv.resManager.ListTickets(name, odateStr)
+	if err := validateScope(msg.GetScope()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	data := srv.resManager.ListTickets(scopedPattern(msg.GetScope(), name), odateStr)
 
 	for _, d := range data {
-		msg := services.TicketListResultMsg{Name: d.Name, Odate: string(d.Odate)}
-		lflags.Send(&msg)
+		lflags.Send(ticketListResult(d))
+	}
+
+	return nil
+}
+
+//AddTickets - adds tickets at once, none of tickets is added if any of them is invalid or already exists
+func (srv *ovsResourceService) AddTickets(ctx context.Context, msg *services.TicketBatchMsg) (*services.ActionResultMsg, error) {
+
+	response := &services.ActionResultMsg{}
+
+	if len(msg.GetTickets()) == 0 {
+		return response, status.Error(codes.InvalidArgument, "at least one ticket is required")
+	}
+
+	tickets := make([]resources.TicketResource, 0, len(msg.GetTickets()))
+	for _, t := range msg.GetTickets() {
+		if err := validateTicketFields(t.GetName(), date.Odate(t.GetOdate())); err != nil {
+			return response, status.Error(codes.InvalidArgument, fmt.Sprintf("%s, ticket: %s odate:%s", err, t.GetName(), t.GetOdate()))
+		}
+		if err := validateScope(t.GetScope()); err != nil {
+			return response, status.Error(codes.InvalidArgument, fmt.Sprintf("%s, ticket: %s odate:%s", err, t.GetName(), t.GetOdate()))
+		}
+		if err := allowedNamespace(ctx, t.GetScope()); err != nil {
+			return response, err
+		}
+		tickets = append(tickets, resources.TicketResource{Name: resources.ScopedName(t.GetScope(), t.GetName()), Odate: date.Odate(t.GetOdate()), Keep: t.GetKeep()})
+	}
+
+	username, _ := ctx.Value("username").(string)
+
+	num, err := srv.resManager.AddTickets(tickets, resources.TicketOrigin{Username: username})
+	if err != nil {
+		return response, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	response.Success = true
+	response.Message = fmt.Sprintf("%d tickets added", num)
+
+	return response, nil
+}
+
+//DeleteTickets - deletes tickets that match name and odate patterns. A dry run returns matching tickets and a token,
+//the deletion is performed only if the token is equal to the token of currently matching tickets.
+func (srv *ovsResourceService) DeleteTickets(ctx context.Context, msg *services.TicketDeleteMsg) (*services.TicketDeleteResultMsg, error) {
+
+	response := &services.TicketDeleteResultMsg{Tickets: []*services.TicketListResultMsg{}}
+
+	name := msg.GetName()
+	if err := validator.Valid.ValidateTag(name, "required,max=32"); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	odateStr := msg.GetOdate()
+	if err := validator.Valid.ValidateTag(odateStr, "max=8"); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validateScope(msg.GetScope()); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := allowedNamespace(ctx, msg.GetScope()); err != nil {
+		return response, err
+	}
+
+	username, _ := ctx.Value("username").(string)
+	name = resources.ScopedName(msg.GetScope(), name)
+
+	data, token, err := srv.resManager.DeleteTickets(name, odateStr, msg.GetDryRun(), msg.GetToken(), resources.TicketOrigin{Username: username})
+	if err != nil {
+		return response, status.Error(codes.FailedPrecondition, fmt.Sprintf("%s, run a dry run first", err))
+	}
+	for _, d := range data {
+		response.Tickets = append(response.Tickets, ticketListResult(d))
+	}
+
+	response.Success = true
+	response.Count = int32(len(data))
+	response.Message = fmt.Sprintf("%d tickets deleted", len(data))
+	if msg.GetDryRun() {
+		response.Token = token
+		response.Message = fmt.Sprintf("%d tickets match the pattern", len(data))
+	}
+
+	return response, nil
+}
+
+//ticketListResult - converts a ticket to a message
+func ticketListResult(d resources.TicketResource) *services.TicketListResultMsg {
+
+	scope, name := resources.SplitScope(d.Name)
+	msg := &services.TicketListResultMsg{Name: name, Scope: scope, Odate: string(d.Odate), Keep: d.Keep,
+		OrderID: d.Origin.OrderID, ExecutionID: d.Origin.ExecutionID, Username: d.Origin.Username,
+	}
+	if !d.Created.IsZero() {
+		msg.Created = d.Created.Format(ticketTimeLayout)
+	}
+
+	return msg
+}
+
+//TicketAudit - returns additions and removals of tickets restricted to given name and odate
+func (srv *ovsResourceService) TicketAudit(msg *services.TicketActionMsg, laudit services.ResourceService_TicketAuditServer) error {
+
+	name := msg.GetName()
+
+	if err := validator.Valid.ValidateTag(name, "max=32"); err != nil {
+		return err
+	}
+
+	odateStr := msg.GetOdate()
+
+	if err := validator.Valid.ValidateTag(odateStr, "max=8"); err != nil {
+		return err
+	}
+
+	if err := validateScope(msg.GetScope()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for _, e := range srv.resManager.TicketAudit(scopedPattern(msg.GetScope(), name), odateStr) {
+		scope, ename := resources.SplitScope(e.Name)
+		entry := services.TicketAuditResultMsg{Time: e.Time.Format(ticketTimeLayout), Action: string(e.Action), Name: ename, Scope: scope, Odate: string(e.Odate),
+			OrderID: e.Origin.OrderID, ExecutionID: e.Origin.ExecutionID, Username: e.Origin.Username,
+		}
+		if err := laudit.Send(&entry); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -145,6 +316,12 @@ func (srv *ovsResourceService) SetFlag(ctx context.Context, msg *services.FlagAc
 
 	}
 
+	if err := validateScope(msg.GetScope()); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	fname := resources.ScopedName(msg.GetScope(), name)
+
 	respPolicy := func() string {
 		if msg.State == int32(resources.FlagPolicyExclusive) {
 			return "exclusive"
@@ -152,13 +329,13 @@ func (srv *ovsResourceService) SetFlag(ctx context.Context, msg *services.FlagAc
 		return "shared"
 	}()
 
-	ok, err := srv.resManager.Set(msg.Name, resources.FlagResourcePolicy(msg.State))
+	ok, err := srv.resManager.Set(fname, resources.FlagResourcePolicy(msg.State))
 	if err != nil {
 		return response, status.Error(codes.FailedPrecondition, err.Error())
 	}
 
 	response.Success = ok
-	response.Message = fmt.Sprintf("%s has been set to %s", msg.Name, respPolicy)
+	response.Message = fmt.Sprintf("%s has been set to %s", fname, respPolicy)
 
 	return response, nil
 }
@@ -173,13 +350,19 @@ func (srv *ovsResourceService) DestroyFlag(ctx context.Context, msg *services.Fl
 
 	}
 
-	ok, err := srv.resManager.DestroyFlag(msg.Name)
+	if err := validateScope(msg.GetScope()); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	fname := resources.ScopedName(msg.GetScope(), name)
+
+	ok, err := srv.resManager.DestroyFlag(fname)
 	if err != nil {
 		return response, status.Error(codes.NotFound, err.Error())
 	}
 
 	response.Success = ok
-	response.Message = fmt.Sprintf("%s has been removed", msg.Name)
+	response.Message = fmt.Sprintf("%s has been removed", fname)
 
 	return response, nil
 }
@@ -193,16 +376,205 @@ func (srv *ovsResourceService) ListFlags(msg *services.FlagActionMsg, lflags ser
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	data := srv.resManager.ListFlags(name)
+	if err := validateScope(msg.GetScope()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	data := srv.resManager.ListFlags(scopedPattern(msg.GetScope(), name))
 
 	for _, d := range data {
-		msg := services.FlagListResultMsg{FlagName: d.Name, State: int32(d.Policy)}
+		scope, fname := resources.SplitScope(d.Name)
+		msg := services.FlagListResultMsg{FlagName: fname, Scope: scope, State: int32(d.Policy), Holders: d.Holders}
 		lflags.Send(&msg)
 	}
 
 	return nil
 }
 
+//ReleaseFlagHolder - forcefully releases a flag held by a task
+func (srv *ovsResourceService) ReleaseFlagHolder(ctx context.Context, msg *services.FlagHolderMsg) (*services.ActionResultMsg, error) {
+
+	response := &services.ActionResultMsg{}
+
+	if err := validator.Valid.ValidateTag(msg.GetName(), "required,resname,max=32"); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validator.Valid.ValidateTag(msg.GetHolder(), "required"); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validator.Valid.Validate(unique.TaskOrderID(msg.GetHolder())); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validateScope(msg.GetScope()); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var username string
+	var ok bool
+	if username, ok = ctx.Value("username").(string); !ok {
+		return response, status.Error(codes.Unauthenticated, errInvalidUser)
+	}
+
+	fname := resources.ScopedName(msg.GetScope(), msg.GetName())
+
+	ok, err := srv.resManager.ReleaseHolder(fname, msg.Holder, username)
+	if err != nil {
+		return response, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	response.Success = ok
+	response.Message = fmt.Sprintf("%s has been released by %s", fname, msg.Holder)
+
+	return response, nil
+}
+
+//SetQuantity - creates a quantitative resource or changes its capacity
+func (srv *ovsResourceService) SetQuantity(ctx context.Context, msg *services.QuantityActionMsg) (*services.ActionResultMsg, error) {
+
+	response := &services.ActionResultMsg{}
+
+	if err := validator.Valid.ValidateTag(msg.GetName(), "required,resname,max=32"); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if msg.Capacity < 1 || msg.Capacity > 9999 {
+		return response, status.Error(codes.InvalidArgument, "invalid capacity")
+	}
+
+	ok, err := srv.resManager.SetQuantity(msg.Name, int(msg.Capacity))
+	if err != nil {
+		return response, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	response.Success = ok
+	response.Message = fmt.Sprintf("%s has been set to %d", msg.Name, msg.Capacity)
+
+	return response, nil
+}
+
+//DeleteQuantity - removes a quantitative resource
+func (srv *ovsResourceService) DeleteQuantity(ctx context.Context, msg *services.QuantityActionMsg) (*services.ActionResultMsg, error) {
+
+	response := &services.ActionResultMsg{}
+
+	if err := validator.Valid.ValidateTag(msg.GetName(), "required,resname,max=32"); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ok, err := srv.resManager.DeleteQuantity(msg.Name)
+	if err != nil {
+		return response, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	response.Success = ok
+	response.Message = fmt.Sprintf("%s has been removed", msg.Name)
+
+	return response, nil
+}
+
+//ListQuantities - lists quantitative resources with their capacity and the amount in use
+func (srv *ovsResourceService) ListQuantities(msg *services.QuantityActionMsg, lquantities services.ResourceService_ListQuantitiesServer) error {
+
+	name := msg.GetName()
+
+	if err := validateResourceName(name); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for _, d := range srv.resManager.ListQuantities(name) {
+		msg := services.QuantityListResultMsg{Name: d.Name, Capacity: int32(d.Capacity), Used: int32(d.Used)}
+		lquantities.Send(&msg)
+	}
+
+	return nil
+}
+
+func (srv *ovsResourceService) SetVariable(ctx context.Context, msg *services.VariableActionMsg) (*services.ActionResultMsg, error) {
+
+	response := &services.ActionResultMsg{}
+
+	variable := resources.VariableResource{
+		Scope: resources.VariableScope(msg.GetScope()),
+		Group: msg.GetGroup(),
+		Task:  msg.GetTask(),
+		Name:  variableName(msg.GetName()),
+		Value: msg.GetValue(),
+	}
+
+	if err := validateVariableFields(variable.Group, variable.Task, variable.Name); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ok, err := srv.resManager.SetVariable(variable)
+	if err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response.Success = ok
+	response.Message = fmt.Sprintf("variable: %s has been set in the %s scope", variable.Name, variable.Scope)
+
+	return response, nil
+}
+
+func (srv *ovsResourceService) DeleteVariable(ctx context.Context, msg *services.VariableActionMsg) (*services.ActionResultMsg, error) {
+
+	response := &services.ActionResultMsg{}
+
+	scope := resources.VariableScope(msg.GetScope())
+	name := variableName(msg.GetName())
+
+	if err := validateVariableFields(msg.GetGroup(), msg.GetTask(), name); err != nil {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ok, err := srv.resManager.DeleteVariable(scope, msg.GetGroup(), msg.GetTask(), name)
+	if err == resources.ErrInvalidVariableScope {
+		return response, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err != nil {
+		return response, status.Error(codes.NotFound, err.Error())
+	}
+
+	response.Success = ok
+	response.Message = fmt.Sprintf("variable: %s has been removed from the %s scope", name, scope)
+
+	return response, nil
+}
+
+func (srv *ovsResourceService) ListVariables(msg *services.VariableActionMsg, lvars services.ResourceService_ListVariablesServer) error {
+
+	scope := resources.VariableScope(msg.GetScope())
+
+	if err := validator.Valid.ValidateTag(string(scope), "omitempty,oneof=global group task"); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for _, v := range []string{msg.GetGroup(), msg.GetTask()} {
+		if err := validator.Valid.ValidateTag(v, "omitempty,resvalue,max=32"); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	name := variableName(msg.GetName())
+
+	if err := validator.Valid.ValidateTag(name, "max=32"); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	data := srv.resManager.ListVariables(scope, msg.GetGroup(), msg.GetTask(), name)
+
+	for _, d := range data {
+		msg := services.VariableListResultMsg{Scope: string(d.Scope), Group: d.Group, Task: d.Task, Name: d.Name, Value: d.Value}
+		lvars.Send(&msg)
+	}
+
+	return nil
+}
+
 func validateTicketFields(name string, odate date.Odate) error {
 
 	if err := validator.Valid.Validate(odate); err != nil {
@@ -216,26 +588,87 @@ func validateTicketFields(name string, odate date.Odate) error {
 	return nil
 }
 
+func validateVariableFields(group, task, name string) error {
+
+	if err := validator.Valid.Validate(types.EnvironmentVariable{Name: name}); err != nil {
+		return err
+	}
+
+	if err := validator.Valid.ValidateTag(group, "omitempty,resname,max=32"); err != nil {
+		return err
+	}
+
+	return validator.Valid.ValidateTag(task, "omitempty,resname,max=32")
+}
+
+//variableName - adds a prefix to the name of a variable if it is omitted
+func variableName(name string) string {
+
+	if name != "" && !strings.HasPrefix(name, "%%") {
+		return "%%" + name
+	}
+
+	return name
+}
+
 func validateResourceName(name string) error {
 
 	return validator.Valid.ValidateTag(name, "resvalue,required,max=32")
 
 }
 
+//validateScope - validates a namespace of a ticket or a flag, an empty namespace is the global namespace
+func validateScope(scope string) error {
+
+	return validator.Valid.ValidateTag(scope, "omitempty,resname,max=32")
+}
+
+//scopedPattern - returns a search pattern restricted to a namespace, an empty name matches all names in the namespace
+func scopedPattern(scope, name string) string {
+
+	if scope != "" && name == "" {
+		name = "*"
+	}
+
+	return resources.ScopedName(scope, name)
+}
+
+//allowedNamespace - verifies if a user can add or remove tickets in a given namespace,
+//a context without namespaces means that the user is not restricted
+func allowedNamespace(ctx context.Context, scope string) error {
+
+	namespaces, ok := ctx.Value("namespaces").([]string)
+	if !ok {
+		return nil
+	}
+
+	if scope == "" {
+		scope = auth.GlobalNamespace
+	}
+
+	for _, ns := range namespaces {
+		if ns == scope {
+			return nil
+		}
+	}
+
+	return status.Error(codes.PermissionDenied, fmt.Sprintf("user not allowed to manage tickets in the namespace:%s", scope))
+}
+
 //GetAllowedAction - returns allowed action for given method. Implementation of handlers.AccessRestricter
 func (srv *ovsResourceService) GetAllowedAction(method string) auth.UserAction {
 
 	var action auth.UserAction
 
-	if strings.HasSuffix(method, "AddTicket") {
+	if strings.HasSuffix(method, "AddTicket") || strings.HasSuffix(method, "AddTickets") {
 		action = auth.ActionAddTicket
 	}
 
-	if strings.HasSuffix(method, "DeleteTicket") {
+	if strings.HasSuffix(method, "DeleteTicket") || strings.HasSuffix(method, "DeleteTickets") {
 		action = auth.ActionRemoveTicket
 	}
 
-	if strings.HasSuffix(method, "CheckTicket") || strings.HasSuffix(method, "ListTickets") {
+	if strings.HasSuffix(method, "CheckTicket") || strings.HasSuffix(method, "ListTickets") || strings.HasSuffix(method, "TicketAudit") {
 		action = auth.ActionBrowse
 	}
 
@@ -243,7 +676,7 @@ func (srv *ovsResourceService) GetAllowedAction(method string) auth.UserAction {
 		action = auth.ActionSetFlag
 	}
 
-	if strings.HasSuffix(method, "DestroyFlag") {
+	if strings.HasSuffix(method, "DestroyFlag") || strings.HasSuffix(method, "ReleaseFlagHolder") {
 		action = auth.ActionSetFlag
 	}
 
@@ -251,5 +684,21 @@ func (srv *ovsResourceService) GetAllowedAction(method string) auth.UserAction {
 		action = auth.ActionBrowse
 	}
 
+	if strings.HasSuffix(method, "SetQuantity") || strings.HasSuffix(method, "DeleteQuantity") {
+		action = auth.ActionSetFlag
+	}
+
+	if strings.HasSuffix(method, "ListQuantities") {
+		action = auth.ActionBrowse
+	}
+
+	if strings.HasSuffix(method, "SetVariable") || strings.HasSuffix(method, "DeleteVariable") {
+		action = auth.ActionVariables
+	}
+
+	if strings.HasSuffix(method, "ListVariables") {
+		action = auth.ActionBrowse
+	}
+
 	return action
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"net"
+	"strings"
 	"testing"
 
 	"github.com/przebro/overseer/common/logger"
@@ -375,6 +376,198 @@ func TestListTicket(t *testing.T) {
 	}
 
 }
+func TestTicketProvenance(t *testing.T) {
+
+	client := createResourceClient(t)
+	ctx := context.WithValue(context.Background(), "username", "testuser")
+	msg := &services.TicketActionMsg{Name: "service_audit_01", Odate: "20201120"}
+
+	if _, err := rsrvc.AddTicket(ctx, msg); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := rsrvc.CheckTicket(ctx, msg)
+	if err != nil || !r.Success || !strings.Contains(r.Message, "user:testuser") {
+		t.Error("unexpected result:", r, err)
+	}
+
+	lr, err := client.ListTickets(context.Background(), &services.TicketActionMsg{Name: "service_audit_01"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ticket, err := lr.Recv()
+	if err != nil || ticket.Username != "testuser" || ticket.Created == "" {
+		t.Error("unexpected result:", ticket, err)
+	}
+
+	if _, err = rsrvc.DeleteTicket(ctx, msg); err != nil {
+		t.Fatal(err)
+	}
+
+	ar, err := client.TicketAudit(context.Background(), &services.TicketActionMsg{Name: "service_audit_01"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actions := []string{}
+	for {
+		e, err := ar.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e.Username != "testuser" {
+			t.Error("unexpected result:", e.Username)
+		}
+		actions = append(actions, e.Action)
+	}
+
+	if len(actions) != 2 || actions[0] != "ADD" || actions[1] != "REM" {
+		t.Error("unexpected result:", actions)
+	}
+}
+
+func TestAddDeleteTickets(t *testing.T) {
+
+	client := createResourceClient(t)
+
+	batch := &services.TicketBatchMsg{Tickets: []*services.TicketActionMsg{
+		{Name: "service_batch_01", Odate: "20201120"},
+		{Name: "service_batch_02", Odate: "ABCDEF"},
+	}}
+
+	_, err := client.AddTickets(context.Background(), batch)
+	if ok, code := matchExpectedStatusFromError(err, codes.InvalidArgument); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.InvalidArgument)
+	}
+
+	batch.Tickets[1].Odate = "20201120"
+	if r, err := client.AddTickets(context.Background(), batch); err != nil || !r.Success {
+		t.Fatal("unexpected result:", r, err)
+	}
+
+	_, err = client.AddTickets(context.Background(), batch)
+	if ok, code := matchExpectedStatusFromError(err, codes.FailedPrecondition); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.FailedPrecondition)
+	}
+
+	msg := &services.TicketDeleteMsg{Name: "service_batch_*", Odate: "20201120", DryRun: true}
+	r, err := client.DeleteTickets(context.Background(), msg)
+	if err != nil || r.Count != 2 || len(r.Tickets) != 2 || r.Token == "" {
+		t.Fatal("unexpected result:", r, err)
+	}
+
+	msg.DryRun = false
+	_, err = client.DeleteTickets(context.Background(), msg)
+	if ok, code := matchExpectedStatusFromError(err, codes.FailedPrecondition); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.FailedPrecondition)
+	}
+
+	msg.Token = r.Token
+	if r, err = client.DeleteTickets(context.Background(), msg); err != nil || r.Count != 2 {
+		t.Error("unexpected result:", r, err)
+	}
+
+	_, err = client.DeleteTickets(context.Background(), &services.TicketDeleteMsg{DryRun: true})
+	if ok, code := matchExpectedStatusFromError(err, codes.InvalidArgument); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.InvalidArgument)
+	}
+}
+
+func TestTicketNamespaces(t *testing.T) {
+
+	client := createResourceClient(t)
+	ctx := context.WithValue(context.Background(), "namespaces", []string{"team"})
+
+	_, err := rsrvc.AddTicket(ctx, &services.TicketActionMsg{Name: "service_ns_01", Odate: "20201120"})
+	if ok, code := matchExpectedStatusFromError(err, codes.PermissionDenied); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.PermissionDenied)
+	}
+
+	_, err = rsrvc.AddTicket(ctx, &services.TicketActionMsg{Name: "service_ns_01", Odate: "20201120", Scope: "team:a"})
+	if ok, code := matchExpectedStatusFromError(err, codes.InvalidArgument); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.InvalidArgument)
+	}
+
+	if _, err = rsrvc.AddTicket(ctx, &services.TicketActionMsg{Name: "service_ns_01", Odate: "20201120", Scope: "team"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = rsrvc.AddTicket(context.Background(), &services.TicketActionMsg{Name: "service_ns_01", Odate: "20201120"}); err != nil {
+		t.Fatal(err)
+	}
+
+	lr, err := client.ListTickets(context.Background(), &services.TicketActionMsg{Scope: "team"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ticket, err := lr.Recv()
+	if err != nil || ticket.Name != "service_ns_01" || ticket.Scope != "team" {
+		t.Error("unexpected result:", ticket, err)
+	}
+
+	if _, err = lr.Recv(); err != io.EOF {
+		t.Error("unexpected result:", err)
+	}
+
+	_, err = rsrvc.DeleteTickets(ctx, &services.TicketDeleteMsg{Name: "service_ns_*", Scope: "other", DryRun: true})
+	if ok, code := matchExpectedStatusFromError(err, codes.PermissionDenied); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.PermissionDenied)
+	}
+
+	r, err := rsrvc.DeleteTickets(ctx, &services.TicketDeleteMsg{Name: "service_ns_*", Scope: "team", DryRun: true})
+	if err != nil || r.Count != 1 {
+		t.Fatal("unexpected result:", r, err)
+	}
+
+	r, err = rsrvc.DeleteTickets(ctx, &services.TicketDeleteMsg{Name: "service_ns_*", Scope: "team", Token: r.Token})
+	if err != nil || r.Count != 1 || r.Tickets[0].Scope != "team" {
+		t.Error("unexpected result:", r, err)
+	}
+
+	_, err = rsrvc.DeleteTicket(ctx, &services.TicketActionMsg{Name: "service_ns_01", Odate: "20201120"})
+	if ok, code := matchExpectedStatusFromError(err, codes.PermissionDenied); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.PermissionDenied)
+	}
+
+	gctx := context.WithValue(context.Background(), "namespaces", []string{auth.GlobalNamespace})
+	if _, err = rsrvc.DeleteTicket(gctx, &services.TicketActionMsg{Name: "service_ns_01", Odate: "20201120"}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestScopedFlags(t *testing.T) {
+
+	client := createResourceClient(t)
+
+	if _, err := client.SetFlag(context.Background(), &services.FlagActionMsg{Name: "service_ns_flag", State: 0, Scope: "team"}); err != nil {
+		t.Fatal(err)
+	}
+
+	lr, err := client.ListFlags(context.Background(), &services.FlagActionMsg{Name: "service_ns_*", Scope: "team"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	flag, err := lr.Recv()
+	if err != nil || flag.FlagName != "service_ns_flag" || flag.Scope != "team" {
+		t.Error("unexpected result:", flag, err)
+	}
+
+	_, err = client.DestroyFlag(context.Background(), &services.FlagActionMsg{Name: "service_ns_flag"})
+	if ok, code := matchExpectedStatusFromError(err, codes.NotFound); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.NotFound)
+	}
+
+	if _, err = client.DestroyFlag(context.Background(), &services.FlagActionMsg{Name: "service_ns_flag", Scope: "team"}); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestSetFlag_Errors(t *testing.T) {
 
 	client := createResourceClient(t)
@@ -539,18 +732,161 @@ func TestDestroyFlag(t *testing.T) {
 
 }
 
+func TestSetVariable_Errors(t *testing.T) {
+
+	client := createResourceClient(t)
+
+	msg := &services.VariableActionMsg{Scope: "global", Name: "%%invalid_name", Value: "value"}
+	_, err := client.SetVariable(context.Background(), msg)
+
+	if ok, code := matchExpectedStatusFromError(err, codes.InvalidArgument); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.InvalidArgument)
+	}
+
+	msg = &services.VariableActionMsg{Scope: "task", Group: "test", Name: "%%VARIABLE", Value: "value"}
+	_, err = client.SetVariable(context.Background(), msg)
+
+	if ok, code := matchExpectedStatusFromError(err, codes.InvalidArgument); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.InvalidArgument)
+	}
+}
+
+func TestSetListDeleteVariable(t *testing.T) {
+
+	client := createResourceClient(t)
+
+	msg := &services.VariableActionMsg{Scope: "group", Group: "test", Name: "SRVVARIABLE", Value: "value"}
+	r, err := client.SetVariable(context.Background(), msg)
+
+	if err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+	if r.Success != true {
+		t.Error("unexpected result:", r.Success, "expected:", true)
+	}
+
+	result, err := client.ListVariables(context.Background(), &services.VariableActionMsg{Scope: "group", Name: "%%SRV*"})
+	if err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+
+	v, err := result.Recv()
+	if err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+
+	if v.Name != "%%SRVVARIABLE" || v.Group != "test" || v.Value != "value" {
+		t.Error("unexpected result:", v)
+	}
+
+	if _, err = result.Recv(); err != io.EOF {
+		t.Error("unexpected result:", err, "expected:", io.EOF)
+	}
+
+	_, err = client.DeleteVariable(context.Background(), msg)
+	if err != nil {
+		t.Error("unexpected result:", err)
+	}
+
+	_, err = client.DeleteVariable(context.Background(), msg)
+	if ok, code := matchExpectedStatusFromError(err, codes.NotFound); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.NotFound)
+	}
+}
+
+func TestReleaseFlagHolder(t *testing.T) {
+
+	client := createResourceClient(t)
+
+	_, err := client.ReleaseFlagHolder(context.Background(), &services.FlagHolderMsg{Name: "SRV_FLAG", Holder: ""})
+	if ok, code := matchExpectedStatusFromError(err, codes.InvalidArgument); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.InvalidArgument)
+	}
+
+	_, err = client.ReleaseFlagHolder(context.Background(), &services.FlagHolderMsg{Name: "SRV_*", Holder: "00010"})
+	if ok, code := matchExpectedStatusFromError(err, codes.InvalidArgument); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.InvalidArgument)
+	}
+
+	_, err = client.ReleaseFlagHolder(context.Background(), &services.FlagHolderMsg{Name: "SRV_FLAG", Holder: "00010"})
+	if ok, code := matchExpectedStatusFromError(err, codes.FailedPrecondition); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.FailedPrecondition)
+	}
+}
+
+func TestSetListDeleteQuantity(t *testing.T) {
+
+	client := createResourceClient(t)
+
+	_, err := client.SetQuantity(context.Background(), &services.QuantityActionMsg{Name: "SRV_LICENSE", Capacity: 0})
+	if ok, code := matchExpectedStatusFromError(err, codes.InvalidArgument); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.InvalidArgument)
+	}
+
+	_, err = client.SetQuantity(context.Background(), &services.QuantityActionMsg{Name: "SRV_*", Capacity: 3})
+	if ok, code := matchExpectedStatusFromError(err, codes.InvalidArgument); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.InvalidArgument)
+	}
+
+	msg := &services.QuantityActionMsg{Name: "SRV_LICENSE", Capacity: 3}
+	r, err := client.SetQuantity(context.Background(), msg)
+	if err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+	if r.Success != true {
+		t.Error("unexpected result:", r.Success, "expected:", true)
+	}
+
+	result, err := client.ListQuantities(context.Background(), &services.QuantityActionMsg{Name: "SRV_*"})
+	if err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+
+	v, err := result.Recv()
+	if err != nil {
+		t.Fatal("unexpected result:", err)
+	}
+
+	if v.Name != "SRV_LICENSE" || v.Capacity != 3 || v.Used != 0 {
+		t.Error("unexpected result:", v)
+	}
+
+	if _, err = result.Recv(); err != io.EOF {
+		t.Error("unexpected result:", err, "expected:", io.EOF)
+	}
+
+	if _, err = client.DeleteQuantity(context.Background(), msg); err != nil {
+		t.Error("unexpected result:", err)
+	}
+
+	_, err = client.DeleteQuantity(context.Background(), msg)
+	if ok, code := matchExpectedStatusFromError(err, codes.FailedPrecondition); !ok {
+		t.Error("unexpected result:", code, "expected:", codes.FailedPrecondition)
+	}
+}
+
 func TestAllowedActions(t *testing.T) {
 
 	createResourceClient(t)
 
 	tdata := map[string]auth.UserAction{
-		"AddTicket":    auth.ActionAddTicket,
-		"DeleteTicket": auth.ActionRemoveTicket,
-		"CheckTicket":  auth.ActionBrowse,
-		"ListTickets":  auth.ActionBrowse,
-		"SetFlag":      auth.ActionSetFlag,
-		"DestroyFlag":  auth.ActionSetFlag,
-		"ListFlags":    auth.ActionBrowse,
+		"AddTicket":         auth.ActionAddTicket,
+		"DeleteTicket":      auth.ActionRemoveTicket,
+		"CheckTicket":       auth.ActionBrowse,
+		"ListTickets":       auth.ActionBrowse,
+		"TicketAudit":       auth.ActionBrowse,
+		"AddTickets":        auth.ActionAddTicket,
+		"DeleteTickets":     auth.ActionRemoveTicket,
+		"SetFlag":           auth.ActionSetFlag,
+		"DestroyFlag":       auth.ActionSetFlag,
+		"ReleaseFlagHolder": auth.ActionSetFlag,
+		"ListFlags":         auth.ActionBrowse,
+		"SetVariable":       auth.ActionVariables,
+		"DeleteVariable":    auth.ActionVariables,
+		"ListVariables":     auth.ActionBrowse,
+		"SetQuantity":       auth.ActionSetFlag,
+		"DeleteQuantity":    auth.ActionSetFlag,
+		"ListQuantities":    auth.ActionBrowse,
 	}
 
 	for k, v := range tdata {
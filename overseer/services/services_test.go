@@ -143,8 +143,11 @@ var provcfg = config.StoreProviderConfiguration{
 }
 
 var rescfg = config.ResourcesConfigurartion{
-	TicketSource: config.ResourceEntry{Sync: 3600, Collection: "resources"},
-	FlagSource:   config.ResourceEntry{Sync: 3600, Collection: "resources"},
+	TicketSource:   config.ResourceEntry{Sync: 3600, Collection: "resources"},
+	FlagSource:     config.ResourceEntry{Sync: 3600, Collection: "resources"},
+	QuantitySource: config.ResourceEntry{Sync: 3600, Collection: "resources"},
+	VariableSource: config.ResourceEntry{Sync: 3600, Collection: "resources"},
+	SecretSource:   config.SecretEntry{Sync: 3600, Collection: "resources", Key: "WBdumgVKBK4iTB+CR2Z2meseDrlnrg54QDSAPcFswWU="},
 }
 
 var testCollectionName = "tasks"
@@ -207,7 +210,7 @@ func init() {
 }
 
 func initTaskPool() {
-	taskPoolT, _ = pool.NewTaskPool(&dispatcher, taskPoolConfig, provider, true, logger.NewTestLogger(), definitionManagerT)
+	taskPoolT, _ = pool.NewTaskPool(&dispatcher, taskPoolConfig, provider, true, logger.NewTestLogger(), definitionManagerT, nil)
 }
 
 func matchExpectedStatusFromError(err error, expected codes.Code) (bool, codes.Code) {
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/x509"
+
+	"github.com/przebro/overseer/common/logger"
+	"github.com/przebro/overseer/overseer/internal/work"
+	"github.com/przebro/overseer/proto/wservices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type ovsWorkerRegistrationService struct {
+	registry work.WorkerRegistry
+	token    string
+	log      logger.AppLogger
+	wservices.UnimplementedWorkerRegistrationServiceServer
+}
+
+//NewWorkerRegistrationService - Creates a new service that registers workers. A worker is authenticated
+//with a verified client certificate issued for its name or with a join token, an empty token accepts only workers with a certificate.
+func NewWorkerRegistrationService(registry work.WorkerRegistry, token string, log logger.AppLogger) wservices.WorkerRegistrationServiceServer {
+	return &ovsWorkerRegistrationService{registry: registry, token: token, log: log}
+}
+
+//Register - registers a worker
+func (srv *ovsWorkerRegistrationService) Register(ctx context.Context, msg *wservices.WorkerRegistrationMsg) (*wservices.WorkerRegistrationResponseMsg, error) {
+
+	identified, err := srv.authenticate(ctx, msg.Name, msg.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	reg := work.WorkerRegistration{
+		Name:         msg.Name,
+		Host:         msg.Host,
+		Port:         int(msg.Port),
+		TasksLimit:   int(msg.TasksLimit),
+		Capabilities: msg.Capabilities,
+		Pool:         msg.Pool,
+		Tags:         msg.Tags,
+		Weight:       int(msg.Weight),
+		Identified:   identified,
+	}
+
+	if err := srv.registry.Register(reg); err != nil {
+		return nil, workerError(err)
+	}
+
+	return &wservices.WorkerRegistrationResponseMsg{Success: true, Message: "worker registered", Heartbeat: int32(srv.registry.HeartbeatInterval())}, nil
+}
+
+//Heartbeat - confirms that a worker is alive
+func (srv *ovsWorkerRegistrationService) Heartbeat(ctx context.Context, msg *wservices.WorkerHeartbeatMsg) (*wservices.WorkerHeartbeatResponseMsg, error) {
+
+	if _, err := srv.authenticate(ctx, msg.Name, msg.Token); err != nil {
+		return nil, err
+	}
+
+	return &wservices.WorkerHeartbeatResponseMsg{Registered: srv.registry.Heartbeat(msg.Name)}, nil
+}
+
+//authenticate - checks if a worker presented a verified certificate issued for its name or a valid join token,
+//returns true if the worker was identified by the certificate. The join token is shared by workers so it does not identify a worker.
+func (srv *ovsWorkerRegistrationService) authenticate(ctx context.Context, name string, token string) (bool, error) {
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(info.State.VerifiedChains) > 0 && certificateFor(info.State.VerifiedChains[0][0], name) {
+			return true, nil
+		}
+	}
+
+	if srv.token != "" && subtle.ConstantTimeCompare([]byte(srv.token), []byte(token)) == 1 {
+		return false, nil
+	}
+
+	srv.log.Error("worker registration rejected, invalid token or certificate, worker:", name)
+
+	return false, status.Error(codes.Unauthenticated, "worker not authenticated")
+}
+
+//certificateFor - checks if a certificate was issued for a worker, the common name or one of DNS names must be equal to the name of the worker
+func certificateFor(cert *x509.Certificate, name string) bool {
+
+	if name == "" {
+		return false
+	}
+
+	if cert.Subject.CommonName == name {
+		return true
+	}
+
+	for _, n := range cert.DNSNames {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/przebro/overseer/common/logger"
+	"github.com/przebro/overseer/proto/wservices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func TestWorkerRegistration(t *testing.T) {
+
+	registry := &mockRegistry{}
+	srv := NewWorkerRegistrationService(registry, "join-token", logger.NewTestLogger())
+
+	msg := &wservices.WorkerRegistrationMsg{Name: "worker_02", Host: "127.0.0.2", Port: 7055, TasksLimit: 4, Capabilities: []string{"os", "dummy"}}
+
+	_, err := srv.Register(context.Background(), msg)
+	if ok, code := matchExpectedStatusFromError(err, codes.Unauthenticated); !ok {
+		t.Error("unexpected result:", code)
+	}
+
+	msg.Token = "join-token"
+	r, err := srv.Register(context.Background(), msg)
+	if err != nil || !r.Success || r.Heartbeat != 10 {
+		t.Fatal("unexpected result:", r, err)
+	}
+
+	if len(registry.registered) != 1 || registry.registered[0].Port != 7055 || len(registry.registered[0].Capabilities) != 2 || registry.registered[0].Identified {
+		t.Error("unexpected result:", registry.registered)
+	}
+
+	msg.Name = "worker_01"
+	_, err = srv.Register(context.Background(), msg)
+	if ok, code := matchExpectedStatusFromError(err, codes.AlreadyExists); !ok {
+		t.Error("unexpected result:", code)
+	}
+
+	h, err := srv.Heartbeat(context.Background(), &wservices.WorkerHeartbeatMsg{Name: "worker_02", Token: "join-token"})
+	if err != nil || !h.Registered {
+		t.Error("unexpected result:", h, err)
+	}
+
+	h, err = srv.Heartbeat(context.Background(), &wservices.WorkerHeartbeatMsg{Name: "unknown", Token: "join-token"})
+	if err != nil || h.Registered {
+		t.Error("unexpected result:", h, err)
+	}
+
+	_, err = srv.Heartbeat(context.Background(), &wservices.WorkerHeartbeatMsg{Name: "worker_02", Token: "invalid"})
+	if ok, code := matchExpectedStatusFromError(err, codes.Unauthenticated); !ok {
+		t.Error("unexpected result:", code)
+	}
+
+	srv = NewWorkerRegistrationService(registry, "", logger.NewTestLogger())
+	_, err = srv.Register(context.Background(), msg)
+	if ok, code := matchExpectedStatusFromError(err, codes.Unauthenticated); !ok {
+		t.Error("unexpected result, an empty token is not accepted:", code)
+	}
+}
+
+func TestWorkerRegistration_Certificate(t *testing.T) {
+
+	registry := &mockRegistry{}
+	srv := NewWorkerRegistrationService(registry, "", logger.NewTestLogger())
+
+	certContext := func(cert *x509.Certificate) context.Context {
+		info := credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}}
+		return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: info})
+	}
+
+	msg := &wservices.WorkerRegistrationMsg{Name: "worker_03", Host: "127.0.0.3", Port: 7055, TasksLimit: 4, Capabilities: []string{"os"}}
+
+	_, err := srv.Register(certContext(&x509.Certificate{Subject: pkix.Name{CommonName: "worker_04"}}), msg)
+	if ok, code := matchExpectedStatusFromError(err, codes.Unauthenticated); !ok {
+		t.Error("unexpected result, a certificate issued for another worker is accepted:", code)
+	}
+
+	if _, err = srv.Register(certContext(&x509.Certificate{Subject: pkix.Name{CommonName: "worker_03"}}), msg); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = srv.Register(certContext(&x509.Certificate{DNSNames: []string{"worker.local", "worker_03"}}), msg); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(registry.registered) != 2 || !registry.registered[0].Identified || !registry.registered[1].Identified {
+		t.Error("unexpected result:", registry.registered)
+	}
+
+	_, err = srv.Heartbeat(certContext(&x509.Certificate{Subject: pkix.Name{CommonName: "worker_04"}}), &wservices.WorkerHeartbeatMsg{Name: "worker_03"})
+	if ok, code := matchExpectedStatusFromError(err, codes.Unauthenticated); !ok {
+		t.Error("unexpected result:", code)
+	}
+}
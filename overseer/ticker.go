@@ -4,15 +4,15 @@ import (
 	"time"
 
 	"github.com/przebro/overseer/common/logger"
-	"github.com/przebro/overseer/overseer/config"
 	"github.com/przebro/overseer/overseer/internal/events"
 )
 
 type overseerTimer struct{ log logger.AppLogger }
 
-func (timer *overseerTimer) tickerFunc(dispatcher events.Dispatcher, interval config.IntervalValue) error {
+//tickerFunc - pushes the current time to a route at a given interval
+func (timer *overseerTimer) tickerFunc(dispatcher events.Dispatcher, route events.RouteName, interval time.Duration) error {
 
-	t := time.NewTicker(time.Duration(int(interval) * int(time.Second)))
+	t := time.NewTicker(interval)
 	go func() {
 		for {
 			x := <-t.C
@@ -20,7 +20,7 @@ func (timer *overseerTimer) tickerFunc(dispatcher events.Dispatcher, interval co
 			y, mth, d := x.Date()
 			msgdata := events.RouteTimeOutMsgFormat{Year: y, Month: int(mth), Day: d, Hour: h, Min: m, Sec: s}
 			msg := events.NewMsg(msgdata)
-			err := dispatcher.PushEvent(nil, events.RouteTimeOut, msg)
+			err := dispatcher.PushEvent(nil, route, msg)
 
 			if err != nil {
 				timer.log.Info("Unable to Push events:", err)
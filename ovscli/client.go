@@ -1,9 +1,11 @@
 package ovscli
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	empty "google.golang.org/protobuf/types/known/emptypb"
 )
 
 var state []string = []string{"", "Waiting", "Starting", "Executing", "Ended OK", "Ended NOT OK", "Hold"}
@@ -29,6 +32,41 @@ type ListResult struct {
 //TicketValue - represents ticket value
 type TicketValue struct {
 	Name, Odate string
+	Keep        bool
+	Origin      string
+	Created     string
+}
+
+//TicketAuditValue - represents a change of a ticket in the audit trail
+type TicketAuditValue struct {
+	Time, Action, Name, Odate, Origin string
+}
+
+//QuantityValue - represents quantitative resource
+type QuantityValue struct {
+	Name           string
+	Capacity, Used int32
+}
+
+//VariableValue - represents variable stored in the variable store
+type VariableValue struct {
+	Scope, Group, Task, Name, Value string
+}
+
+//WorkerValue - represents a worker known to overseer
+type WorkerValue struct {
+	Name, Address, State, Pool, LastSeen string
+	Registered                           bool
+	Capabilities, Tags                   []string
+	Tasks, TasksLimit, Executions        int32
+	Weight, CPU, MemUsed, MemTotal       int32
+	DiskFree                             int64
+}
+
+//LimitValue - represents a concurrency limit
+type LimitValue struct {
+	Kind, Name     string
+	Limit, Running int32
 }
 
 //OverseerClient - holds connection to ovs server
@@ -109,17 +147,43 @@ func (cli *OverseerClient) Authenticate(username, passwd string) (string, error)
 }
 
 //AddTicket - adds a ticket with given name and odate
-func (cli *OverseerClient) AddTicket(name, odate string) (string, error) {
+func (cli *OverseerClient) AddTicket(name, odate string, keep bool) (string, error) {
+
+	if cli.conn == nil {
+		return "", fmt.Errorf("client not connected,connect first")
+	}
+
+	service := services.NewResourceServiceClient(cli.conn)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	scope, name := SplitScope(name)
+	result, err := service.AddTicket(ctx, &services.TicketActionMsg{Name: name, Scope: scope, Odate: odate, Keep: keep})
+	if err != nil {
+		return "", err
+	}
+
+	return result.Message, nil
+}
+
+//AddTickets - adds tickets at once, none of tickets is added if any of them cannot be added
+func (cli *OverseerClient) AddTickets(tickets []TicketValue) (string, error) {
 
 	if cli.conn == nil {
 		return "", fmt.Errorf("client not connected,connect first")
 	}
 
+	msg := &services.TicketBatchMsg{Tickets: make([]*services.TicketActionMsg, 0, len(tickets))}
+	for _, t := range tickets {
+		scope, name := SplitScope(t.Name)
+		msg.Tickets = append(msg.Tickets, &services.TicketActionMsg{Name: name, Scope: scope, Odate: t.Odate, Keep: t.Keep})
+	}
+
 	service := services.NewResourceServiceClient(cli.conn)
 
 	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
 
-	result, err := service.AddTicket(ctx, &services.TicketActionMsg{Name: name, Odate: odate})
+	result, err := service.AddTickets(ctx, msg)
 	if err != nil {
 		return "", err
 	}
@@ -127,6 +191,70 @@ func (cli *OverseerClient) AddTicket(name, odate string) (string, error) {
 	return result.Message, nil
 }
 
+//DeleteTickets - removes tickets that match name and odate patterns, if dryRun is set, matching tickets are only returned.
+//Token identifies tickets that match the patterns, it is returned by a dry run and it is required to remove tickets.
+func (cli *OverseerClient) DeleteTickets(name, odate string, dryRun bool, token string) ([]TicketValue, string, string, error) {
+
+	if cli.conn == nil {
+		return []TicketValue{}, "", "", fmt.Errorf("client not connected,connect first")
+	}
+
+	service := services.NewResourceServiceClient(cli.conn)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	scope, name := SplitScope(name)
+	result, err := service.DeleteTickets(ctx, &services.TicketDeleteMsg{Name: name, Scope: scope, Odate: odate, DryRun: dryRun, Token: token})
+	if err != nil {
+		return []TicketValue{}, "", "", err
+	}
+
+	tickets := make([]TicketValue, 0, len(result.Tickets))
+	for _, t := range result.Tickets {
+		tickets = append(tickets, TicketValue{Name: scopedName(t.Scope, t.Name), Odate: t.Odate, Keep: t.Keep, Origin: ticketOrigin(t.OrderID, t.ExecutionID, t.Username), Created: t.Created})
+	}
+
+	return tickets, result.Token, result.Message, nil
+}
+
+//ReadTickets - reads a list of tickets, each line contains a name of a ticket followed by an optional odate
+//and an optional KEEP keyword. Empty lines and lines that start with # are skipped.
+func ReadTickets(r io.Reader) ([]TicketValue, error) {
+
+	tickets := []TicketValue{}
+	scanner := bufio.NewScanner(r)
+	line := 0
+
+	for scanner.Scan() {
+
+		line++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		ticket := TicketValue{Name: fields[0]}
+		for _, f := range fields[1:] {
+			switch {
+			case strings.EqualFold(f, "KEEP") && !ticket.Keep:
+				ticket.Keep = true
+			case ticket.Odate == "" && !ticket.Keep:
+				ticket.Odate = f
+			default:
+				return nil, fmt.Errorf("line %d: unexpected value:%s", line, f)
+			}
+		}
+
+		tickets = append(tickets, ticket)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return tickets, nil
+}
+
 //DelTicket - removes a ticket
 func (cli *OverseerClient) DelTicket(name, odate string) (string, error) {
 
@@ -137,7 +265,8 @@ func (cli *OverseerClient) DelTicket(name, odate string) (string, error) {
 	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", cli.token)
 	service := services.NewResourceServiceClient(cli.conn)
 
-	result, err := service.DeleteTicket(ctx, &services.TicketActionMsg{Name: name, Odate: odate})
+	scope, name := SplitScope(name)
+	result, err := service.DeleteTicket(ctx, &services.TicketActionMsg{Name: name, Scope: scope, Odate: odate})
 	if err != nil {
 		return "", err
 	}
@@ -154,7 +283,8 @@ func (cli *OverseerClient) CheckTicket(name, odate string) (string, error) {
 	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
 
 	service := services.NewResourceServiceClient(cli.conn)
-	result, err := service.CheckTicket(ctx, &services.TicketActionMsg{Name: name, Odate: odate})
+	scope, name := SplitScope(name)
+	result, err := service.CheckTicket(ctx, &services.TicketActionMsg{Name: name, Scope: scope, Odate: odate})
 	if err != nil {
 		return "", err
 	}
@@ -172,7 +302,8 @@ func (cli *OverseerClient) ListTickets(name, odate string) ([]TicketValue, error
 	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
 
 	service := services.NewResourceServiceClient(cli.conn)
-	r, err := service.ListTickets(ctx, &services.TicketActionMsg{Name: name, Odate: odate})
+	scope, name := SplitScope(name)
+	r, err := service.ListTickets(ctx, &services.TicketActionMsg{Name: name, Scope: scope, Odate: odate})
 	if err != nil {
 		return []TicketValue{}, err
 	}
@@ -185,13 +316,77 @@ func (cli *OverseerClient) ListTickets(name, odate string) ([]TicketValue, error
 		if err == io.EOF {
 			break
 		}
-		result = append(result, TicketValue{Name: t.Name, Odate: t.Odate})
+		result = append(result, TicketValue{Name: scopedName(t.Scope, t.Name), Odate: t.Odate, Keep: t.Keep, Origin: ticketOrigin(t.OrderID, t.ExecutionID, t.Username), Created: t.Created})
 
 	}
 
 	return result, nil
 }
 
+//TicketAudit - returns additions and removals of tickets
+func (cli *OverseerClient) TicketAudit(name, odate string) ([]TicketAuditValue, error) {
+
+	var result = []TicketAuditValue{}
+	if cli.conn == nil {
+		return []TicketAuditValue{}, fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewResourceServiceClient(cli.conn)
+	scope, name := SplitScope(name)
+	r, err := service.TicketAudit(ctx, &services.TicketActionMsg{Name: name, Scope: scope, Odate: odate})
+	if err != nil {
+		return []TicketAuditValue{}, err
+	}
+
+	for {
+		e, err := r.Recv()
+		if err != nil && err != io.EOF {
+			return []TicketAuditValue{}, err
+		}
+		if err == io.EOF {
+			break
+		}
+		result = append(result, TicketAuditValue{Time: e.Time, Action: e.Action, Name: scopedName(e.Scope, e.Name), Odate: e.Odate, Origin: ticketOrigin(e.OrderID, e.ExecutionID, e.Username)})
+	}
+
+	return result, nil
+}
+
+//ticketOrigin - returns a description of a task or a user that changed a ticket
+func ticketOrigin(orderID, executionID, username string) string {
+
+	if orderID != "" {
+		return fmt.Sprintf("task:%s execution:%s", orderID, executionID)
+	}
+	if username != "" {
+		return fmt.Sprintf("user:%s", username)
+	}
+	return "unknown"
+}
+
+//SplitScope - splits a name qualified with a namespace (NAMESPACE:NAME) into a namespace and a name,
+//a name without a namespace refers to the global namespace
+func SplitScope(name string) (string, string) {
+
+	if idx := strings.Index(name, ":"); idx != -1 {
+		return name[:idx], name[idx+1:]
+	}
+
+	return "", name
+}
+
+//scopedName - returns a name qualified with a namespace
+func scopedName(scope, name string) string {
+
+	if scope == "" {
+		return name
+	}
+
+	return scope + ":" + name
+}
+
 //SetFlag - sets a new flag or change current flag
 func (cli *OverseerClient) SetFlag(name string, value int32) (string, error) {
 
@@ -202,7 +397,8 @@ func (cli *OverseerClient) SetFlag(name string, value int32) (string, error) {
 	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
 
 	service := services.NewResourceServiceClient(cli.conn)
-	result, err := service.SetFlag(ctx, &services.FlagActionMsg{Name: name, State: value})
+	scope, name := SplitScope(name)
+	result, err := service.SetFlag(ctx, &services.FlagActionMsg{Name: name, Scope: scope, State: value})
 	if err != nil {
 		return "", err
 	}
@@ -219,7 +415,129 @@ func (cli *OverseerClient) DestroyFlag(name string) (string, error) {
 	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
 
 	service := services.NewResourceServiceClient(cli.conn)
-	result, err := service.DestroyFlag(ctx, &services.FlagActionMsg{Name: name})
+	scope, name := SplitScope(name)
+	result, err := service.DestroyFlag(ctx, &services.FlagActionMsg{Name: name, Scope: scope})
+	if err != nil {
+		return "", err
+	}
+
+	return result.Message, nil
+}
+
+//ReleaseFlagHolder - forcefully releases a flag held by a task
+func (cli *OverseerClient) ReleaseFlagHolder(name, holder string) (string, error) {
+
+	if cli.conn == nil {
+		return "", fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewResourceServiceClient(cli.conn)
+	scope, name := SplitScope(name)
+	result, err := service.ReleaseFlagHolder(ctx, &services.FlagHolderMsg{Name: name, Scope: scope, Holder: holder})
+	if err != nil {
+		return "", err
+	}
+
+	return result.Message, nil
+}
+
+//SetQuantity - creates a quantitative resource or changes its capacity
+func (cli *OverseerClient) SetQuantity(name string, capacity int32) (string, error) {
+
+	if cli.conn == nil {
+		return "", fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewResourceServiceClient(cli.conn)
+	result, err := service.SetQuantity(ctx, &services.QuantityActionMsg{Name: name, Capacity: capacity})
+	if err != nil {
+		return "", err
+	}
+
+	return result.Message, nil
+}
+
+//DeleteQuantity - removes a quantitative resource
+func (cli *OverseerClient) DeleteQuantity(name string) (string, error) {
+
+	if cli.conn == nil {
+		return "", fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewResourceServiceClient(cli.conn)
+	result, err := service.DeleteQuantity(ctx, &services.QuantityActionMsg{Name: name})
+	if err != nil {
+		return "", err
+	}
+
+	return result.Message, nil
+}
+
+//ListQuantities - returns a list of quantitative resources
+func (cli *OverseerClient) ListQuantities(name string) ([]QuantityValue, error) {
+
+	var result = []QuantityValue{}
+	if cli.conn == nil {
+		return []QuantityValue{}, fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewResourceServiceClient(cli.conn)
+	r, err := service.ListQuantities(ctx, &services.QuantityActionMsg{Name: name})
+	if err != nil {
+		return []QuantityValue{}, err
+	}
+
+	for {
+		v, err := r.Recv()
+		if err != nil && err != io.EOF {
+			return []QuantityValue{}, err
+		}
+		if err == io.EOF {
+			break
+		}
+		result = append(result, QuantityValue{Name: v.Name, Capacity: v.Capacity, Used: v.Used})
+	}
+
+	return result, nil
+}
+
+//SetVariable - sets a variable in a global, group or task scope
+func (cli *OverseerClient) SetVariable(scope, group, task, name, value string) (string, error) {
+
+	if cli.conn == nil {
+		return "", fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewResourceServiceClient(cli.conn)
+	result, err := service.SetVariable(ctx, &services.VariableActionMsg{Scope: scope, Group: group, Task: task, Name: name, Value: value})
+	if err != nil {
+		return "", err
+	}
+
+	return result.Message, nil
+}
+
+//DeleteVariable - removes a variable from the variable store
+func (cli *OverseerClient) DeleteVariable(scope, group, task, name string) (string, error) {
+
+	if cli.conn == nil {
+		return "", fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewResourceServiceClient(cli.conn)
+	result, err := service.DeleteVariable(ctx, &services.VariableActionMsg{Scope: scope, Group: group, Task: task, Name: name})
 	if err != nil {
 		return "", err
 	}
@@ -227,8 +545,39 @@ func (cli *OverseerClient) DestroyFlag(name string) (string, error) {
 	return result.Message, nil
 }
 
-//OrderTask - orders a task definition to active task pool
-func (cli *OverseerClient) OrderTask(group, name, odate string, force bool) (string, error) {
+//ListVariables - returns a list of variables
+func (cli *OverseerClient) ListVariables(scope, group, task, name string) ([]VariableValue, error) {
+
+	var result = []VariableValue{}
+	if cli.conn == nil {
+		return []VariableValue{}, fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewResourceServiceClient(cli.conn)
+	r, err := service.ListVariables(ctx, &services.VariableActionMsg{Scope: scope, Group: group, Task: task, Name: name})
+	if err != nil {
+		return []VariableValue{}, err
+	}
+
+	for {
+		v, err := r.Recv()
+		if err != nil && err != io.EOF {
+			return []VariableValue{}, err
+		}
+		if err == io.EOF {
+			break
+		}
+		result = append(result, VariableValue{Scope: v.Scope, Group: v.Group, Task: v.Task, Name: v.Name, Value: v.Value})
+	}
+
+	return result, nil
+}
+
+//OrderTask - orders a task definition to active task pool, variables override variables from the definition
+//and a priority greater than zero overrides a priority from the definition
+func (cli *OverseerClient) OrderTask(group, name, odate string, force bool, variables map[string]string, priority int32) (string, error) {
 
 	if cli.conn == nil {
 		return "", fmt.Errorf("client not connected,connect first")
@@ -242,9 +591,9 @@ func (cli *OverseerClient) OrderTask(group, name, odate string, force bool) (str
 	service := services.NewTaskServiceClient(cli.conn)
 
 	if force {
-		result, err = service.ForceTask(ctx, &services.TaskOrderMsg{TaskGroup: group, TaskName: name, Odate: odate})
+		result, err = service.ForceTask(ctx, &services.TaskOrderMsg{TaskGroup: group, TaskName: name, Odate: odate, Variables: variables, Priority: priority})
 	} else {
-		result, err = service.OrderTask(ctx, &services.TaskOrderMsg{TaskGroup: group, TaskName: name, Odate: odate})
+		result, err = service.OrderTask(ctx, &services.TaskOrderMsg{TaskGroup: group, TaskName: name, Odate: odate, Variables: variables, Priority: priority})
 	}
 
 	if err == nil {
@@ -321,6 +670,22 @@ func (cli *OverseerClient) TaskDetail(orderID string) (string, error) {
 		fmt.Sprintf("End Time:%s", result.EndTime),
 	}
 
+	for _, w := range strings.Split(result.BaseData.Waiting, ";") {
+		if w != "" {
+			details = append(details, fmt.Sprintf("Waiting:%s", w))
+		}
+	}
+
+	names := make([]string, 0, len(result.Variables))
+	for n := range result.Variables {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	for _, n := range names {
+		details = append(details, fmt.Sprintf("Variable:%s=%s", n, result.Variables[n]))
+	}
+
 	return strings.Join(details, "\n"), nil
 
 }
@@ -414,3 +779,238 @@ func (cli *OverseerClient) Free(orderID string) (string, error) {
 
 	return result.Message, nil
 }
+
+//NewDayProcedure - runs the new day procedure for a given odate
+func (cli *OverseerClient) NewDayProcedure(odate string) (string, error) {
+
+	if cli.conn == nil {
+		return "", fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewAdministrationServiceClient(cli.conn)
+	result, err := service.NewDayProcedure(ctx, &services.NewDayProcMsg{Odate: odate})
+	if err != nil {
+		return "", err
+	}
+
+	return result.Message, nil
+}
+
+//SetSecret - stores a secret with a given value
+func (cli *OverseerClient) SetSecret(name, value string) (string, error) {
+
+	if cli.conn == nil {
+		return "", fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewAdministrationServiceClient(cli.conn)
+	result, err := service.SetSecret(ctx, &services.SecretMsg{Name: name, Value: value})
+	if err != nil {
+		return "", err
+	}
+
+	return result.Message, nil
+}
+
+//DeleteSecret - removes a secret
+func (cli *OverseerClient) DeleteSecret(name string) (string, error) {
+
+	if cli.conn == nil {
+		return "", fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewAdministrationServiceClient(cli.conn)
+	result, err := service.DeleteSecret(ctx, &services.SecretMsg{Name: name})
+	if err != nil {
+		return "", err
+	}
+
+	return result.Message, nil
+}
+
+//ListSecrets - returns names of secrets that match a given filter
+func (cli *OverseerClient) ListSecrets(filter string) ([]string, error) {
+
+	if cli.conn == nil {
+		return nil, fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewAdministrationServiceClient(cli.conn)
+	result, err := service.ListSecrets(ctx, &services.FilterMsg{Filter: filter})
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, e := range result.Entity {
+		names = append(names, e.Name)
+	}
+
+	return names, nil
+}
+
+//ReconcileResources - recomputes the state of flags and quantitative resources from the active pool, returns changes that were made
+func (cli *OverseerClient) ReconcileResources() ([]string, error) {
+
+	if cli.conn == nil {
+		return nil, fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewAdministrationServiceClient(cli.conn)
+	result, err := service.ReconcileResources(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	fixes := []string{}
+	for _, e := range result.Entity {
+		fixes = append(fixes, e.Name)
+	}
+
+	return fixes, nil
+}
+
+//ListWorkers - returns workers whose names match a given filter
+func (cli *OverseerClient) ListWorkers(filter string) ([]WorkerValue, error) {
+
+	if cli.conn == nil {
+		return nil, fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewAdministrationServiceClient(cli.conn)
+	result, err := service.ListWorkers(ctx, &services.FilterMsg{Filter: filter})
+	if err != nil {
+		return nil, err
+	}
+
+	workers := []WorkerValue{}
+	for _, w := range result.Workers {
+		workers = append(workers, WorkerValue{
+			Name:         w.Name,
+			Address:      w.Address,
+			State:        w.State,
+			Pool:         w.Pool,
+			LastSeen:     w.LastSeen,
+			Registered:   w.Registered,
+			Capabilities: w.Capabilities,
+			Tags:         w.Tags,
+			Tasks:        w.Tasks,
+			TasksLimit:   w.TasksLimit,
+			Executions:   w.Executions,
+			Weight:       w.Weight,
+			CPU:          w.Cpuload,
+			MemUsed:      w.Memused,
+			MemTotal:     w.Memtotal,
+			DiskFree:     w.Diskfree,
+		})
+	}
+
+	return workers, nil
+}
+
+//DrainWorker - stops sending new tasks to a worker
+func (cli *OverseerClient) DrainWorker(name string) (string, error) {
+
+	if cli.conn == nil {
+		return "", fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewAdministrationServiceClient(cli.conn)
+	result, err := service.DrainWorker(ctx, &services.WorkerMsg{Name: name})
+	if err != nil {
+		return "", err
+	}
+
+	return result.Message, nil
+}
+
+//UndrainWorker - resumes sending new tasks to a drained worker
+func (cli *OverseerClient) UndrainWorker(name string) (string, error) {
+
+	if cli.conn == nil {
+		return "", fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewAdministrationServiceClient(cli.conn)
+	result, err := service.UndrainWorker(ctx, &services.WorkerMsg{Name: name})
+	if err != nil {
+		return "", err
+	}
+
+	return result.Message, nil
+}
+
+//RemoveWorker - removes a worker that does not execute any task
+func (cli *OverseerClient) RemoveWorker(name string) (string, error) {
+
+	if cli.conn == nil {
+		return "", fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewAdministrationServiceClient(cli.conn)
+	result, err := service.RemoveWorker(ctx, &services.WorkerMsg{Name: name})
+	if err != nil {
+		return "", err
+	}
+
+	return result.Message, nil
+}
+
+//ListConcurrencyLimits - returns concurrency limits with numbers of executed tasks
+func (cli *OverseerClient) ListConcurrencyLimits() ([]LimitValue, error) {
+
+	if cli.conn == nil {
+		return nil, fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewAdministrationServiceClient(cli.conn)
+	result, err := service.ListConcurrencyLimits(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	limits := []LimitValue{}
+	for _, l := range result.Limits {
+		limits = append(limits, LimitValue{Kind: l.Kind, Name: l.Name, Limit: l.Limit, Running: l.Running})
+	}
+
+	return limits, nil
+}
+
+//SetConcurrencyLimit - sets a maximum number of tasks executed at the same time
+func (cli *OverseerClient) SetConcurrencyLimit(kind, name string, limit int32) (string, error) {
+
+	if cli.conn == nil {
+		return "", fmt.Errorf("client not connected,connect first")
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", cli.token)
+
+	service := services.NewAdministrationServiceClient(cli.conn)
+	result, err := service.SetConcurrencyLimit(ctx, &services.ConcurrencyLimitMsg{Kind: kind, Name: name, Limit: limit})
+	if err != nil {
+		return "", err
+	}
+
+	return result.Message, nil
+}
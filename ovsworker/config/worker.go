@@ -17,15 +17,28 @@ type WorkerConfiguration struct {
 	Port             int                           `json:"port" validate:"min=1024,max=65535,required"`
 	SysoutDirectory  string                        `json:"sysoutDirectory" validate:"required"`
 	TaskLimit        int                           `json:"taskLimit" validate:"min=0,max=128"`
+	ExecRetention    int                           `json:"executionRetention" validate:"min=0"`
 	SecurityLevel    types.ConnectionSecurityLevel `json:"securityLevel" validate:"oneof=none server clientandserver"`
 	WorkerCert       string                        `json:"cert"`
 	WorkerKey        string                        `json:"key"`
 	WorkerCertPolicy types.CertPolicy              `json:"overseerCertPolicy" validate:"oneof=none required verify"`
 	OverseerCA       string                        `json:"overseerCA"`
+	Registration     RegistrationConfiguration     `json:"registration"`
 	RootDirectory    string
 	ProcessDirectory string
 }
 
+//RegistrationConfiguration - Holds settings of a registration in overseer, a worker does not register itself if the host is empty.
+//A connection with overseer uses the same security settings and certificates as a worker.
+type RegistrationConfiguration struct {
+	OverseerHost string   `json:"overseerHost"`
+	OverseerPort int      `json:"overseerPort" validate:"required_with=OverseerHost,omitempty,min=1024,max=65535"`
+	Token        string   `json:"token"`
+	Pool         string   `json:"pool" validate:"omitempty,max=32"`
+	Tags         []string `json:"tags" validate:"omitempty,dive,required,max=32"`
+	Weight       int      `json:"weight" validate:"min=0,max=100"`
+}
+
 //OverseerWorkerConfiguration - configuration
 type OverseerWorkerConfiguration struct {
 	Worker    WorkerConfiguration        `json:"worker"`
@@ -3,12 +3,10 @@ package fragments
 import (
 	"errors"
 	"fmt"
-	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
-	"sync"
 
 	"github.com/przebro/overseer/common/logger"
 	"github.com/przebro/overseer/common/types"
@@ -32,7 +30,7 @@ type osJob struct {
 	Command    string
 	Arguments  []string
 	RunAs      string
-	stdout     io.ReadCloser
+	stdout     *os.File
 	cancelFunc context.CancelFunc
 	log        logger.AppLogger
 }
@@ -86,12 +84,15 @@ func (j *osJob) StartJob(ctx context.Context, stat chan status.JobExecutionStatu
 		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
 	}
 
-	j.stdout, err = cmd.StdoutPipe()
+	//an output is written directly to a file, so the process is not affected by a restart of a worker
+	j.stdout, err = os.Create(filepath.Join(j.SysoutDir, j.ExecutionID))
 	if err != nil {
 		j.log.Desugar().Error("StartJob", zap.String("error", err.Error()))
 		return status.StatusFailed(j.TaskID, j.ExecutionID, err.Error())
 
 	}
+	cmd.Stdout = j.stdout
+
 	go j.run(cmd, stat)
 
 	return status.StatusExecuting(j.TaskID, j.ExecutionID)
@@ -112,6 +113,8 @@ func (j *osJob) CancelJob() error {
 
 func (j *osJob) run(cmd *exec.Cmd, stat chan status.JobExecutionStatus) {
 
+	defer j.stdout.Close()
+
 	err := cmd.Start()
 
 	if err != nil {
@@ -121,9 +124,11 @@ func (j *osJob) run(cmd *exec.Cmd, stat chan status.JobExecutionStatus) {
 		return
 	}
 
-	fpath := filepath.Join(j.SysoutDir, j.ExecutionID)
+	//a PID is reported as soon as the process starts, so a worker can re-attach to it after restart
+	running := status.StatusExecuting(j.TaskID, j.ExecutionID)
+	running.PID = cmd.Process.Pid
+	stat <- running
 
-	stdout(j.stdout, fpath, j.log)
 	cmd.Wait()
 
 	stat <- status.StatusEnded(j.TaskID, j.ExecutionID, cmd.ProcessState.ExitCode(), cmd.ProcessState.Pid(), 0)
@@ -138,35 +143,3 @@ func (j *osJob) JobTaskID() string {
 func (j *osJob) JobExecutionID() string {
 	return j.ExecutionID
 }
-
-func stdout(out io.ReadCloser, path string, log logger.AppLogger) struct{} {
-
-	wait := sync.WaitGroup{}
-	file, err := os.Create(path)
-	if err != nil {
-		log.Desugar().Error("stdout", zap.String("error", err.Error()))
-		return struct{}{}
-	}
-
-	wait.Add(1)
-	go func(f *os.File) {
-		for {
-			buff := make([]byte, 1024)
-			bytes, err := out.Read(buff)
-			if err == io.EOF {
-				break
-			}
-			if err != nil {
-				f.WriteString(err.Error())
-				break
-			}
-
-			f.Write(buff[:bytes])
-		}
-
-		wait.Done()
-	}(file)
-
-	wait.Wait()
-	return struct{}{}
-}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/przebro/overseer/common/logger"
 	"github.com/przebro/overseer/common/types"
@@ -20,6 +21,18 @@ func RegisterFactory(t types.TaskType, fm JobFactorytMethod) {
 	factories[t] = fm
 }
 
+//Types - returns task types for which factories are registered
+func Types() []string {
+
+	result := []string{}
+	for t := range factories {
+		result = append(result, string(t))
+	}
+	sort.Strings(result)
+
+	return result
+}
+
 //NewJobExecutor - Creates a new job that will be executed
 func NewJobExecutor(header msgheader.TaskHeader, sysoutDir string, data []byte, log logger.AppLogger) (JobExecutor, error) {
 
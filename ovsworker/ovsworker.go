@@ -16,6 +16,7 @@ import (
 type ovsWorker struct {
 	conf          config.OverseerWorkerConfiguration
 	grpcComponent core.OverseerComponent
+	registrar     *services.Registrar
 	logger        logger.AppLogger
 	wservices.UnimplementedTaskExecutionServiceServer
 }
@@ -36,6 +37,12 @@ func NewWorkerService(config config.OverseerWorkerConfiguration, log logger.AppL
 		conf:          config,
 	}
 
+	if config.Worker.Registration.OverseerHost != "" {
+		if wserver.registrar, err = services.NewRegistrar(config.Worker, log); err != nil {
+			return nil, err
+		}
+	}
+
 	return wserver, nil
 }
 
@@ -45,7 +52,7 @@ func createServiceServer(conf config.OverseerWorkerConfiguration, log logger.App
 		return nil, fmt.Errorf("filepath is not absolute:%s", conf.Worker.SysoutDirectory)
 	}
 
-	srvc, err := services.NewWorkerExecutionService(conf.Worker.SysoutDirectory, conf.Worker.TaskLimit, log)
+	srvc, err := services.NewWorkerExecutionService(conf.Worker.SysoutDirectory, conf.Worker.TaskLimit, conf.Worker.ExecRetention, log)
 	if err != nil {
 		return nil, err
 	}
@@ -60,11 +67,19 @@ func createServiceServer(conf config.OverseerWorkerConfiguration, log logger.App
 
 func (wsrvc *ovsWorker) Start() error {
 
+	if wsrvc.registrar != nil {
+		wsrvc.registrar.Start()
+	}
+
 	return wsrvc.grpcComponent.Start()
 }
 
 func (wsrvc *ovsWorker) Shutdown() error {
 
+	if wsrvc.registrar != nil {
+		wsrvc.registrar.Shutdown()
+	}
+
 	return wsrvc.grpcComponent.Shutdown()
 }
 
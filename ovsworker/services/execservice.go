@@ -7,12 +7,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/przebro/overseer/common/logger"
 	"github.com/przebro/overseer/common/types"
 
 	"github.com/przebro/overseer/ovsworker/jobs"
 	"github.com/przebro/overseer/ovsworker/msgheader"
+	jstatus "github.com/przebro/overseer/ovsworker/status"
 	"github.com/przebro/overseer/ovsworker/task"
 	"github.com/przebro/overseer/proto/wservices"
 
@@ -49,7 +51,7 @@ type workerExecutionService struct {
 }
 
 //NewWorkerExecutionService - creates a new instance of a workerExecutionService
-func NewWorkerExecutionService(sysoutDir string, limit int, log logger.AppLogger) (*workerExecutionService, error) {
+func NewWorkerExecutionService(sysoutDir string, limit int, retention int, log logger.AppLogger) (*workerExecutionService, error) {
 
 	var sysout string
 	var err error
@@ -77,7 +79,9 @@ func NewWorkerExecutionService(sysoutDir string, limit int, log logger.AppLogger
 		taskLimit: limit,
 	}
 
-	wservice.te = task.NewTaskRunnerManager()
+	if wservice.te, err = task.NewDurableTaskRunnerManager(task.NewFileStore(filepath.Join(sysout, task.ExecutionDirectory)), time.Duration(retention)*time.Hour, log); err != nil {
+		return nil, err
+	}
 
 	return wservice, nil
 }
@@ -165,18 +169,50 @@ func (wsrvc *workerExecutionService) TaskStatus(ctx context.Context, msg *wservi
 
 	wsrvc.log.Desugar().Info("TaskStatus", zap.Object("payload", &result))
 
-	response = &wservices.TaskExecutionResponseMsg{
+	response = wsrvc.statusResponse(result, num)
 
+	return response, nil
+
+}
+
+func (wsrvc *workerExecutionService) statusResponse(result jstatus.JobExecutionStatus, tasks int) *wservices.TaskExecutionResponseMsg {
+
+	return &wservices.TaskExecutionResponseMsg{
 		Status:     statusMap[result.State],
 		ReturnCode: int32(result.ReturnCode),
 		StatusCode: int32(result.StatusCode),
+		Reason:     result.Reason,
 		Pid:        int32(result.PID),
 		TasksLimit: int32(wsrvc.taskLimit),
-		Tasks:      int32(num),
+		Tasks:      int32(tasks),
 	}
+}
 
-	return response, nil
+//TaskStatusUpdates - sends changes of statuses of executions until overseer closes the stream
+func (wsrvc *workerExecutionService) TaskStatusUpdates(msg *empty.Empty, stream wservices.TaskExecutionService_TaskStatusUpdatesServer) error {
 
+	updates, stop := wsrvc.te.Watch()
+	defer stop()
+
+	wsrvc.log.Desugar().Info("TaskStatusUpdates", zap.String("descr", "overseer is watching statuses"))
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case result := <-updates:
+
+			msg := &wservices.TaskStatusUpdateMsg{
+				TaskID: &wservices.TaskIdMsg{TaskID: result.TaskID, ExecutionID: result.ExecutionID},
+				Status: wsrvc.statusResponse(result, wsrvc.te.TaskCount()),
+			}
+
+			if err := stream.Send(msg); err != nil {
+				wsrvc.log.Desugar().Error("TaskStatusUpdates", zap.String("error", err.Error()))
+				return err
+			}
+		}
+	}
 }
 
 func (wsrvc *workerExecutionService) TerminateTask(context.Context, *wservices.TaskIdMsg) (*wservices.WorkerActionMsg, error) {
@@ -202,7 +238,19 @@ func (wsrvc *workerExecutionService) WorkerStatus(ctx context.Context, msg *empt
 
 	num := wsrvc.te.TaskCount()
 
-	response := &wservices.WorkerStatusResponseMsg{Tasks: int32(num), TasksLimit: int32(wsrvc.taskLimit), Memused: 0, Memtotal: 0, Cpuload: 0}
+	info, err := readSystemInfo(procDir, wsrvc.sysoutDir)
+	if err != nil {
+		wsrvc.log.Desugar().Debug("WorkerStatus", zap.String("error", err.Error()))
+	}
+
+	response := &wservices.WorkerStatusResponseMsg{
+		Tasks:      int32(num),
+		TasksLimit: int32(wsrvc.taskLimit),
+		Memused:    int32(info.memused),
+		Memtotal:   int32(info.memtotal),
+		Cpuload:    int32(info.cpuload),
+		Diskfree:   info.diskfree,
+	}
 
 	return response, nil
 
@@ -13,6 +13,7 @@ import (
 	"github.com/przebro/overseer/proto/actions"
 	"github.com/przebro/overseer/proto/wservices"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -33,24 +34,24 @@ func init() {
 
 }
 func TestCreateInstance(t *testing.T) {
-	inst, _ := NewWorkerExecutionService("../../data/tests/sysout", 0, lg)
+	inst, _ := NewWorkerExecutionService("../../data/tests/sysout", 0, 0, lg)
 	if inst == nil {
 		t.Error("create instance")
 	}
 
-	_, err := NewWorkerExecutionService("../../data/tests/tasks.json", 0, lg)
+	_, err := NewWorkerExecutionService("../../data/tests/tasks.json", 0, 0, lg)
 	if err == nil {
 		t.Error("create instance")
 	}
 
-	_, err = NewWorkerExecutionService("../../data/not_exists/sysout", 0, lg)
+	_, err = NewWorkerExecutionService("../../data/not_exists/sysout", 0, 0, lg)
 	if err == nil {
 		t.Error("create instance")
 	}
 
 	s, _ := os.Getwd()
 
-	inst, _ = NewWorkerExecutionService(s, 0, lg)
+	inst, _ = NewWorkerExecutionService(s, 0, 0, lg)
 	if inst == nil {
 		t.Error("create instance:", err)
 	}
@@ -324,8 +325,9 @@ func TestWorkerStatus(t *testing.T) {
 		t.Error(err)
 	}
 
-	if status.Tasks != 1 {
-		t.Error("status Tasks, invalid number, expected 1")
+	//a dummy task ends immediately, finished executions are not counted as running tasks
+	if status.Tasks != 0 {
+		t.Error("status Tasks, invalid number, expected 0,actual:", status.Tasks)
 	}
 
 }
@@ -361,3 +363,55 @@ func TestSerializeMap(t *testing.T) {
 	data, _ := json.Marshal(&custom)
 	fmt.Println(string(data))
 }
+
+type mockStatusStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *wservices.TaskStatusUpdateMsg
+}
+
+func (m *mockStatusStream) Context() context.Context { return m.ctx }
+func (m *mockStatusStream) Send(msg *wservices.TaskStatusUpdateMsg) error {
+	m.sent <- msg
+	return nil
+}
+
+func TestTaskStatusUpdates(t *testing.T) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockStatusStream{ctx: ctx, sent: make(chan *wservices.TaskStatusUpdateMsg, 10)}
+
+	done := make(chan error)
+	go func() { done <- exservice.TaskStatusUpdates(&empty.Empty{}, stream) }()
+
+	//waits until the stream is watching
+	time.Sleep(100 * time.Millisecond)
+
+	cmd, _ := anypb.New(&actions.DummyTaskAction{Data: "testdata"})
+	msg := &wservices.StartTaskMsg{
+		TaskID:    &wservices.TaskIdMsg{TaskID: "00020", ExecutionID: "1234020"},
+		Type:      "dummy",
+		Variables: map[string]string{},
+		Command:   cmd,
+	}
+
+	if _, err := exservice.StartTask(context.Background(), msg); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case update := <-stream.sent:
+		if update.TaskID.ExecutionID != "1234020" || update.Status.Status != wservices.TaskExecutionResponseMsg_ENDED {
+			t.Error("unexpected result:", update)
+		}
+	case <-time.After(2 * time.Second):
+		t.Error("status was not pushed")
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Error("unexpected result:", err)
+	}
+
+	exservice.te.CleanupTask("1234020")
+}
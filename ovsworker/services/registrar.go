@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/przebro/overseer/common/cert"
+	"github.com/przebro/overseer/common/logger"
+	"github.com/przebro/overseer/common/types"
+	"github.com/przebro/overseer/ovsworker/config"
+	"github.com/przebro/overseer/ovsworker/jobs"
+	"github.com/przebro/overseer/proto/wservices"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+//retryInterval - interval between attempts of a registration
+const retryInterval = 5 * time.Second
+
+//Registrar - registers a worker in overseer and sends heartbeats
+type Registrar struct {
+	conf   config.WorkerConfiguration
+	client wservices.WorkerRegistrationServiceClient
+	log    logger.AppLogger
+	done   chan struct{}
+}
+
+//NewRegistrar - creates a new instance of a Registrar, a connection with overseer is established when a worker registers itself
+func NewRegistrar(conf config.WorkerConfiguration, log logger.AppLogger) (*Registrar, error) {
+
+	opt := []grpc.DialOption{}
+
+	if conf.SecurityLevel == types.ConnectionSecurityLevelNone {
+		opt = append(opt, grpc.WithInsecure())
+	} else {
+		creds, err := cert.BuildClientCredentials(conf.WorkerCert, conf.WorkerKey, conf.WorkerCertPolicy, conf.SecurityLevel)
+		if err != nil {
+			return nil, err
+		}
+		opt = append(opt, creds)
+	}
+
+	target := fmt.Sprintf("%s:%d", conf.Registration.OverseerHost, conf.Registration.OverseerPort)
+	conn, err := grpc.Dial(target, opt...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Registrar{conf: conf, client: wservices.NewWorkerRegistrationServiceClient(conn), log: log, done: make(chan struct{})}, nil
+}
+
+//Start - starts a registration and heartbeats
+func (r *Registrar) Start() {
+	go r.run()
+}
+
+//Shutdown - stops heartbeats
+func (r *Registrar) Shutdown() {
+	close(r.done)
+}
+
+func (r *Registrar) run() {
+
+	registered := false
+	interval := retryInterval
+
+	for {
+		if !registered {
+			if heartbeat, err := r.register(); err != nil {
+				r.log.Desugar().Error("register", zap.String("error", err.Error()))
+				interval = retryInterval
+			} else {
+				registered = true
+				interval = heartbeat
+			}
+		} else {
+			if ok, err := r.heartbeat(); err != nil {
+				r.log.Desugar().Error("heartbeat", zap.String("error", err.Error()))
+			} else if !ok {
+				//overseer does not know this worker, e.g. after restart or removal
+				r.log.Desugar().Warn("heartbeat", zap.String("error", "worker not registered"))
+				registered = false
+				continue
+			}
+		}
+
+		select {
+		case <-r.done:
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (r *Registrar) register() (time.Duration, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), retryInterval)
+	defer cancel()
+
+	msg := &wservices.WorkerRegistrationMsg{
+		Name:         r.conf.Name,
+		Host:         r.conf.Host,
+		Port:         int32(r.conf.Port),
+		TasksLimit:   int32(r.conf.TaskLimit),
+		Capabilities: jobs.Types(),
+		Pool:         r.conf.Registration.Pool,
+		Tags:         r.conf.Registration.Tags,
+		Weight:       int32(r.conf.Registration.Weight),
+		Token:        r.conf.Registration.Token,
+	}
+
+	resp, err := r.client.Register(ctx, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	r.log.Desugar().Info("register", zap.String("message", resp.Message), zap.Int32("heartbeat", resp.Heartbeat))
+
+	if resp.Heartbeat <= 0 {
+		return retryInterval, nil
+	}
+
+	return time.Duration(resp.Heartbeat) * time.Second, nil
+}
+
+func (r *Registrar) heartbeat() (bool, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), retryInterval)
+	defer cancel()
+
+	resp, err := r.client.Heartbeat(ctx, &wservices.WorkerHeartbeatMsg{Name: r.conf.Name, Token: r.conf.Registration.Token})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.Registered, nil
+}
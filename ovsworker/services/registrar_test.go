@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/przebro/overseer/ovsworker/config"
+	"github.com/przebro/overseer/proto/wservices"
+
+	"google.golang.org/grpc"
+)
+
+type mockRegistrationClient struct {
+	lock       sync.Mutex
+	registered []*wservices.WorkerRegistrationMsg
+	heartbeats int
+	known      bool
+	fail       bool
+}
+
+func (m *mockRegistrationClient) Register(ctx context.Context, in *wservices.WorkerRegistrationMsg, opts ...grpc.CallOption) (*wservices.WorkerRegistrationResponseMsg, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.fail {
+		return nil, errors.New("unavailable")
+	}
+	m.registered = append(m.registered, in)
+	m.known = true
+
+	return &wservices.WorkerRegistrationResponseMsg{Success: true, Heartbeat: 1}, nil
+}
+
+func (m *mockRegistrationClient) Heartbeat(ctx context.Context, in *wservices.WorkerHeartbeatMsg, opts ...grpc.CallOption) (*wservices.WorkerHeartbeatResponseMsg, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.heartbeats++
+	return &wservices.WorkerHeartbeatResponseMsg{Registered: m.known}, nil
+}
+
+func TestRegistrar(t *testing.T) {
+
+	conf := config.WorkerConfiguration{Name: "worker_02", Host: "127.0.0.2", Port: 7055, TaskLimit: 4,
+		Registration: config.RegistrationConfiguration{Token: "join-token", Pool: "aws", Tags: []string{"credentials"}},
+	}
+
+	client := &mockRegistrationClient{}
+	r := &Registrar{conf: conf, client: client, log: lg, done: make(chan struct{})}
+
+	heartbeat, err := r.register()
+	if err != nil || heartbeat != time.Second {
+		t.Fatal("unexpected result:", heartbeat, err)
+	}
+
+	msg := client.registered[0]
+	if msg.Name != "worker_02" || msg.Port != 7055 || msg.TasksLimit != 4 || msg.Token != "join-token" || msg.Pool != "aws" {
+		t.Error("unexpected registration:", msg)
+	}
+
+	if len(msg.Capabilities) != 3 || msg.Capabilities[0] != "aws" || msg.Capabilities[1] != "dummy" || msg.Capabilities[2] != "os" {
+		t.Error("unexpected capabilities:", msg.Capabilities)
+	}
+
+	if ok, err := r.heartbeat(); !ok || err != nil {
+		t.Error("unexpected result:", ok, err)
+	}
+
+	client.known = false
+	if ok, err := r.heartbeat(); ok || err != nil {
+		t.Error("unexpected result:", ok, err)
+	}
+
+	client.fail = true
+	if _, err := r.register(); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestRegistrar_Reregister(t *testing.T) {
+
+	client := &mockRegistrationClient{}
+	r := &Registrar{conf: config.WorkerConfiguration{Name: "worker_02"}, client: client, log: lg, done: make(chan struct{})}
+
+	r.Start()
+	time.Sleep(1500 * time.Millisecond)
+
+	client.lock.Lock()
+	client.known = false
+	client.lock.Unlock()
+
+	time.Sleep(1500 * time.Millisecond)
+	r.Shutdown()
+
+	client.lock.Lock()
+	defer client.lock.Unlock()
+
+	if len(client.registered) != 2 || client.heartbeats == 0 {
+		t.Error("unexpected result:", len(client.registered), client.heartbeats)
+	}
+}
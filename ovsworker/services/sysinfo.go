@@ -0,0 +1,105 @@
+package services
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+//procDir - a directory of the proc filesystem
+const procDir = "/proc"
+
+//systemInfo - a load of a host on which a worker runs
+type systemInfo struct {
+	cpuload  int
+	memused  int
+	memtotal int
+	diskfree int64
+}
+
+//readSystemInfo - reads a load average, a memory usage and a free space of a filesystem of a sysout directory.
+//A value that can't be read is left as zero and the first error is returned.
+func readSystemInfo(proc, sysoutDir string) (systemInfo, error) {
+
+	info := systemInfo{}
+	var result error
+
+	keep := func(err error) {
+		if result == nil {
+			result = err
+		}
+	}
+
+	if data, err := ioutil.ReadFile(filepath.Join(proc, "loadavg")); err != nil {
+		keep(err)
+	} else if info.cpuload, err = parseLoadAvg(string(data), runtime.NumCPU()); err != nil {
+		keep(err)
+	}
+
+	if data, err := ioutil.ReadFile(filepath.Join(proc, "meminfo")); err != nil {
+		keep(err)
+	} else if info.memused, info.memtotal, err = parseMemInfo(string(data)); err != nil {
+		keep(err)
+	}
+
+	var err error
+	if info.diskfree, err = diskFree(sysoutDir); err != nil {
+		keep(err)
+	}
+
+	return info, result
+}
+
+//parseLoadAvg - returns a load average from the last minute as a percentage of available CPUs
+func parseLoadAvg(data string, cpus int) (int, error) {
+
+	fields := strings.Fields(data)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("invalid loadavg format")
+	}
+
+	load, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if cpus < 1 {
+		cpus = 1
+	}
+
+	return int(load * 100 / float64(cpus)), nil
+}
+
+//parseMemInfo - returns used and total memory in MB, used memory doesn't contain memory that can be reclaimed
+func parseMemInfo(data string) (int, int, error) {
+
+	values := map[string]int64{}
+	scanner := bufio.NewScanner(strings.NewReader(data))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		if v, err := strconv.ParseInt(fields[1], 10, 64); err == nil {
+			values[strings.TrimSuffix(fields[0], ":")] = v
+		}
+	}
+
+	total, ok := values["MemTotal"]
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid meminfo format, MemTotal not found")
+	}
+
+	available, ok := values["MemAvailable"]
+	if !ok {
+		//kernels older than 3.14 do not report an available memory
+		available = values["MemFree"] + values["Buffers"] + values["Cached"]
+	}
+
+	return int((total - available) / 1024), int(total / 1024), nil
+}
@@ -0,0 +1,14 @@
+package services
+
+import "syscall"
+
+//diskFree - returns a free space in MB of a filesystem that contains a directory
+func diskFree(dir string) (int64, error) {
+
+	st := syscall.Statfs_t{}
+	if err := syscall.Statfs(dir, &st); err != nil {
+		return 0, err
+	}
+
+	return int64(uint64(st.Bavail) * uint64(st.Bsize) / (1024 * 1024)), nil
+}
@@ -0,0 +1,8 @@
+// +build !linux
+
+package services
+
+//diskFree - a free space of a filesystem is reported only on linux
+func diskFree(dir string) (int64, error) {
+	return 0, nil
+}
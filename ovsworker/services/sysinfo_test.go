@@ -0,0 +1,73 @@
+package services
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testMemInfo = `MemTotal:        8192000 kB
+MemFree:         1024000 kB
+MemAvailable:    2048000 kB
+Buffers:          102400 kB
+Cached:           512000 kB
+`
+
+func TestParseLoadAvg(t *testing.T) {
+
+	load, err := parseLoadAvg("1.50 0.80 0.40 2/512 12345\n", 2)
+	if err != nil || load != 75 {
+		t.Error("unexpected result:", load, err)
+	}
+
+	if _, err = parseLoadAvg("", 2); err == nil {
+		t.Error("expected error")
+	}
+
+	if _, err = parseLoadAvg("abc 0.80 0.40", 2); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestParseMemInfo(t *testing.T) {
+
+	used, total, err := parseMemInfo(testMemInfo)
+	if err != nil || used != 6000 || total != 8000 {
+		t.Error("unexpected result:", used, total, err)
+	}
+
+	used, _, err = parseMemInfo("MemTotal: 8192000 kB\nMemFree: 1024000 kB\nBuffers: 102400 kB\nCached: 921600 kB\n")
+	if err != nil || used != 6000 {
+		t.Error("unexpected result without MemAvailable:", used, err)
+	}
+
+	if _, _, err = parseMemInfo("MemFree: 1024000 kB\n"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestReadSystemInfo(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "proc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err = readSystemInfo(dir, dir); err == nil {
+		t.Error("expected error, proc files do not exist")
+	}
+
+	ioutil.WriteFile(filepath.Join(dir, "loadavg"), []byte("0.00 0.00 0.00 1/100 1\n"), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "meminfo"), []byte(testMemInfo), 0644)
+
+	info, err := readSystemInfo(dir, dir)
+	if err != nil {
+		t.Error("unexpected error:", err)
+	}
+
+	if info.memtotal != 8000 || info.memused != 6000 || info.cpuload != 0 {
+		t.Error("unexpected result:", info)
+	}
+}
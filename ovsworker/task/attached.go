@@ -0,0 +1,76 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/przebro/overseer/common/types"
+	"github.com/przebro/overseer/ovsworker/status"
+)
+
+//attachInterval - interval between checks whether a re-attached process still runs
+var attachInterval = time.Second
+
+const (
+	//reasonExecutionLost - a reason of a failure of a task whose process ended when a worker was not running
+	reasonExecutionLost = "execution lost, the process ended while the worker was not running"
+	//reasonAttachedEnded - a reason of a failure of a task whose process ended after a restart of a worker
+	reasonAttachedEnded = "the process ended after the worker was restarted, return code unknown"
+)
+
+//attachedJob - a process started before a restart of a worker. The worker is not its parent, so it can only check whether
+//the process still runs and the return code of the process is unknown.
+type attachedJob struct {
+	taskID       string
+	executionID  string
+	pid          int
+	processStart uint64
+	cancel       context.CancelFunc
+}
+
+//StartJob - watches the process until it ends
+func (j *attachedJob) StartJob(ctx context.Context, stat chan status.JobExecutionStatus) status.JobExecutionStatus {
+
+	ctx, j.cancel = context.WithCancel(ctx)
+
+	go func() {
+		done := ctx.Done()
+		for {
+			select {
+			case <-done:
+				killProcess(j.pid)
+				done = nil
+			case <-time.After(attachInterval):
+			}
+
+			if start, running := processStart(j.pid); !running || start != j.processStart {
+				st := status.StatusFailed(j.taskID, j.executionID, reasonAttachedEnded)
+				st.PID = j.pid
+				stat <- st
+				return
+			}
+		}
+	}()
+
+	return status.JobExecutionStatus{TaskID: j.taskID, ExecutionID: j.executionID, State: types.WorkerTaskStatusExecuting, PID: j.pid}
+}
+
+//CancelJob - kills the process
+func (j *attachedJob) CancelJob() error {
+
+	if j.cancel != nil {
+		j.cancel()
+	}
+
+	return nil
+}
+
+//JobTaskID - Returns ID of a task associated with this job.
+func (j *attachedJob) JobTaskID() string {
+	return j.taskID
+}
+
+//JobExecutionID - Returns ID of a current run of a task associated with this job.
+func (j *attachedJob) JobExecutionID() string {
+	return j.executionID
+}
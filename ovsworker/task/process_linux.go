@@ -0,0 +1,48 @@
+package task
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+//processStart - returns a start time of a process in clock ticks since boot, it distinguishes a process from
+//another process that reused its PID. Returns false if a process does not exist.
+func processStart(pid int) (uint64, bool) {
+
+	data, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0, false
+	}
+
+	return parseProcessStart(string(data))
+}
+
+//parseProcessStart - the start time is the 22nd field, the 2nd field is a name in brackets that may contain spaces
+func parseProcessStart(stat string) (uint64, bool) {
+
+	idx := strings.LastIndex(stat, ")")
+	if idx == -1 {
+		return 0, false
+	}
+
+	//fields after the name start with the 3rd field
+	fields := strings.Fields(stat[idx+1:])
+	if len(fields) < 20 {
+		return 0, false
+	}
+
+	start, err := strconv.ParseUint(fields[19], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return start, true
+}
+
+//killProcess - kills a process that is not a child of a worker
+func killProcess(pid int) error {
+	return syscall.Kill(pid, syscall.SIGKILL)
+}
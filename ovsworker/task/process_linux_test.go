@@ -0,0 +1,22 @@
+package task
+
+import (
+	"os"
+	"testing"
+)
+
+func TestParseProcessStart(t *testing.T) {
+
+	stat := "1234 (my (proc) name) S 1 1234 1234 0 -1 4194560 100 0 0 0 1 2 0 0 20 0 1 0 98765 1000 100"
+	if start, ok := parseProcessStart(stat); !ok || start != 98765 {
+		t.Error("unexpected result:", start, ok)
+	}
+
+	if _, ok := parseProcessStart("1234 (name) S 1"); ok {
+		t.Error("unexpected result, stat is too short")
+	}
+
+	if _, ok := processStart(os.Getpid()); !ok {
+		t.Error("unexpected result, current process is running")
+	}
+}
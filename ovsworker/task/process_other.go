@@ -0,0 +1,15 @@
+// +build !linux
+
+package task
+
+import "errors"
+
+//processStart - processes can't be identified, so a worker does not re-attach to them after restart
+func processStart(pid int) (uint64, bool) {
+	return 0, false
+}
+
+//killProcess - re-attached processes are not supported, so there is nothing to kill
+func killProcess(pid int) error {
+	return errors.New("not supported")
+}
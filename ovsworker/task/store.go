@@ -0,0 +1,130 @@
+package task
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/przebro/overseer/common/types"
+	"github.com/przebro/overseer/ovsworker/status"
+)
+
+//ExecutionDirectory - a directory inside the sysout directory where executions are stored
+const ExecutionDirectory = ".executions"
+
+//ExecutionRecord - metadata of an execution that survives a restart of a worker
+type ExecutionRecord struct {
+	TaskID       string                 `json:"taskId"`
+	ExecutionID  string                 `json:"executionId"`
+	PID          int                    `json:"pid"`
+	ProcessStart uint64                 `json:"processStart,omitempty"`
+	Start        time.Time              `json:"start"`
+	End          time.Time              `json:"end,omitempty"`
+	State        types.WorkerTaskStatus `json:"state"`
+	ReturnCode   int                    `json:"rc"`
+	StatusCode   int32                  `json:"statusCode"`
+	Reason       string                 `json:"reason,omitempty"`
+}
+
+//Status - returns a status of an execution
+func (r ExecutionRecord) Status() status.JobExecutionStatus {
+	return status.JobExecutionStatus{
+		TaskID:      r.TaskID,
+		ExecutionID: r.ExecutionID,
+		State:       r.State,
+		ReturnCode:  r.ReturnCode,
+		StatusCode:  r.StatusCode,
+		PID:         r.PID,
+		Reason:      r.Reason,
+	}
+}
+
+//ExecutionStore - persists executions of tasks
+type ExecutionStore interface {
+	Save(rec ExecutionRecord) error
+	Remove(executionID string) error
+	Load() ([]ExecutionRecord, error)
+}
+
+//fileStore - stores each execution in a separate file
+type fileStore struct {
+	dir string
+}
+
+//NewFileStore - creates a store in a given directory, the directory is created with the first record
+func NewFileStore(dir string) ExecutionStore {
+	return &fileStore{dir: dir}
+}
+
+//Save - writes a record, the record is written to a temporary file first, so a crash does not leave a partial record
+func (s *fileStore) Save(rec ExecutionRecord) error {
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(s.dir, 0755); err != nil {
+		return err
+	}
+
+	path := s.path(rec.ExecutionID)
+	if err = ioutil.WriteFile(path+".tmp", data, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(path+".tmp", path)
+}
+
+//Remove - removes a record
+func (s *fileStore) Remove(executionID string) error {
+
+	if err := os.Remove(s.path(executionID)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+//Load - reads all records, files that can't be read are skipped
+func (s *fileStore) Load() ([]ExecutionRecord, error) {
+
+	result := []ExecutionRecord{}
+
+	files, err := ioutil.ReadDir(s.dir)
+	if os.IsNotExist(err) {
+		return result, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range files {
+
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(s.dir, f.Name()))
+		if err != nil {
+			continue
+		}
+
+		rec := ExecutionRecord{}
+		if err = json.Unmarshal(data, &rec); err != nil || rec.ExecutionID == "" {
+			continue
+		}
+
+		result = append(result, rec)
+	}
+
+	return result, nil
+}
+
+func (s *fileStore) path(executionID string) string {
+	return filepath.Join(s.dir, filepath.Base(executionID)+".json")
+}
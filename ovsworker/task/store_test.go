@@ -0,0 +1,200 @@
+package task
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	osexec "os/exec"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/przebro/overseer/common/logger"
+	"github.com/przebro/overseer/common/types"
+	"github.com/przebro/overseer/ovsworker/status"
+)
+
+type testJob struct {
+	result status.JobExecutionStatus
+}
+
+func (j *testJob) StartJob(ctx context.Context, stat chan status.JobExecutionStatus) status.JobExecutionStatus {
+	return j.result
+}
+func (j *testJob) CancelJob() error       { return nil }
+func (j *testJob) JobTaskID() string      { return j.result.TaskID }
+func (j *testJob) JobExecutionID() string { return j.result.ExecutionID }
+
+func TestFileStore(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "executions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := NewFileStore(dir + "/" + ExecutionDirectory)
+
+	if records, err := store.Load(); err != nil || len(records) != 0 {
+		t.Error("unexpected result:", records, err)
+	}
+
+	rec := ExecutionRecord{TaskID: "00001", ExecutionID: "ex1", PID: 100, State: types.WorkerTaskStatusEnded, ReturnCode: 8}
+	if err = store.Save(rec); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := store.Load()
+	if err != nil || len(records) != 1 || records[0].ReturnCode != 8 || records[0].PID != 100 {
+		t.Error("unexpected result:", records, err)
+	}
+
+	if err = store.Remove("ex1"); err != nil {
+		t.Error(err)
+	}
+
+	if records, _ = store.Load(); len(records) != 0 {
+		t.Error("unexpected result:", records)
+	}
+}
+
+func TestDurableManager_Restore(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "executions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := NewFileStore(dir)
+
+	manager, err := NewDurableTaskRunnerManager(store, 0, logger.NewTestLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manager.RunTask(&testJob{result: status.StatusEnded("00001", "ex1", 4, 100, 0)})
+	manager.RunTask(&testJob{result: status.StatusExecuting("00002", "ex2")})
+
+	//a worker restarts, the finished execution keeps its result and the execution without a process is lost
+	restored, err := NewDurableTaskRunnerManager(store, 0, logger.NewTestLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stat, _, ok := restored.GetTaskStatus("ex1"); !ok || stat.State != types.WorkerTaskStatusEnded || stat.ReturnCode != 4 {
+		t.Error("unexpected result:", stat, ok)
+	}
+
+	if stat, _, ok := restored.GetTaskStatus("ex2"); !ok || stat.State != types.WorkerTaskStatusFailed || stat.Reason != reasonExecutionLost {
+		t.Error("unexpected result:", stat, ok)
+	}
+
+	restored.CleanupTask("ex1")
+	if records, _ := store.Load(); len(records) != 1 || records[0].ExecutionID != "ex2" {
+		t.Error("unexpected result:", records)
+	}
+}
+
+func TestDurableManager_Reattach(t *testing.T) {
+
+	if runtime.GOOS != "linux" {
+		t.Skip("processes are re-attached only on linux")
+	}
+
+	dir, err := ioutil.TempDir("", "executions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cmd := osexec.Command("sleep", "30")
+	if err = cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	start, running := processStart(cmd.Process.Pid)
+	if !running {
+		t.Fatal("unexpected result, process is not running")
+	}
+
+	store := NewFileStore(dir)
+	store.Save(ExecutionRecord{TaskID: "00001", ExecutionID: "ex1", PID: cmd.Process.Pid, ProcessStart: start, State: types.WorkerTaskStatusExecuting})
+
+	attachInterval = 50 * time.Millisecond
+
+	manager, err := NewDurableTaskRunnerManager(store, 0, logger.NewTestLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stat, _, ok := manager.GetTaskStatus("ex1"); !ok || stat.State != types.WorkerTaskStatusExecuting || stat.PID != cmd.Process.Pid {
+		t.Fatal("unexpected result:", stat, ok)
+	}
+
+	manager.TerminateTask("ex1")
+	cmd.Wait()
+
+	var stat status.JobExecutionStatus
+	for i := 0; i < 40; i++ {
+		if stat, _, _ = manager.GetTaskStatus("ex1"); stat.State == types.WorkerTaskStatusFailed {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	if stat.State != types.WorkerTaskStatusFailed || stat.Reason != reasonAttachedEnded {
+		t.Error("unexpected result:", stat)
+	}
+}
+
+func TestDurableManager_Purge(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "executions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := NewFileStore(dir)
+	store.Save(ExecutionRecord{TaskID: "00001", ExecutionID: "ex1", State: types.WorkerTaskStatusEnded, End: time.Now().Add(-3 * time.Hour)})
+
+	manager, err := NewDurableTaskRunnerManager(store, 2*time.Hour, logger.NewTestLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, ok := manager.GetTaskStatus("ex1"); ok {
+		t.Error("unexpected result, expired execution restored")
+	}
+
+	if records, _ := store.Load(); len(records) != 0 {
+		t.Error("unexpected result:", records)
+	}
+
+	_, tasks := manager.RunTask(&testJob{result: status.StatusEnded("00002", "ex2", 0, 100, 0)})
+	if tasks != 0 {
+		t.Error("unexpected result, finished execution counted:", tasks)
+	}
+
+	_, tasks = manager.RunTask(&testJob{result: status.StatusExecuting("00003", "ex3")})
+	if tasks != 1 || manager.TaskCount() != 1 {
+		t.Error("unexpected result:", tasks, manager.TaskCount())
+	}
+
+	if n := manager.Purge(time.Now()); n != 0 {
+		t.Error("unexpected result:", n)
+	}
+
+	if n := manager.Purge(time.Now().Add(3 * time.Hour)); n != 1 {
+		t.Error("unexpected result:", n)
+	}
+
+	if _, _, ok := manager.GetTaskStatus("ex2"); ok {
+		t.Error("unexpected result, expired execution not removed")
+	}
+
+	if records, _ := store.Load(); len(records) != 1 || records[0].ExecutionID != "ex3" {
+		t.Error("unexpected result:", records)
+	}
+}
@@ -3,19 +3,38 @@ package task
 import (
 	"context"
 	"sync"
+	"time"
 
+	"github.com/przebro/overseer/common/logger"
+	"github.com/przebro/overseer/common/types"
 	"github.com/przebro/overseer/ovsworker/jobs"
 	"github.com/przebro/overseer/ovsworker/status"
+
+	"go.uber.org/zap"
 )
 
 //TaskRunnerManager - executes a commissioned task
 type TaskRunnerManager struct {
 	store    map[string]status.JobExecutionStatus
 	jobs     map[string]jobs.JobExecutor
+	records  map[string]ExecutionRecord
+	persist  ExecutionStore
+	keep     time.Duration
+	log      logger.AppLogger
 	statChan chan status.JobExecutionStatus
+	watchers map[chan status.JobExecutionStatus]struct{}
 	lock     *sync.Mutex
 }
 
+//watcherBuffer - a number of changes of statuses that a watcher can fall behind, further changes are dropped
+const watcherBuffer = 64
+
+//DefaultRetention - time after which a finished execution that was not completed by overseer is removed
+const DefaultRetention = 24 * time.Hour
+
+//purgeInterval - interval between removals of expired executions
+var purgeInterval = time.Hour
+
 //NewTaskRunnerManager - creates a new TaskRunnerManager
 func NewTaskRunnerManager() *TaskRunnerManager {
 
@@ -23,6 +42,8 @@ func NewTaskRunnerManager() *TaskRunnerManager {
 		store:    map[string]status.JobExecutionStatus{},
 		statChan: make(chan status.JobExecutionStatus),
 		jobs:     map[string]jobs.JobExecutor{},
+		records:  map[string]ExecutionRecord{},
+		watchers: map[chan status.JobExecutionStatus]struct{}{},
 		lock:     &sync.Mutex{},
 	}
 	exec.updateTaskStatus()
@@ -30,6 +51,71 @@ func NewTaskRunnerManager() *TaskRunnerManager {
 	return exec
 }
 
+//NewDurableTaskRunnerManager - creates a new TaskRunnerManager that persists executions in a store. Executions from the store
+//are restored: finished executions keep their results and running processes are re-attached where possible.
+//A finished execution is kept until overseer completes it or until it is older than the retention, zero means the default retention.
+func NewDurableTaskRunnerManager(persist ExecutionStore, retention time.Duration, log logger.AppLogger) (*TaskRunnerManager, error) {
+
+	records, err := persist.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+
+	exec := NewTaskRunnerManager()
+	exec.persist = persist
+	exec.keep = retention
+	exec.log = log
+
+	exec.lock.Lock()
+	for _, rec := range records {
+		exec.restore(rec)
+	}
+	exec.lock.Unlock()
+
+	exec.Purge(time.Now())
+	go exec.purgeExpired()
+
+	return exec, nil
+}
+
+//finished - checks if an execution is in a final state
+func finished(state types.WorkerTaskStatus) bool {
+	return state == types.WorkerTaskStatusEnded || state == types.WorkerTaskStatusFailed
+}
+
+//restore - restores an execution, it has to be called under the lock
+func (exec *TaskRunnerManager) restore(rec ExecutionRecord) {
+
+	if !finished(rec.State) {
+
+		if start, running := processStart(rec.PID); rec.PID > 0 && running && start == rec.ProcessStart {
+
+			job := &attachedJob{taskID: rec.TaskID, executionID: rec.ExecutionID, pid: rec.PID, processStart: rec.ProcessStart}
+			exec.jobs[rec.ExecutionID] = job
+			job.StartJob(context.Background(), exec.statChan)
+
+			exec.log.Desugar().Info("restore", zap.String("descr", "process re-attached"),
+				zap.String("executionID", rec.ExecutionID), zap.Int("pid", rec.PID))
+		} else {
+			rec.State = types.WorkerTaskStatusFailed
+			rec.StatusCode = int32(types.StatusCodeSevereError)
+			rec.Reason = reasonExecutionLost
+			rec.End = time.Now()
+			exec.save(rec)
+
+			exec.log.Desugar().Warn("restore", zap.String("descr", rec.Reason),
+				zap.String("executionID", rec.ExecutionID), zap.Int("pid", rec.PID))
+		}
+	}
+
+	exec.records[rec.ExecutionID] = rec
+	exec.store[rec.ExecutionID] = rec.Status()
+}
+
 //RunTask - starts work fragment
 func (exec *TaskRunnerManager) RunTask(j jobs.JobExecutor) (status.JobExecutionStatus, int) {
 
@@ -41,7 +127,9 @@ func (exec *TaskRunnerManager) RunTask(j jobs.JobExecutor) (status.JobExecutionS
 
 	exec.store[j.JobExecutionID()] = status
 	exec.jobs[j.JobExecutionID()] = j
-	tasks = len(exec.store)
+	exec.record(status, time.Now())
+	exec.publish(status)
+	tasks = exec.tasks()
 
 	return status, tasks
 }
@@ -66,7 +154,80 @@ func (exec *TaskRunnerManager) update(stat status.JobExecutionStatus) {
 
 	defer exec.lock.Unlock()
 	exec.lock.Lock()
+
+	//a job could be cleaned up in the meantime
+	if _, exists := exec.store[stat.ExecutionID]; !exists {
+		return
+	}
+
 	exec.store[stat.ExecutionID] = stat
+	exec.record(stat, time.Time{})
+	exec.publish(stat)
+}
+
+//Watch - returns a channel that receives changes of statuses of executions and a function that stops watching.
+//A watcher that falls behind misses changes, so it should not be the only source of statuses.
+func (exec *TaskRunnerManager) Watch() (<-chan status.JobExecutionStatus, func()) {
+
+	defer exec.lock.Unlock()
+	exec.lock.Lock()
+
+	ch := make(chan status.JobExecutionStatus, watcherBuffer)
+	exec.watchers[ch] = struct{}{}
+
+	return ch, func() {
+		defer exec.lock.Unlock()
+		exec.lock.Lock()
+		delete(exec.watchers, ch)
+	}
+}
+
+//publish - sends a status to watchers, it has to be called under the lock
+func (exec *TaskRunnerManager) publish(stat status.JobExecutionStatus) {
+
+	for ch := range exec.watchers {
+		select {
+		case ch <- stat:
+		default:
+		}
+	}
+}
+
+//record - updates a record of an execution and persists it, it has to be called under the lock
+func (exec *TaskRunnerManager) record(stat status.JobExecutionStatus, start time.Time) {
+
+	rec, exists := exec.records[stat.ExecutionID]
+	if !exists {
+		rec = ExecutionRecord{TaskID: stat.TaskID, ExecutionID: stat.ExecutionID, Start: start}
+	}
+
+	if stat.PID > 0 && stat.PID != rec.PID {
+		rec.PID = stat.PID
+		rec.ProcessStart, _ = processStart(stat.PID)
+	}
+
+	rec.State = stat.State
+	rec.ReturnCode = stat.ReturnCode
+	rec.StatusCode = stat.StatusCode
+	rec.Reason = stat.Reason
+
+	if finished(stat.State) {
+		rec.End = time.Now()
+	}
+
+	exec.records[stat.ExecutionID] = rec
+	exec.save(rec)
+}
+
+func (exec *TaskRunnerManager) save(rec ExecutionRecord) {
+
+	if exec.persist == nil {
+		return
+	}
+
+	if err := exec.persist.Save(rec); err != nil {
+		exec.log.Desugar().Error("save", zap.String("executionID", rec.ExecutionID), zap.String("error", err.Error()))
+	}
 }
 
 //GetTaskStatus - gets fragment status
@@ -75,7 +236,7 @@ func (exec *TaskRunnerManager) GetTaskStatus(executionID string) (status.JobExec
 	exec.lock.Lock()
 
 	stat, exists := exec.store[executionID]
-	return stat, len(exec.store), exists
+	return stat, exec.tasks(), exists
 }
 
 //TaskCount - returns the number of tasks currently processed
@@ -83,7 +244,20 @@ func (exec *TaskRunnerManager) TaskCount() int {
 	defer exec.lock.Unlock()
 	exec.lock.Lock()
 
-	return len(exec.store)
+	return exec.tasks()
+}
+
+//tasks - returns the number of executions that are not finished, it has to be called under the lock
+func (exec *TaskRunnerManager) tasks() int {
+
+	num := 0
+	for _, stat := range exec.store {
+		if !finished(stat.State) {
+			num++
+		}
+	}
+
+	return num
 }
 
 //CleanupTask - removes a task
@@ -91,9 +265,66 @@ func (exec *TaskRunnerManager) CleanupTask(executionID string) int {
 
 	defer exec.lock.Unlock()
 	exec.lock.Lock()
+	exec.remove(executionID)
+
+	return exec.tasks()
+}
+
+//remove - removes an execution, it has to be called under the lock
+func (exec *TaskRunnerManager) remove(executionID string) {
+
 	delete(exec.jobs, executionID)
 	delete(exec.store, executionID)
-	return len(exec.store)
+	delete(exec.records, executionID)
+
+	if exec.persist != nil {
+		if err := exec.persist.Remove(executionID); err != nil {
+			exec.log.Desugar().Error("cleanup", zap.String("executionID", executionID), zap.String("error", err.Error()))
+		}
+	}
+}
+
+//Purge - removes finished executions that ended before the retention counted from a given time, such executions
+//were not completed by overseer, e.g. a task was failed by overseer when the worker was lost. Returns the number of removed executions.
+func (exec *TaskRunnerManager) Purge(now time.Time) int {
+
+	defer exec.lock.Unlock()
+	exec.lock.Lock()
+
+	if exec.keep <= 0 {
+		return 0
+	}
+
+	purged := 0
+	for id, rec := range exec.records {
+
+		end := rec.End
+		if end.IsZero() {
+			end = rec.Start
+		}
+
+		if finished(rec.State) && end.Add(exec.keep).Before(now) {
+			exec.remove(id)
+			purged++
+		}
+	}
+
+	if purged > 0 {
+		exec.log.Desugar().Info("purge", zap.String("descr", "expired executions removed"), zap.Int("count", purged))
+	}
+
+	return purged
+}
+
+//purgeExpired - periodically removes expired executions
+func (exec *TaskRunnerManager) purgeExpired() {
+
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		exec.Purge(now)
+	}
 }
 
 //TerminateTask - removes a task
@@ -103,5 +334,5 @@ func (exec *TaskRunnerManager) TerminateTask(executionID string) int {
 	exec.lock.Lock()
 	exec.jobs[executionID].CancelJob()
 
-	return len(exec.store)
+	return exec.tasks()
 }
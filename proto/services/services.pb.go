@@ -226,6 +226,8 @@ type TicketActionMsg struct {
 
 	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Odate string `protobuf:"bytes,2,opt,name=odate,proto3" json:"odate,omitempty"`
+	Keep  bool   `protobuf:"varint,3,opt,name=keep,proto3" json:"keep,omitempty"`
+	Scope string `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
 }
 
 func (x *TicketActionMsg) Reset() {
@@ -274,6 +276,20 @@ func (x *TicketActionMsg) GetOdate() string {
 	return ""
 }
 
+func (x *TicketActionMsg) GetKeep() bool {
+	if x != nil {
+		return x.Keep
+	}
+	return false
+}
+
+func (x *TicketActionMsg) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
 type FlagActionMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -281,6 +297,7 @@ type FlagActionMsg struct {
 
 	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	State int32  `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
+	Scope string `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
 }
 
 func (x *FlagActionMsg) Reset() {
@@ -329,6 +346,210 @@ func (x *FlagActionMsg) GetState() int32 {
 	return 0
 }
 
+func (x *FlagActionMsg) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+type FlagHolderMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	Scope  string `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+}
+
+func (x *FlagHolderMsg) Reset() {
+	*x = FlagHolderMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlagHolderMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagHolderMsg) ProtoMessage() {}
+
+func (x *FlagHolderMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagHolderMsg.ProtoReflect.Descriptor instead.
+func (*FlagHolderMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FlagHolderMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FlagHolderMsg) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *FlagHolderMsg) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+type VariableActionMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Group string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Task  string `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"`
+	Name  string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *VariableActionMsg) Reset() {
+	*x = VariableActionMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VariableActionMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableActionMsg) ProtoMessage() {}
+
+func (x *VariableActionMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableActionMsg.ProtoReflect.Descriptor instead.
+func (*VariableActionMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VariableActionMsg) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *VariableActionMsg) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *VariableActionMsg) GetTask() string {
+	if x != nil {
+		return x.Task
+	}
+	return ""
+}
+
+func (x *VariableActionMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VariableActionMsg) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type QuantityActionMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity int32  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+}
+
+func (x *QuantityActionMsg) Reset() {
+	*x = QuantityActionMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuantityActionMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuantityActionMsg) ProtoMessage() {}
+
+func (x *QuantityActionMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuantityActionMsg.ProtoReflect.Descriptor instead.
+func (*QuantityActionMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QuantityActionMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QuantityActionMsg) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
 type TaskActionMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -340,7 +561,7 @@ type TaskActionMsg struct {
 func (x *TaskActionMsg) Reset() {
 	*x = TaskActionMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[6]
+		mi := &file_services_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -353,7 +574,7 @@ func (x *TaskActionMsg) String() string {
 func (*TaskActionMsg) ProtoMessage() {}
 
 func (x *TaskActionMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[6]
+	mi := &file_services_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -366,7 +587,7 @@ func (x *TaskActionMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskActionMsg.ProtoReflect.Descriptor instead.
 func (*TaskActionMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{6}
+	return file_services_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TaskActionMsg) GetTaskID() string {
@@ -392,7 +613,7 @@ type TaskFilterMsg struct {
 func (x *TaskFilterMsg) Reset() {
 	*x = TaskFilterMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[7]
+		mi := &file_services_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -405,7 +626,7 @@ func (x *TaskFilterMsg) String() string {
 func (*TaskFilterMsg) ProtoMessage() {}
 
 func (x *TaskFilterMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[7]
+	mi := &file_services_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +639,7 @@ func (x *TaskFilterMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskFilterMsg.ProtoReflect.Descriptor instead.
 func (*TaskFilterMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{7}
+	return file_services_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TaskFilterMsg) GetGroup() string {
@@ -468,15 +689,18 @@ type TaskOrderMsg struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaskGroup string `protobuf:"bytes,1,opt,name=taskGroup,proto3" json:"taskGroup,omitempty"`
-	TaskName  string `protobuf:"bytes,2,opt,name=taskName,proto3" json:"taskName,omitempty"`
-	Odate     string `protobuf:"bytes,3,opt,name=odate,proto3" json:"odate,omitempty"`
+	TaskGroup string            `protobuf:"bytes,1,opt,name=taskGroup,proto3" json:"taskGroup,omitempty"`
+	TaskName  string            `protobuf:"bytes,2,opt,name=taskName,proto3" json:"taskName,omitempty"`
+	Odate     string            `protobuf:"bytes,3,opt,name=odate,proto3" json:"odate,omitempty"`
+	Variables map[string]string `protobuf:"bytes,4,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//overrides a priority from the task definition, zero means a priority from the definition
+	Priority int32 `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (x *TaskOrderMsg) Reset() {
 	*x = TaskOrderMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[8]
+		mi := &file_services_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -489,7 +713,7 @@ func (x *TaskOrderMsg) String() string {
 func (*TaskOrderMsg) ProtoMessage() {}
 
 func (x *TaskOrderMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[8]
+	mi := &file_services_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -502,7 +726,7 @@ func (x *TaskOrderMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskOrderMsg.ProtoReflect.Descriptor instead.
 func (*TaskOrderMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{8}
+	return file_services_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TaskOrderMsg) GetTaskGroup() string {
@@ -526,6 +750,20 @@ func (x *TaskOrderMsg) GetOdate() string {
 	return ""
 }
 
+func (x *TaskOrderMsg) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *TaskOrderMsg) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
 type TaskOrderGroupMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -538,7 +776,7 @@ type TaskOrderGroupMsg struct {
 func (x *TaskOrderGroupMsg) Reset() {
 	*x = TaskOrderGroupMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[9]
+		mi := &file_services_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -551,7 +789,7 @@ func (x *TaskOrderGroupMsg) String() string {
 func (*TaskOrderGroupMsg) ProtoMessage() {}
 
 func (x *TaskOrderGroupMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[9]
+	mi := &file_services_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,7 +802,7 @@ func (x *TaskOrderGroupMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskOrderGroupMsg.ProtoReflect.Descriptor instead.
 func (*TaskOrderGroupMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{9}
+	return file_services_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TaskOrderGroupMsg) GetTaskGroup() string {
@@ -593,7 +831,7 @@ type DefinitionMsg struct {
 func (x *DefinitionMsg) Reset() {
 	*x = DefinitionMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[10]
+		mi := &file_services_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -606,7 +844,7 @@ func (x *DefinitionMsg) String() string {
 func (*DefinitionMsg) ProtoMessage() {}
 
 func (x *DefinitionMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[10]
+	mi := &file_services_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,7 +857,7 @@ func (x *DefinitionMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefinitionMsg.ProtoReflect.Descriptor instead.
 func (*DefinitionMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{10}
+	return file_services_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DefinitionMsg) GetGroupName() string {
@@ -647,7 +885,7 @@ type DefinitionActionMsg struct {
 func (x *DefinitionActionMsg) Reset() {
 	*x = DefinitionActionMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[11]
+		mi := &file_services_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -660,7 +898,7 @@ func (x *DefinitionActionMsg) String() string {
 func (*DefinitionActionMsg) ProtoMessage() {}
 
 func (x *DefinitionActionMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[11]
+	mi := &file_services_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,7 +911,7 @@ func (x *DefinitionActionMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefinitionActionMsg.ProtoReflect.Descriptor instead.
 func (*DefinitionActionMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{11}
+	return file_services_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DefinitionActionMsg) GetDefinitionMsg() *DefinitionMsg {
@@ -695,7 +933,7 @@ type DefinitionDetails struct {
 func (x *DefinitionDetails) Reset() {
 	*x = DefinitionDetails{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[12]
+		mi := &file_services_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -708,7 +946,7 @@ func (x *DefinitionDetails) String() string {
 func (*DefinitionDetails) ProtoMessage() {}
 
 func (x *DefinitionDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[12]
+	mi := &file_services_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,7 +959,7 @@ func (x *DefinitionDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefinitionDetails.ProtoReflect.Descriptor instead.
 func (*DefinitionDetails) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{12}
+	return file_services_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DefinitionDetails) GetSuccess() bool {
@@ -749,7 +987,7 @@ type DefinitionResultMsg struct {
 func (x *DefinitionResultMsg) Reset() {
 	*x = DefinitionResultMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[13]
+		mi := &file_services_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -762,7 +1000,7 @@ func (x *DefinitionResultMsg) String() string {
 func (*DefinitionResultMsg) ProtoMessage() {}
 
 func (x *DefinitionResultMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[13]
+	mi := &file_services_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +1013,7 @@ func (x *DefinitionResultMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefinitionResultMsg.ProtoReflect.Descriptor instead.
 func (*DefinitionResultMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{13}
+	return file_services_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DefinitionResultMsg) GetDefinitionMsg() *DefinitionDetails {
@@ -797,7 +1035,7 @@ type ActionResultMsg struct {
 func (x *ActionResultMsg) Reset() {
 	*x = ActionResultMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[14]
+		mi := &file_services_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -810,7 +1048,7 @@ func (x *ActionResultMsg) String() string {
 func (*ActionResultMsg) ProtoMessage() {}
 
 func (x *ActionResultMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[14]
+	mi := &file_services_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,7 +1061,7 @@ func (x *ActionResultMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActionResultMsg.ProtoReflect.Descriptor instead.
 func (*ActionResultMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{14}
+	return file_services_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ActionResultMsg) GetSuccess() bool {
@@ -845,14 +1083,16 @@ type FlagListResultMsg struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FlagName string `protobuf:"bytes,1,opt,name=flagName,proto3" json:"flagName,omitempty"`
-	State    int32  `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
+	FlagName string   `protobuf:"bytes,1,opt,name=flagName,proto3" json:"flagName,omitempty"`
+	State    int32    `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
+	Holders  []string `protobuf:"bytes,3,rep,name=holders,proto3" json:"holders,omitempty"`
+	Scope    string   `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
 }
 
 func (x *FlagListResultMsg) Reset() {
 	*x = FlagListResultMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[15]
+		mi := &file_services_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -865,7 +1105,7 @@ func (x *FlagListResultMsg) String() string {
 func (*FlagListResultMsg) ProtoMessage() {}
 
 func (x *FlagListResultMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[15]
+	mi := &file_services_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,7 +1118,7 @@ func (x *FlagListResultMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlagListResultMsg.ProtoReflect.Descriptor instead.
 func (*FlagListResultMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{15}
+	return file_services_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *FlagListResultMsg) GetFlagName() string {
@@ -895,19 +1135,39 @@ func (x *FlagListResultMsg) GetState() int32 {
 	return 0
 }
 
+func (x *FlagListResultMsg) GetHolders() []string {
+	if x != nil {
+		return x.Holders
+	}
+	return nil
+}
+
+func (x *FlagListResultMsg) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
 type TicketListResultMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Odate string `protobuf:"bytes,2,opt,name=odate,proto3" json:"odate,omitempty"`
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Odate       string `protobuf:"bytes,2,opt,name=odate,proto3" json:"odate,omitempty"`
+	Keep        bool   `protobuf:"varint,3,opt,name=keep,proto3" json:"keep,omitempty"`
+	OrderID     string `protobuf:"bytes,4,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	ExecutionID string `protobuf:"bytes,5,opt,name=executionID,proto3" json:"executionID,omitempty"`
+	Username    string `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`
+	Created     string `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`
+	Scope       string `protobuf:"bytes,8,opt,name=scope,proto3" json:"scope,omitempty"`
 }
 
 func (x *TicketListResultMsg) Reset() {
 	*x = TicketListResultMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[16]
+		mi := &file_services_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -920,7 +1180,7 @@ func (x *TicketListResultMsg) String() string {
 func (*TicketListResultMsg) ProtoMessage() {}
 
 func (x *TicketListResultMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[16]
+	mi := &file_services_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -933,7 +1193,7 @@ func (x *TicketListResultMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TicketListResultMsg.ProtoReflect.Descriptor instead.
 func (*TicketListResultMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{16}
+	return file_services_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TicketListResultMsg) GetName() string {
@@ -950,145 +1210,124 @@ func (x *TicketListResultMsg) GetOdate() string {
 	return ""
 }
 
-type TaskListResultMsg struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	GroupName  string `protobuf:"bytes,1,opt,name=groupName,proto3" json:"groupName,omitempty"`
-	TaskName   string `protobuf:"bytes,2,opt,name=taskName,proto3" json:"taskName,omitempty"`
-	TaskId     string `protobuf:"bytes,3,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	OrderDate  string `protobuf:"bytes,4,opt,name=orderDate,proto3" json:"orderDate,omitempty"`
-	TaskStatus int32  `protobuf:"varint,5,opt,name=taskStatus,proto3" json:"taskStatus,omitempty"`
-	RunNumber  int32  `protobuf:"varint,6,opt,name=runNumber,proto3" json:"runNumber,omitempty"`
-	Waiting    string `protobuf:"bytes,7,opt,name=waiting,proto3" json:"waiting,omitempty"`
-	Held       bool   `protobuf:"varint,8,opt,name=held,proto3" json:"held,omitempty"`
-	Confirmed  bool   `protobuf:"varint,9,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
-}
-
-func (x *TaskListResultMsg) Reset() {
-	*x = TaskListResultMsg{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TicketListResultMsg) GetKeep() bool {
+	if x != nil {
+		return x.Keep
 	}
+	return false
 }
 
-func (x *TaskListResultMsg) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TaskListResultMsg) ProtoMessage() {}
-
-func (x *TaskListResultMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TicketListResultMsg) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TaskListResultMsg.ProtoReflect.Descriptor instead.
-func (*TaskListResultMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{17}
+	return ""
 }
 
-func (x *TaskListResultMsg) GetGroupName() string {
+func (x *TicketListResultMsg) GetExecutionID() string {
 	if x != nil {
-		return x.GroupName
+		return x.ExecutionID
 	}
 	return ""
 }
 
-func (x *TaskListResultMsg) GetTaskName() string {
+func (x *TicketListResultMsg) GetUsername() string {
 	if x != nil {
-		return x.TaskName
+		return x.Username
 	}
 	return ""
 }
 
-func (x *TaskListResultMsg) GetTaskId() string {
+func (x *TicketListResultMsg) GetCreated() string {
 	if x != nil {
-		return x.TaskId
+		return x.Created
 	}
 	return ""
 }
 
-func (x *TaskListResultMsg) GetOrderDate() string {
+func (x *TicketListResultMsg) GetScope() string {
 	if x != nil {
-		return x.OrderDate
+		return x.Scope
 	}
 	return ""
 }
 
-func (x *TaskListResultMsg) GetTaskStatus() int32 {
-	if x != nil {
-		return x.TaskStatus
-	}
-	return 0
+type TicketBatchMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tickets []*TicketActionMsg `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
 }
 
-func (x *TaskListResultMsg) GetRunNumber() int32 {
-	if x != nil {
-		return x.RunNumber
+func (x *TicketBatchMsg) Reset() {
+	*x = TicketBatchMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *TaskListResultMsg) GetWaiting() string {
-	if x != nil {
-		return x.Waiting
-	}
-	return ""
+func (x *TicketBatchMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TaskListResultMsg) GetHeld() bool {
-	if x != nil {
-		return x.Held
+func (*TicketBatchMsg) ProtoMessage() {}
+
+func (x *TicketBatchMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *TaskListResultMsg) GetConfirmed() bool {
+// Deprecated: Use TicketBatchMsg.ProtoReflect.Descriptor instead.
+func (*TicketBatchMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TicketBatchMsg) GetTickets() []*TicketActionMsg {
 	if x != nil {
-		return x.Confirmed
+		return x.Tickets
 	}
-	return false
+	return nil
 }
 
-type TaskResourcesMsg struct {
+type TicketDeleteMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type      string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Odate     string `protobuf:"bytes,3,opt,name=odate,proto3" json:"odate,omitempty"`
-	Satisfied bool   `protobuf:"varint,5,opt,name=satisfied,proto3" json:"satisfied,omitempty"`
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Odate  string `protobuf:"bytes,2,opt,name=odate,proto3" json:"odate,omitempty"`
+	DryRun bool   `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Token  string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Scope  string `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`
 }
 
-func (x *TaskResourcesMsg) Reset() {
-	*x = TaskResourcesMsg{}
+func (x *TicketDeleteMsg) Reset() {
+	*x = TicketDeleteMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[18]
+		mi := &file_services_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TaskResourcesMsg) String() string {
+func (x *TicketDeleteMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskResourcesMsg) ProtoMessage() {}
+func (*TicketDeleteMsg) ProtoMessage() {}
 
-func (x *TaskResourcesMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[18]
+func (x *TicketDeleteMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,68 +1338,75 @@ func (x *TaskResourcesMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskResourcesMsg.ProtoReflect.Descriptor instead.
-func (*TaskResourcesMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use TicketDeleteMsg.ProtoReflect.Descriptor instead.
+func (*TicketDeleteMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *TaskResourcesMsg) GetType() string {
+func (x *TicketDeleteMsg) GetName() string {
 	if x != nil {
-		return x.Type
+		return x.Name
 	}
 	return ""
 }
 
-func (x *TaskResourcesMsg) GetName() string {
+func (x *TicketDeleteMsg) GetOdate() string {
 	if x != nil {
-		return x.Name
+		return x.Odate
 	}
 	return ""
 }
 
-func (x *TaskResourcesMsg) GetOdate() string {
+func (x *TicketDeleteMsg) GetDryRun() bool {
 	if x != nil {
-		return x.Odate
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *TicketDeleteMsg) GetToken() string {
+	if x != nil {
+		return x.Token
 	}
 	return ""
 }
 
-func (x *TaskResourcesMsg) GetSatisfied() bool {
+func (x *TicketDeleteMsg) GetScope() string {
 	if x != nil {
-		return x.Satisfied
+		return x.Scope
 	}
-	return false
+	return ""
 }
 
-type TaskCyclicResultMsg struct {
+type TicketDeleteResultMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsCyclic bool   `protobuf:"varint,1,opt,name=isCyclic,proto3" json:"isCyclic,omitempty"`
-	NextRun  string `protobuf:"bytes,2,opt,name=nextRun,proto3" json:"nextRun,omitempty"`
-	RunFrom  string `protobuf:"bytes,3,opt,name=runFrom,proto3" json:"runFrom,omitempty"`
-	MaxRun   int32  `protobuf:"varint,4,opt,name=maxRun,proto3" json:"maxRun,omitempty"`
-	Interval int32  `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count   int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Tickets []*TicketListResultMsg `protobuf:"bytes,4,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	Token   string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *TaskCyclicResultMsg) Reset() {
-	*x = TaskCyclicResultMsg{}
+func (x *TicketDeleteResultMsg) Reset() {
+	*x = TicketDeleteResultMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[19]
+		mi := &file_services_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TaskCyclicResultMsg) String() string {
+func (x *TicketDeleteResultMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskCyclicResultMsg) ProtoMessage() {}
+func (*TicketDeleteResultMsg) ProtoMessage() {}
 
-func (x *TaskCyclicResultMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[19]
+func (x *TicketDeleteResultMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1171,80 +1417,78 @@ func (x *TaskCyclicResultMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskCyclicResultMsg.ProtoReflect.Descriptor instead.
-func (*TaskCyclicResultMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use TicketDeleteResultMsg.ProtoReflect.Descriptor instead.
+func (*TicketDeleteResultMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *TaskCyclicResultMsg) GetIsCyclic() bool {
+func (x *TicketDeleteResultMsg) GetSuccess() bool {
 	if x != nil {
-		return x.IsCyclic
+		return x.Success
 	}
 	return false
 }
 
-func (x *TaskCyclicResultMsg) GetNextRun() string {
+func (x *TicketDeleteResultMsg) GetMessage() string {
 	if x != nil {
-		return x.NextRun
+		return x.Message
 	}
 	return ""
 }
 
-func (x *TaskCyclicResultMsg) GetRunFrom() string {
+func (x *TicketDeleteResultMsg) GetCount() int32 {
 	if x != nil {
-		return x.RunFrom
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *TaskCyclicResultMsg) GetMaxRun() int32 {
+func (x *TicketDeleteResultMsg) GetTickets() []*TicketListResultMsg {
 	if x != nil {
-		return x.MaxRun
+		return x.Tickets
 	}
-	return 0
+	return nil
 }
 
-func (x *TaskCyclicResultMsg) GetInterval() int32 {
+func (x *TicketDeleteResultMsg) GetToken() string {
 	if x != nil {
-		return x.Interval
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-type TaskDetailResultMsg struct {
+type TicketAuditResultMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result      *ActionResultMsg     `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	BaseData    *TaskListResultMsg   `protobuf:"bytes,2,opt,name=baseData,proto3" json:"baseData,omitempty"`
-	Description string               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	From        string               `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
-	To          string               `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
-	StartTime   string               `protobuf:"bytes,6,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	EndTime     string               `protobuf:"bytes,7,opt,name=endTime,proto3" json:"endTime,omitempty"`
-	Worker      string               `protobuf:"bytes,8,opt,name=worker,proto3" json:"worker,omitempty"`
-	Resources   []*TaskResourcesMsg  `protobuf:"bytes,9,rep,name=resources,proto3" json:"resources,omitempty"`
-	CyclicData  *TaskCyclicResultMsg `protobuf:"bytes,10,opt,name=cyclicData,proto3" json:"cyclicData,omitempty"`
+	Time        string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Action      string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Odate       string `protobuf:"bytes,4,opt,name=odate,proto3" json:"odate,omitempty"`
+	OrderID     string `protobuf:"bytes,5,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	ExecutionID string `protobuf:"bytes,6,opt,name=executionID,proto3" json:"executionID,omitempty"`
+	Username    string `protobuf:"bytes,7,opt,name=username,proto3" json:"username,omitempty"`
+	Scope       string `protobuf:"bytes,8,opt,name=scope,proto3" json:"scope,omitempty"`
 }
 
-func (x *TaskDetailResultMsg) Reset() {
-	*x = TaskDetailResultMsg{}
+func (x *TicketAuditResultMsg) Reset() {
+	*x = TicketAuditResultMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[20]
+		mi := &file_services_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TaskDetailResultMsg) String() string {
+func (x *TicketAuditResultMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskDetailResultMsg) ProtoMessage() {}
+func (*TicketAuditResultMsg) ProtoMessage() {}
 
-func (x *TaskDetailResultMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[20]
+func (x *TicketAuditResultMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1255,106 +1499,96 @@ func (x *TaskDetailResultMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskDetailResultMsg.ProtoReflect.Descriptor instead.
-func (*TaskDetailResultMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *TaskDetailResultMsg) GetResult() *ActionResultMsg {
-	if x != nil {
-		return x.Result
-	}
-	return nil
-}
-
-func (x *TaskDetailResultMsg) GetBaseData() *TaskListResultMsg {
-	if x != nil {
-		return x.BaseData
-	}
-	return nil
+// Deprecated: Use TicketAuditResultMsg.ProtoReflect.Descriptor instead.
+func (*TicketAuditResultMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *TaskDetailResultMsg) GetDescription() string {
+func (x *TicketAuditResultMsg) GetTime() string {
 	if x != nil {
-		return x.Description
+		return x.Time
 	}
 	return ""
 }
 
-func (x *TaskDetailResultMsg) GetFrom() string {
+func (x *TicketAuditResultMsg) GetAction() string {
 	if x != nil {
-		return x.From
+		return x.Action
 	}
 	return ""
 }
 
-func (x *TaskDetailResultMsg) GetTo() string {
+func (x *TicketAuditResultMsg) GetName() string {
 	if x != nil {
-		return x.To
+		return x.Name
 	}
 	return ""
 }
 
-func (x *TaskDetailResultMsg) GetStartTime() string {
+func (x *TicketAuditResultMsg) GetOdate() string {
 	if x != nil {
-		return x.StartTime
+		return x.Odate
 	}
 	return ""
 }
 
-func (x *TaskDetailResultMsg) GetEndTime() string {
+func (x *TicketAuditResultMsg) GetOrderID() string {
 	if x != nil {
-		return x.EndTime
+		return x.OrderID
 	}
 	return ""
 }
 
-func (x *TaskDetailResultMsg) GetWorker() string {
+func (x *TicketAuditResultMsg) GetExecutionID() string {
 	if x != nil {
-		return x.Worker
+		return x.ExecutionID
 	}
 	return ""
 }
 
-func (x *TaskDetailResultMsg) GetResources() []*TaskResourcesMsg {
+func (x *TicketAuditResultMsg) GetUsername() string {
 	if x != nil {
-		return x.Resources
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *TaskDetailResultMsg) GetCyclicData() *TaskCyclicResultMsg {
+func (x *TicketAuditResultMsg) GetScope() string {
 	if x != nil {
-		return x.CyclicData
+		return x.Scope
 	}
-	return nil
+	return ""
 }
 
-type TaskDataMsg struct {
+type VariableListResultMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Output []string `protobuf:"bytes,1,rep,name=output,proto3" json:"output,omitempty"`
+	Scope string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Group string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Task  string `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"`
+	Name  string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *TaskDataMsg) Reset() {
-	*x = TaskDataMsg{}
+func (x *VariableListResultMsg) Reset() {
+	*x = VariableListResultMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[21]
+		mi := &file_services_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TaskDataMsg) String() string {
+func (x *VariableListResultMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskDataMsg) ProtoMessage() {}
+func (*VariableListResultMsg) ProtoMessage() {}
 
-func (x *TaskDataMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[21]
+func (x *VariableListResultMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1365,92 +1599,73 @@ func (x *TaskDataMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskDataMsg.ProtoReflect.Descriptor instead.
-func (*TaskDataMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use VariableListResultMsg.ProtoReflect.Descriptor instead.
+func (*VariableListResultMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *TaskDataMsg) GetOutput() []string {
+func (x *VariableListResultMsg) GetScope() string {
 	if x != nil {
-		return x.Output
+		return x.Scope
 	}
-	return nil
-}
-
-type DefinitionListGroupResultMsg struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	GroupName []string `protobuf:"bytes,1,rep,name=groupName,proto3" json:"groupName,omitempty"`
+	return ""
 }
 
-func (x *DefinitionListGroupResultMsg) Reset() {
-	*x = DefinitionListGroupResultMsg{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *VariableListResultMsg) GetGroup() string {
+	if x != nil {
+		return x.Group
 	}
+	return ""
 }
 
-func (x *DefinitionListGroupResultMsg) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DefinitionListGroupResultMsg) ProtoMessage() {}
-
-func (x *DefinitionListGroupResultMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *VariableListResultMsg) GetTask() string {
+	if x != nil {
+		return x.Task
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DefinitionListGroupResultMsg.ProtoReflect.Descriptor instead.
-func (*DefinitionListGroupResultMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{22}
+func (x *VariableListResultMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *DefinitionListGroupResultMsg) GetGroupName() []string {
+func (x *VariableListResultMsg) GetValue() string {
 	if x != nil {
-		return x.GroupName
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-type DefinitionListMsg struct {
+type QuantityListResultMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupName   string `protobuf:"bytes,1,opt,name=groupName,proto3" json:"groupName,omitempty"`
-	TaskName    string `protobuf:"bytes,2,opt,name=taskName,proto3" json:"taskName,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity int32  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Used     int32  `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
 }
 
-func (x *DefinitionListMsg) Reset() {
-	*x = DefinitionListMsg{}
+func (x *QuantityListResultMsg) Reset() {
+	*x = QuantityListResultMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[23]
+		mi := &file_services_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DefinitionListMsg) String() string {
+func (x *QuantityListResultMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefinitionListMsg) ProtoMessage() {}
+func (*QuantityListResultMsg) ProtoMessage() {}
 
-func (x *DefinitionListMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[23]
+func (x *QuantityListResultMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1461,57 +1676,65 @@ func (x *DefinitionListMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefinitionListMsg.ProtoReflect.Descriptor instead.
-func (*DefinitionListMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use QuantityListResultMsg.ProtoReflect.Descriptor instead.
+func (*QuantityListResultMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DefinitionListMsg) GetGroupName() string {
+func (x *QuantityListResultMsg) GetName() string {
 	if x != nil {
-		return x.GroupName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *DefinitionListMsg) GetTaskName() string {
+func (x *QuantityListResultMsg) GetCapacity() int32 {
 	if x != nil {
-		return x.TaskName
+		return x.Capacity
 	}
-	return ""
+	return 0
 }
 
-func (x *DefinitionListMsg) GetDescription() string {
+func (x *QuantityListResultMsg) GetUsed() int32 {
 	if x != nil {
-		return x.Description
+		return x.Used
 	}
-	return ""
+	return 0
 }
 
-type DefinitionListResultMsg struct {
+type TaskListResultMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Definitions []*DefinitionListMsg `protobuf:"bytes,1,rep,name=definitions,proto3" json:"definitions,omitempty"`
+	GroupName  string `protobuf:"bytes,1,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	TaskName   string `protobuf:"bytes,2,opt,name=taskName,proto3" json:"taskName,omitempty"`
+	TaskId     string `protobuf:"bytes,3,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	OrderDate  string `protobuf:"bytes,4,opt,name=orderDate,proto3" json:"orderDate,omitempty"`
+	TaskStatus int32  `protobuf:"varint,5,opt,name=taskStatus,proto3" json:"taskStatus,omitempty"`
+	RunNumber  int32  `protobuf:"varint,6,opt,name=runNumber,proto3" json:"runNumber,omitempty"`
+	Waiting    string `protobuf:"bytes,7,opt,name=waiting,proto3" json:"waiting,omitempty"`
+	Held       bool   `protobuf:"varint,8,opt,name=held,proto3" json:"held,omitempty"`
+	Confirmed  bool   `protobuf:"varint,9,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
 }
 
-func (x *DefinitionListResultMsg) Reset() {
-	*x = DefinitionListResultMsg{}
+func (x *TaskListResultMsg) Reset() {
+	*x = TaskListResultMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[24]
+		mi := &file_services_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DefinitionListResultMsg) String() string {
+func (x *TaskListResultMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefinitionListResultMsg) ProtoMessage() {}
+func (*TaskListResultMsg) ProtoMessage() {}
 
-func (x *DefinitionListResultMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[24]
+func (x *TaskListResultMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1522,123 +1745,102 @@ func (x *DefinitionListResultMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefinitionListResultMsg.ProtoReflect.Descriptor instead.
-func (*DefinitionListResultMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use TaskListResultMsg.ProtoReflect.Descriptor instead.
+func (*TaskListResultMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *DefinitionListResultMsg) GetDefinitions() []*DefinitionListMsg {
+func (x *TaskListResultMsg) GetGroupName() string {
 	if x != nil {
-		return x.Definitions
+		return x.GroupName
 	}
-	return nil
-}
-
-type UserAccount struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Username string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Fullname string   `protobuf:"bytes,2,opt,name=fullname,proto3" json:"fullname,omitempty"`
-	Email    string   `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Enabled  bool     `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Roles    []string `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles,omitempty"`
+	return ""
 }
 
-func (x *UserAccount) Reset() {
-	*x = UserAccount{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TaskListResultMsg) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
 	}
+	return ""
 }
 
-func (x *UserAccount) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UserAccount) ProtoMessage() {}
-
-func (x *UserAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TaskListResultMsg) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UserAccount.ProtoReflect.Descriptor instead.
-func (*UserAccount) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{25}
+func (x *TaskListResultMsg) GetOrderDate() string {
+	if x != nil {
+		return x.OrderDate
+	}
+	return ""
 }
 
-func (x *UserAccount) GetUsername() string {
+func (x *TaskListResultMsg) GetTaskStatus() int32 {
 	if x != nil {
-		return x.Username
+		return x.TaskStatus
 	}
-	return ""
+	return 0
 }
 
-func (x *UserAccount) GetFullname() string {
+func (x *TaskListResultMsg) GetRunNumber() int32 {
 	if x != nil {
-		return x.Fullname
+		return x.RunNumber
 	}
-	return ""
+	return 0
 }
 
-func (x *UserAccount) GetEmail() string {
+func (x *TaskListResultMsg) GetWaiting() string {
 	if x != nil {
-		return x.Email
+		return x.Waiting
 	}
 	return ""
 }
 
-func (x *UserAccount) GetEnabled() bool {
+func (x *TaskListResultMsg) GetHeld() bool {
 	if x != nil {
-		return x.Enabled
+		return x.Held
 	}
 	return false
 }
 
-func (x *UserAccount) GetRoles() []string {
+func (x *TaskListResultMsg) GetConfirmed() bool {
 	if x != nil {
-		return x.Roles
+		return x.Confirmed
 	}
-	return nil
+	return false
 }
 
-type ChangePassword struct {
+type TaskResourcesMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OldPassword string `protobuf:"bytes,1,opt,name=oldPassword,proto3" json:"oldPassword,omitempty"`
-	NewPassword string `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	Type      string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Odate     string `protobuf:"bytes,3,opt,name=odate,proto3" json:"odate,omitempty"`
+	Satisfied bool   `protobuf:"varint,5,opt,name=satisfied,proto3" json:"satisfied,omitempty"`
 }
 
-func (x *ChangePassword) Reset() {
-	*x = ChangePassword{}
+func (x *TaskResourcesMsg) Reset() {
+	*x = TaskResourcesMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[26]
+		mi := &file_services_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangePassword) String() string {
+func (x *TaskResourcesMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePassword) ProtoMessage() {}
+func (*TaskResourcesMsg) ProtoMessage() {}
 
-func (x *ChangePassword) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[26]
+func (x *TaskResourcesMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1649,51 +1851,68 @@ func (x *ChangePassword) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePassword.ProtoReflect.Descriptor instead.
-func (*ChangePassword) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use TaskResourcesMsg.ProtoReflect.Descriptor instead.
+func (*TaskResourcesMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ChangePassword) GetOldPassword() string {
+func (x *TaskResourcesMsg) GetType() string {
 	if x != nil {
-		return x.OldPassword
+		return x.Type
 	}
 	return ""
 }
 
-func (x *ChangePassword) GetNewPassword() string {
+func (x *TaskResourcesMsg) GetName() string {
 	if x != nil {
-		return x.NewPassword
+		return x.Name
 	}
 	return ""
 }
 
-type CreateUserMsg struct {
+func (x *TaskResourcesMsg) GetOdate() string {
+	if x != nil {
+		return x.Odate
+	}
+	return ""
+}
+
+func (x *TaskResourcesMsg) GetSatisfied() bool {
+	if x != nil {
+		return x.Satisfied
+	}
+	return false
+}
+
+type TaskCyclicResultMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User     *UserAccount `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Password string       `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	IsCyclic bool   `protobuf:"varint,1,opt,name=isCyclic,proto3" json:"isCyclic,omitempty"`
+	NextRun  string `protobuf:"bytes,2,opt,name=nextRun,proto3" json:"nextRun,omitempty"`
+	RunFrom  string `protobuf:"bytes,3,opt,name=runFrom,proto3" json:"runFrom,omitempty"`
+	MaxRun   int32  `protobuf:"varint,4,opt,name=maxRun,proto3" json:"maxRun,omitempty"`
+	Interval int32  `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
 }
 
-func (x *CreateUserMsg) Reset() {
-	*x = CreateUserMsg{}
+func (x *TaskCyclicResultMsg) Reset() {
+	*x = TaskCyclicResultMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[27]
+		mi := &file_services_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateUserMsg) String() string {
+func (x *TaskCyclicResultMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserMsg) ProtoMessage() {}
+func (*TaskCyclicResultMsg) ProtoMessage() {}
 
-func (x *CreateUserMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[27]
+func (x *TaskCyclicResultMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1704,51 +1923,81 @@ func (x *CreateUserMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserMsg.ProtoReflect.Descriptor instead.
-func (*CreateUserMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *CreateUserMsg) GetUser() *UserAccount {
+// Deprecated: Use TaskCyclicResultMsg.ProtoReflect.Descriptor instead.
+func (*TaskCyclicResultMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TaskCyclicResultMsg) GetIsCyclic() bool {
 	if x != nil {
-		return x.User
+		return x.IsCyclic
 	}
-	return nil
+	return false
 }
 
-func (x *CreateUserMsg) GetPassword() string {
+func (x *TaskCyclicResultMsg) GetNextRun() string {
 	if x != nil {
-		return x.Password
+		return x.NextRun
 	}
 	return ""
 }
 
-type ModifyUserMsg struct {
+func (x *TaskCyclicResultMsg) GetRunFrom() string {
+	if x != nil {
+		return x.RunFrom
+	}
+	return ""
+}
+
+func (x *TaskCyclicResultMsg) GetMaxRun() int32 {
+	if x != nil {
+		return x.MaxRun
+	}
+	return 0
+}
+
+func (x *TaskCyclicResultMsg) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+type TaskDetailResultMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User     *UserAccount    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Password *ChangePassword `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Result      *ActionResultMsg     `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	BaseData    *TaskListResultMsg   `protobuf:"bytes,2,opt,name=baseData,proto3" json:"baseData,omitempty"`
+	Description string               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	From        string               `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To          string               `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	StartTime   string               `protobuf:"bytes,6,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime     string               `protobuf:"bytes,7,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Worker      string               `protobuf:"bytes,8,opt,name=worker,proto3" json:"worker,omitempty"`
+	Resources   []*TaskResourcesMsg  `protobuf:"bytes,9,rep,name=resources,proto3" json:"resources,omitempty"`
+	CyclicData  *TaskCyclicResultMsg `protobuf:"bytes,10,opt,name=cyclicData,proto3" json:"cyclicData,omitempty"`
+	Variables   map[string]string    `protobuf:"bytes,11,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ModifyUserMsg) Reset() {
-	*x = ModifyUserMsg{}
+func (x *TaskDetailResultMsg) Reset() {
+	*x = TaskDetailResultMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[28]
+		mi := &file_services_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ModifyUserMsg) String() string {
+func (x *TaskDetailResultMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModifyUserMsg) ProtoMessage() {}
+func (*TaskDetailResultMsg) ProtoMessage() {}
 
-func (x *ModifyUserMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[28]
+func (x *TaskDetailResultMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1759,82 +2008,98 @@ func (x *ModifyUserMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModifyUserMsg.ProtoReflect.Descriptor instead.
-func (*ModifyUserMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use TaskDetailResultMsg.ProtoReflect.Descriptor instead.
+func (*TaskDetailResultMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ModifyUserMsg) GetUser() *UserAccount {
+func (x *TaskDetailResultMsg) GetResult() *ActionResultMsg {
 	if x != nil {
-		return x.User
+		return x.Result
 	}
 	return nil
 }
 
-func (x *ModifyUserMsg) GetPassword() *ChangePassword {
+func (x *TaskDetailResultMsg) GetBaseData() *TaskListResultMsg {
 	if x != nil {
-		return x.Password
+		return x.BaseData
 	}
 	return nil
 }
 
-type UserResultMsg struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *TaskDetailResultMsg) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
 
-	User *UserAccount `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+func (x *TaskDetailResultMsg) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
 }
 
-func (x *UserResultMsg) Reset() {
-	*x = UserResultMsg{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TaskDetailResultMsg) GetTo() string {
+	if x != nil {
+		return x.To
 	}
+	return ""
 }
 
-func (x *UserResultMsg) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TaskDetailResultMsg) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
 }
 
-func (*UserResultMsg) ProtoMessage() {}
+func (x *TaskDetailResultMsg) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
 
-func (x *UserResultMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TaskDetailResultMsg) GetWorker() string {
+	if x != nil {
+		return x.Worker
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UserResultMsg.ProtoReflect.Descriptor instead.
-func (*UserResultMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{29}
+func (x *TaskDetailResultMsg) GetResources() []*TaskResourcesMsg {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
 }
 
-func (x *UserResultMsg) GetUser() *UserAccount {
+func (x *TaskDetailResultMsg) GetCyclicData() *TaskCyclicResultMsg {
 	if x != nil {
-		return x.User
+		return x.CyclicData
 	}
 	return nil
 }
 
-type UserMsg struct {
+func (x *TaskDetailResultMsg) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+type TaskDataMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Output []string `protobuf:"bytes,1,rep,name=output,proto3" json:"output,omitempty"`
 }
 
-func (x *UserMsg) Reset() {
-	*x = UserMsg{}
+func (x *TaskDataMsg) Reset() {
+	*x = TaskDataMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1842,13 +2107,13 @@ func (x *UserMsg) Reset() {
 	}
 }
 
-func (x *UserMsg) String() string {
+func (x *TaskDataMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserMsg) ProtoMessage() {}
+func (*TaskDataMsg) ProtoMessage() {}
 
-func (x *UserMsg) ProtoReflect() protoreflect.Message {
+func (x *TaskDataMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1860,28 +2125,28 @@ func (x *UserMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserMsg.ProtoReflect.Descriptor instead.
-func (*UserMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskDataMsg.ProtoReflect.Descriptor instead.
+func (*TaskDataMsg) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *UserMsg) GetUsername() string {
+func (x *TaskDataMsg) GetOutput() []string {
 	if x != nil {
-		return x.Username
+		return x.Output
 	}
-	return ""
+	return nil
 }
 
-type UserAccountMsg struct {
+type DefinitionListGroupResultMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User *UserAccount `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	GroupName []string `protobuf:"bytes,1,rep,name=groupName,proto3" json:"groupName,omitempty"`
 }
 
-func (x *UserAccountMsg) Reset() {
-	*x = UserAccountMsg{}
+func (x *DefinitionListGroupResultMsg) Reset() {
+	*x = DefinitionListGroupResultMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1889,13 +2154,13 @@ func (x *UserAccountMsg) Reset() {
 	}
 }
 
-func (x *UserAccountMsg) String() string {
+func (x *DefinitionListGroupResultMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAccountMsg) ProtoMessage() {}
+func (*DefinitionListGroupResultMsg) ProtoMessage() {}
 
-func (x *UserAccountMsg) ProtoReflect() protoreflect.Message {
+func (x *DefinitionListGroupResultMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1907,29 +2172,30 @@ func (x *UserAccountMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAccountMsg.ProtoReflect.Descriptor instead.
-func (*UserAccountMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use DefinitionListGroupResultMsg.ProtoReflect.Descriptor instead.
+func (*DefinitionListGroupResultMsg) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UserAccountMsg) GetUser() *UserAccount {
+func (x *DefinitionListGroupResultMsg) GetGroupName() []string {
 	if x != nil {
-		return x.User
+		return x.GroupName
 	}
 	return nil
 }
 
-type EntityMsg struct {
+type DefinitionListMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	GroupName   string `protobuf:"bytes,1,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	TaskName    string `protobuf:"bytes,2,opt,name=taskName,proto3" json:"taskName,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (x *EntityMsg) Reset() {
-	*x = EntityMsg{}
+func (x *DefinitionListMsg) Reset() {
+	*x = DefinitionListMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1937,13 +2203,13 @@ func (x *EntityMsg) Reset() {
 	}
 }
 
-func (x *EntityMsg) String() string {
+func (x *DefinitionListMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityMsg) ProtoMessage() {}
+func (*DefinitionListMsg) ProtoMessage() {}
 
-func (x *EntityMsg) ProtoReflect() protoreflect.Message {
+func (x *DefinitionListMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1955,35 +2221,42 @@ func (x *EntityMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityMsg.ProtoReflect.Descriptor instead.
-func (*EntityMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use DefinitionListMsg.ProtoReflect.Descriptor instead.
+func (*DefinitionListMsg) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *EntityMsg) GetName() string {
+func (x *DefinitionListMsg) GetGroupName() string {
 	if x != nil {
-		return x.Name
+		return x.GroupName
 	}
 	return ""
 }
 
-func (x *EntityMsg) GetDescription() string {
+func (x *DefinitionListMsg) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+func (x *DefinitionListMsg) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-type ListEntityResultMsg struct {
+type DefinitionListResultMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entity []*EntityMsg `protobuf:"bytes,1,rep,name=entity,proto3" json:"entity,omitempty"`
+	Definitions []*DefinitionListMsg `protobuf:"bytes,1,rep,name=definitions,proto3" json:"definitions,omitempty"`
 }
 
-func (x *ListEntityResultMsg) Reset() {
-	*x = ListEntityResultMsg{}
+func (x *DefinitionListResultMsg) Reset() {
+	*x = DefinitionListResultMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1991,13 +2264,13 @@ func (x *ListEntityResultMsg) Reset() {
 	}
 }
 
-func (x *ListEntityResultMsg) String() string {
+func (x *DefinitionListResultMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEntityResultMsg) ProtoMessage() {}
+func (*DefinitionListResultMsg) ProtoMessage() {}
 
-func (x *ListEntityResultMsg) ProtoReflect() protoreflect.Message {
+func (x *DefinitionListResultMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2009,28 +2282,32 @@ func (x *ListEntityResultMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEntityResultMsg.ProtoReflect.Descriptor instead.
-func (*ListEntityResultMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use DefinitionListResultMsg.ProtoReflect.Descriptor instead.
+func (*DefinitionListResultMsg) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListEntityResultMsg) GetEntity() []*EntityMsg {
+func (x *DefinitionListResultMsg) GetDefinitions() []*DefinitionListMsg {
 	if x != nil {
-		return x.Entity
+		return x.Definitions
 	}
 	return nil
 }
 
-type RoleMsg struct {
+type UserAccount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rolename string `protobuf:"bytes,1,opt,name=rolename,proto3" json:"rolename,omitempty"`
-}
-
-func (x *RoleMsg) Reset() {
-	*x = RoleMsg{}
+	Username string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Fullname string   `protobuf:"bytes,2,opt,name=fullname,proto3" json:"fullname,omitempty"`
+	Email    string   `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Enabled  bool     `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Roles    []string `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles,omitempty"`
+}
+
+func (x *UserAccount) Reset() {
+	*x = UserAccount{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2038,13 +2315,13 @@ func (x *RoleMsg) Reset() {
 	}
 }
 
-func (x *RoleMsg) String() string {
+func (x *UserAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleMsg) ProtoMessage() {}
+func (*UserAccount) ProtoMessage() {}
 
-func (x *RoleMsg) ProtoReflect() protoreflect.Message {
+func (x *UserAccount) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2056,42 +2333,57 @@ func (x *RoleMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleMsg.ProtoReflect.Descriptor instead.
-func (*RoleMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserAccount.ProtoReflect.Descriptor instead.
+func (*UserAccount) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *RoleMsg) GetRolename() string {
+func (x *UserAccount) GetUsername() string {
 	if x != nil {
-		return x.Rolename
+		return x.Username
 	}
 	return ""
 }
 
-type RoleDefinitionMsg struct {
+func (x *UserAccount) GetFullname() string {
+	if x != nil {
+		return x.Fullname
+	}
+	return ""
+}
+
+func (x *UserAccount) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserAccount) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UserAccount) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type ChangePassword struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Role           *RoleMsg `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	Description    string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Administration bool     `protobuf:"varint,3,opt,name=administration,proto3" json:"administration,omitempty"`
-	Restart        bool     `protobuf:"varint,4,opt,name=restart,proto3" json:"restart,omitempty"`
-	SetToOk        bool     `protobuf:"varint,5,opt,name=setToOk,proto3" json:"setToOk,omitempty"`
-	AddTicket      bool     `protobuf:"varint,6,opt,name=addTicket,proto3" json:"addTicket,omitempty"`
-	RemoveTicket   bool     `protobuf:"varint,7,opt,name=removeTicket,proto3" json:"removeTicket,omitempty"`
-	SetFlag        bool     `protobuf:"varint,8,opt,name=setFlag,proto3" json:"setFlag,omitempty"`
-	Confirm        bool     `protobuf:"varint,9,opt,name=confirm,proto3" json:"confirm,omitempty"`
-	Order          bool     `protobuf:"varint,10,opt,name=order,proto3" json:"order,omitempty"`
-	Force          bool     `protobuf:"varint,11,opt,name=force,proto3" json:"force,omitempty"`
-	Definition     bool     `protobuf:"varint,12,opt,name=definition,proto3" json:"definition,omitempty"`
-	Hold           bool     `protobuf:"varint,13,opt,name=hold,proto3" json:"hold,omitempty"`
-	Free           bool     `protobuf:"varint,14,opt,name=free,proto3" json:"free,omitempty"`
-	Bypass         bool     `protobuf:"varint,15,opt,name=bypass,proto3" json:"bypass,omitempty"`
+	OldPassword string `protobuf:"bytes,1,opt,name=oldPassword,proto3" json:"oldPassword,omitempty"`
+	NewPassword string `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
 }
 
-func (x *RoleDefinitionMsg) Reset() {
-	*x = RoleDefinitionMsg{}
+func (x *ChangePassword) Reset() {
+	*x = ChangePassword{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2099,13 +2391,13 @@ func (x *RoleDefinitionMsg) Reset() {
 	}
 }
 
-func (x *RoleDefinitionMsg) String() string {
+func (x *ChangePassword) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleDefinitionMsg) ProtoMessage() {}
+func (*ChangePassword) ProtoMessage() {}
 
-func (x *RoleDefinitionMsg) ProtoReflect() protoreflect.Message {
+func (x *ChangePassword) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2117,141 +2409,160 @@ func (x *RoleDefinitionMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleDefinitionMsg.ProtoReflect.Descriptor instead.
-func (*RoleDefinitionMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangePassword.ProtoReflect.Descriptor instead.
+func (*ChangePassword) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *RoleDefinitionMsg) GetRole() *RoleMsg {
+func (x *ChangePassword) GetOldPassword() string {
 	if x != nil {
-		return x.Role
+		return x.OldPassword
 	}
-	return nil
+	return ""
 }
 
-func (x *RoleDefinitionMsg) GetDescription() string {
+func (x *ChangePassword) GetNewPassword() string {
 	if x != nil {
-		return x.Description
+		return x.NewPassword
 	}
 	return ""
 }
 
-func (x *RoleDefinitionMsg) GetAdministration() bool {
-	if x != nil {
-		return x.Administration
-	}
-	return false
+type CreateUserMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User     *UserAccount `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Password string       `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *RoleDefinitionMsg) GetRestart() bool {
-	if x != nil {
-		return x.Restart
+func (x *CreateUserMsg) Reset() {
+	*x = CreateUserMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *RoleDefinitionMsg) GetSetToOk() bool {
-	if x != nil {
-		return x.SetToOk
-	}
-	return false
+func (x *CreateUserMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RoleDefinitionMsg) GetAddTicket() bool {
-	if x != nil {
-		return x.AddTicket
+func (*CreateUserMsg) ProtoMessage() {}
+
+func (x *CreateUserMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *RoleDefinitionMsg) GetRemoveTicket() bool {
-	if x != nil {
-		return x.RemoveTicket
-	}
-	return false
+// Deprecated: Use CreateUserMsg.ProtoReflect.Descriptor instead.
+func (*CreateUserMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *RoleDefinitionMsg) GetSetFlag() bool {
+func (x *CreateUserMsg) GetUser() *UserAccount {
 	if x != nil {
-		return x.SetFlag
+		return x.User
 	}
-	return false
+	return nil
 }
 
-func (x *RoleDefinitionMsg) GetConfirm() bool {
+func (x *CreateUserMsg) GetPassword() string {
 	if x != nil {
-		return x.Confirm
+		return x.Password
 	}
-	return false
+	return ""
 }
 
-func (x *RoleDefinitionMsg) GetOrder() bool {
-	if x != nil {
-		return x.Order
-	}
-	return false
+type ModifyUserMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User     *UserAccount    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Password *ChangePassword `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *RoleDefinitionMsg) GetForce() bool {
-	if x != nil {
-		return x.Force
+func (x *ModifyUserMsg) Reset() {
+	*x = ModifyUserMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *RoleDefinitionMsg) GetDefinition() bool {
-	if x != nil {
-		return x.Definition
-	}
-	return false
+func (x *ModifyUserMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RoleDefinitionMsg) GetHold() bool {
-	if x != nil {
-		return x.Hold
+func (*ModifyUserMsg) ProtoMessage() {}
+
+func (x *ModifyUserMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *RoleDefinitionMsg) GetFree() bool {
+// Deprecated: Use ModifyUserMsg.ProtoReflect.Descriptor instead.
+func (*ModifyUserMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ModifyUserMsg) GetUser() *UserAccount {
 	if x != nil {
-		return x.Free
+		return x.User
 	}
-	return false
+	return nil
 }
 
-func (x *RoleDefinitionMsg) GetBypass() bool {
+func (x *ModifyUserMsg) GetPassword() *ChangePassword {
 	if x != nil {
-		return x.Bypass
+		return x.Password
 	}
-	return false
+	return nil
 }
 
-type RoleResultMsg struct {
+type UserResultMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Role *RoleDefinitionMsg `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	User *UserAccount `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (x *RoleResultMsg) Reset() {
-	*x = RoleResultMsg{}
+func (x *UserResultMsg) Reset() {
+	*x = UserResultMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[36]
+		mi := &file_services_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RoleResultMsg) String() string {
+func (x *UserResultMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleResultMsg) ProtoMessage() {}
+func (*UserResultMsg) ProtoMessage() {}
 
-func (x *RoleResultMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[36]
+func (x *UserResultMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2262,29 +2573,981 @@ func (x *RoleResultMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleResultMsg.ProtoReflect.Descriptor instead.
-func (*RoleResultMsg) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use UserResultMsg.ProtoReflect.Descriptor instead.
+func (*UserResultMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *RoleResultMsg) GetRole() *RoleDefinitionMsg {
+func (x *UserResultMsg) GetUser() *UserAccount {
 	if x != nil {
-		return x.Role
+		return x.User
 	}
 	return nil
 }
 
-var File_services_proto protoreflect.FileDescriptor
+type UserMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_services_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x23, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x45, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *UserMsg) Reset() {
+	*x = UserMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserMsg) ProtoMessage() {}
+
+func (x *UserMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserMsg.ProtoReflect.Descriptor instead.
+func (*UserMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UserMsg) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UserAccountMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *UserAccount `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *UserAccountMsg) Reset() {
+	*x = UserAccountMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserAccountMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAccountMsg) ProtoMessage() {}
+
+func (x *UserAccountMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAccountMsg.ProtoReflect.Descriptor instead.
+func (*UserAccountMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UserAccountMsg) GetUser() *UserAccount {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type EntityMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *EntityMsg) Reset() {
+	*x = EntityMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityMsg) ProtoMessage() {}
+
+func (x *EntityMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityMsg.ProtoReflect.Descriptor instead.
+func (*EntityMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *EntityMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EntityMsg) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ListEntityResultMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entity []*EntityMsg `protobuf:"bytes,1,rep,name=entity,proto3" json:"entity,omitempty"`
+}
+
+func (x *ListEntityResultMsg) Reset() {
+	*x = ListEntityResultMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEntityResultMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntityResultMsg) ProtoMessage() {}
+
+func (x *ListEntityResultMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntityResultMsg.ProtoReflect.Descriptor instead.
+func (*ListEntityResultMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListEntityResultMsg) GetEntity() []*EntityMsg {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+type RoleMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rolename string `protobuf:"bytes,1,opt,name=rolename,proto3" json:"rolename,omitempty"`
+}
+
+func (x *RoleMsg) Reset() {
+	*x = RoleMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleMsg) ProtoMessage() {}
+
+func (x *RoleMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleMsg.ProtoReflect.Descriptor instead.
+func (*RoleMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RoleMsg) GetRolename() string {
+	if x != nil {
+		return x.Rolename
+	}
+	return ""
+}
+
+type RoleDefinitionMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role           *RoleMsg `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Description    string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Administration bool     `protobuf:"varint,3,opt,name=administration,proto3" json:"administration,omitempty"`
+	Restart        bool     `protobuf:"varint,4,opt,name=restart,proto3" json:"restart,omitempty"`
+	SetToOk        bool     `protobuf:"varint,5,opt,name=setToOk,proto3" json:"setToOk,omitempty"`
+	AddTicket      bool     `protobuf:"varint,6,opt,name=addTicket,proto3" json:"addTicket,omitempty"`
+	RemoveTicket   bool     `protobuf:"varint,7,opt,name=removeTicket,proto3" json:"removeTicket,omitempty"`
+	SetFlag        bool     `protobuf:"varint,8,opt,name=setFlag,proto3" json:"setFlag,omitempty"`
+	Confirm        bool     `protobuf:"varint,9,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	Order          bool     `protobuf:"varint,10,opt,name=order,proto3" json:"order,omitempty"`
+	Force          bool     `protobuf:"varint,11,opt,name=force,proto3" json:"force,omitempty"`
+	Definition     bool     `protobuf:"varint,12,opt,name=definition,proto3" json:"definition,omitempty"`
+	Hold           bool     `protobuf:"varint,13,opt,name=hold,proto3" json:"hold,omitempty"`
+	Free           bool     `protobuf:"varint,14,opt,name=free,proto3" json:"free,omitempty"`
+	Bypass         bool     `protobuf:"varint,15,opt,name=bypass,proto3" json:"bypass,omitempty"`
+	Variables      bool     `protobuf:"varint,16,opt,name=variables,proto3" json:"variables,omitempty"`
+	Namespaces     []string `protobuf:"bytes,17,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+}
+
+func (x *RoleDefinitionMsg) Reset() {
+	*x = RoleDefinitionMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleDefinitionMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleDefinitionMsg) ProtoMessage() {}
+
+func (x *RoleDefinitionMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleDefinitionMsg.ProtoReflect.Descriptor instead.
+func (*RoleDefinitionMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RoleDefinitionMsg) GetRole() *RoleMsg {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+func (x *RoleDefinitionMsg) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RoleDefinitionMsg) GetAdministration() bool {
+	if x != nil {
+		return x.Administration
+	}
+	return false
+}
+
+func (x *RoleDefinitionMsg) GetRestart() bool {
+	if x != nil {
+		return x.Restart
+	}
+	return false
+}
+
+func (x *RoleDefinitionMsg) GetSetToOk() bool {
+	if x != nil {
+		return x.SetToOk
+	}
+	return false
+}
+
+func (x *RoleDefinitionMsg) GetAddTicket() bool {
+	if x != nil {
+		return x.AddTicket
+	}
+	return false
+}
+
+func (x *RoleDefinitionMsg) GetRemoveTicket() bool {
+	if x != nil {
+		return x.RemoveTicket
+	}
+	return false
+}
+
+func (x *RoleDefinitionMsg) GetSetFlag() bool {
+	if x != nil {
+		return x.SetFlag
+	}
+	return false
+}
+
+func (x *RoleDefinitionMsg) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+func (x *RoleDefinitionMsg) GetOrder() bool {
+	if x != nil {
+		return x.Order
+	}
+	return false
+}
+
+func (x *RoleDefinitionMsg) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *RoleDefinitionMsg) GetDefinition() bool {
+	if x != nil {
+		return x.Definition
+	}
+	return false
+}
+
+func (x *RoleDefinitionMsg) GetHold() bool {
+	if x != nil {
+		return x.Hold
+	}
+	return false
+}
+
+func (x *RoleDefinitionMsg) GetFree() bool {
+	if x != nil {
+		return x.Free
+	}
+	return false
+}
+
+func (x *RoleDefinitionMsg) GetBypass() bool {
+	if x != nil {
+		return x.Bypass
+	}
+	return false
+}
+
+func (x *RoleDefinitionMsg) GetVariables() bool {
+	if x != nil {
+		return x.Variables
+	}
+	return false
+}
+
+func (x *RoleDefinitionMsg) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+type NewDayProcMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Odate string `protobuf:"bytes,1,opt,name=odate,proto3" json:"odate,omitempty"`
+}
+
+func (x *NewDayProcMsg) Reset() {
+	*x = NewDayProcMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewDayProcMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewDayProcMsg) ProtoMessage() {}
+
+func (x *NewDayProcMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewDayProcMsg.ProtoReflect.Descriptor instead.
+func (*NewDayProcMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *NewDayProcMsg) GetOdate() string {
+	if x != nil {
+		return x.Odate
+	}
+	return ""
+}
+
+type SecretMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SecretMsg) Reset() {
+	*x = SecretMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretMsg) ProtoMessage() {}
+
+func (x *SecretMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretMsg.ProtoReflect.Descriptor instead.
+func (*SecretMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SecretMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretMsg) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type WorkerMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *WorkerMsg) Reset() {
+	*x = WorkerMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerMsg) ProtoMessage() {}
+
+func (x *WorkerMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerMsg.ProtoReflect.Descriptor instead.
+func (*WorkerMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *WorkerMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WorkerInfoMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	State   string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	//true if a worker registered itself, false if it is defined in the configuration
+	Registered   bool     `protobuf:"varint,4,opt,name=registered,proto3" json:"registered,omitempty"`
+	Capabilities []string `protobuf:"bytes,5,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Tasks        int32    `protobuf:"varint,6,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	TasksLimit   int32    `protobuf:"varint,7,opt,name=tasksLimit,proto3" json:"tasksLimit,omitempty"`
+	Pool         string   `protobuf:"bytes,8,opt,name=pool,proto3" json:"pool,omitempty"`
+	Tags         []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	LastSeen     string   `protobuf:"bytes,10,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+	//tasks sent to a worker that are not completed yet
+	Executions int32 `protobuf:"varint,11,opt,name=executions,proto3" json:"executions,omitempty"`
+	Cpuload    int32 `protobuf:"varint,12,opt,name=cpuload,proto3" json:"cpuload,omitempty"`
+	Memused    int32 `protobuf:"varint,13,opt,name=memused,proto3" json:"memused,omitempty"`
+	Memtotal   int32 `protobuf:"varint,14,opt,name=memtotal,proto3" json:"memtotal,omitempty"`
+	Diskfree   int64 `protobuf:"varint,15,opt,name=diskfree,proto3" json:"diskfree,omitempty"`
+	Weight     int32 `protobuf:"varint,16,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *WorkerInfoMsg) Reset() {
+	*x = WorkerInfoMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerInfoMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerInfoMsg) ProtoMessage() {}
+
+func (x *WorkerInfoMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerInfoMsg.ProtoReflect.Descriptor instead.
+func (*WorkerInfoMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *WorkerInfoMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkerInfoMsg) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WorkerInfoMsg) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *WorkerInfoMsg) GetRegistered() bool {
+	if x != nil {
+		return x.Registered
+	}
+	return false
+}
+
+func (x *WorkerInfoMsg) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *WorkerInfoMsg) GetTasks() int32 {
+	if x != nil {
+		return x.Tasks
+	}
+	return 0
+}
+
+func (x *WorkerInfoMsg) GetTasksLimit() int32 {
+	if x != nil {
+		return x.TasksLimit
+	}
+	return 0
+}
+
+func (x *WorkerInfoMsg) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *WorkerInfoMsg) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *WorkerInfoMsg) GetLastSeen() string {
+	if x != nil {
+		return x.LastSeen
+	}
+	return ""
+}
+
+func (x *WorkerInfoMsg) GetExecutions() int32 {
+	if x != nil {
+		return x.Executions
+	}
+	return 0
+}
+
+func (x *WorkerInfoMsg) GetCpuload() int32 {
+	if x != nil {
+		return x.Cpuload
+	}
+	return 0
+}
+
+func (x *WorkerInfoMsg) GetMemused() int32 {
+	if x != nil {
+		return x.Memused
+	}
+	return 0
+}
+
+func (x *WorkerInfoMsg) GetMemtotal() int32 {
+	if x != nil {
+		return x.Memtotal
+	}
+	return 0
+}
+
+func (x *WorkerInfoMsg) GetDiskfree() int64 {
+	if x != nil {
+		return x.Diskfree
+	}
+	return 0
+}
+
+func (x *WorkerInfoMsg) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type WorkerListResultMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workers []*WorkerInfoMsg `protobuf:"bytes,1,rep,name=workers,proto3" json:"workers,omitempty"`
+}
+
+func (x *WorkerListResultMsg) Reset() {
+	*x = WorkerListResultMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerListResultMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerListResultMsg) ProtoMessage() {}
+
+func (x *WorkerListResultMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerListResultMsg.ProtoReflect.Descriptor instead.
+func (*WorkerListResultMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WorkerListResultMsg) GetWorkers() []*WorkerInfoMsg {
+	if x != nil {
+		return x.Workers
+	}
+	return nil
+}
+
+type ConcurrencyLimitMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//global, group or type
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	//a name of a group or a type, empty for the global limit
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	//a maximum number of tasks executed at the same time, zero means no limit
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	//a number of currently executed tasks
+	Running int32 `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`
+}
+
+func (x *ConcurrencyLimitMsg) Reset() {
+	*x = ConcurrencyLimitMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConcurrencyLimitMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConcurrencyLimitMsg) ProtoMessage() {}
+
+func (x *ConcurrencyLimitMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConcurrencyLimitMsg.ProtoReflect.Descriptor instead.
+func (*ConcurrencyLimitMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ConcurrencyLimitMsg) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ConcurrencyLimitMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConcurrencyLimitMsg) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ConcurrencyLimitMsg) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+type ConcurrencyLimitListMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limits []*ConcurrencyLimitMsg `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+}
+
+func (x *ConcurrencyLimitListMsg) Reset() {
+	*x = ConcurrencyLimitListMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConcurrencyLimitListMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConcurrencyLimitListMsg) ProtoMessage() {}
+
+func (x *ConcurrencyLimitListMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConcurrencyLimitListMsg.ProtoReflect.Descriptor instead.
+func (*ConcurrencyLimitListMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ConcurrencyLimitListMsg) GetLimits() []*ConcurrencyLimitMsg {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type RoleResultMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role *RoleDefinitionMsg `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *RoleResultMsg) Reset() {
+	*x = RoleResultMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleResultMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleResultMsg) ProtoMessage() {}
+
+func (x *RoleResultMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleResultMsg.ProtoReflect.Descriptor instead.
+func (*RoleResultMsg) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RoleResultMsg) GetRole() *RoleDefinitionMsg {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+var File_services_proto protoreflect.FileDescriptor
+
+var file_services_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x23, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x45, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x1b, 0x2a, 0x07, 0x6d, 0x65,
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x10, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20,
@@ -2299,327 +3562,454 @@ var file_services_proto_rawDesc = []byte{
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
 	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
-	0x3b, 0x0a, 0x0f, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x0a, 0x0f, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
 	0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x22, 0x39, 0x0a, 0x0d,
-	0x46, 0x6c, 0x61, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x27, 0x0a, 0x0d, 0x54, 0x61, 0x73, 0x6b, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44,
-	0x22, 0xa1, 0x01, 0x0a, 0x0d, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d,
-	0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61,
-	0x73, 0x6b, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f,
-	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72,
-	0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x5e, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x4d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
-	0x64, 0x61, 0x74, 0x65, 0x22, 0x47, 0x0a, 0x11, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x73,
-	0x6b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61,
-	0x73, 0x6b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x22, 0x49, 0x0a,
-	0x0d, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x1c,
-	0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x13, 0x44, 0x65, 0x66, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12,
-	0x3a, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
-	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x0d, 0x64, 0x65,
-	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x22, 0x47, 0x0a, 0x11, 0x44,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x65, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6b, 0x65, 0x65, 0x70,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x4f, 0x0a, 0x0d, 0x46, 0x6c, 0x61, 0x67, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x51, 0x0a, 0x0d, 0x46, 0x6c, 0x61, 0x67, 0x48,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x7d, 0x0a, 0x11, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x43, 0x0a, 0x11, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0x27,
+	0x0a, 0x0d, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x22, 0xa1, 0x01, 0x0a, 0x0d, 0x54, 0x61, 0x73, 0x6b,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6f,
+	0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6f, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xfa, 0x01, 0x0a, 0x0c,
+	0x54, 0x61, 0x73, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x61, 0x73, 0x6b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x61, 0x73, 0x6b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61,
+	0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61,
+	0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x09,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x4d, 0x73, 0x67, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x47, 0x0a, 0x11, 0x54, 0x61, 0x73, 0x6b,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x61, 0x73, 0x6b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x64, 0x61, 0x74,
+	0x65, 0x22, 0x49, 0x0a, 0x0d, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x13,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x67, 0x12, 0x3a, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67,
+	0x52, 0x0d, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x22,
+	0x47, 0x0a, 0x11, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x55, 0x0a, 0x13, 0x44, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12,
+	0x3e, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
 	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x55, 0x0a, 0x13, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x3e, 0x0a, 0x0d, 0x64,
-	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0d, 0x64, 0x65,
-	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x22, 0x45, 0x0a, 0x0f, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x45, 0x0a, 0x11, 0x46, 0x6c, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6c, 0x61, 0x67, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6c, 0x61, 0x67, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x3f, 0x0a, 0x13, 0x54, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x22, 0x8d, 0x02, 0x0a, 0x11, 0x54,
-	0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67,
-	0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61,
-	0x73, 0x6b, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b,
-	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x61, 0x74, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18,
-	0x0a, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x6c, 0x64,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x65, 0x6c, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x22, 0x6e, 0x0a, 0x10, 0x54, 0x61,
-	0x73, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x22,
+	0x45, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d,
+	0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x75, 0x0a, 0x11, 0x46, 0x6c, 0x61, 0x67, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x6c, 0x61, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x6c, 0x61, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0xdb, 0x01,
+	0x0a, 0x13, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x65, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6b,
+	0x65, 0x65, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a,
+	0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x42, 0x0a, 0x0e, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x12, 0x30, 0x0a,
+	0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22,
+	0x7f, 0x0a, 0x0f, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
+	0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x22, 0x99, 0x01, 0x0a, 0x13, 0x54,
-	0x61, 0x73, 0x6b, 0x43, 0x79, 0x63, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d,
-	0x73, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x43, 0x79, 0x63, 0x6c, 0x69, 0x63, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x43, 0x79, 0x63, 0x6c, 0x69, 0x63, 0x12, 0x18,
-	0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x46,
-	0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x46, 0x72,
-	0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x52, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x52, 0x75, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x84, 0x03, 0x0a, 0x13, 0x54, 0x61, 0x73, 0x6b, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x2e,
-	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x34,
-	0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x79, 0x63, 0x6c, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61,
-	0x73, 0x6b, 0x43, 0x79, 0x63, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73,
-	0x67, 0x52, 0x0a, 0x63, 0x79, 0x63, 0x6c, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x22, 0x25, 0x0a,
-	0x0b, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x22, 0x3c, 0x0a, 0x1c, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x22, 0xad, 0x01, 0x0a, 0x15, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73,
+	0x67, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0xda, 0x01, 0x0a, 0x14, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x81, 0x01,
+	0x0a, 0x15, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x5b, 0x0a, 0x15, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x22, 0x8d,
+	0x02, 0x0a, 0x11, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
 	0x74, 0x4d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61,
-	0x6d, 0x65, 0x22, 0x6f, 0x0a, 0x11, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x17, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x3a,
-	0x0a, 0x0b, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x66, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x0b, 0x64,
-	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x0b, 0x55,
-	0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x54, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6c,
-	0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b,
-	0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x53,
-	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x65, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x65, 0x6c, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x22, 0x6e,
+	0x0a, 0x10, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x4d,
+	0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x22, 0x99,
+	0x01, 0x0a, 0x13, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x79, 0x63, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x43, 0x79, 0x63, 0x6c,
+	0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x43, 0x79, 0x63, 0x6c,
+	0x69, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x75, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
+	0x75, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x52, 0x75, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x52, 0x75, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x8b, 0x04, 0x0a, 0x13, 0x54,
+	0x61, 0x73, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d,
+	0x73, 0x67, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x08,
+	0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x35,
+	0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x79, 0x63, 0x6c, 0x69, 0x63, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x79, 0x63, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x0a, 0x63, 0x79, 0x63, 0x6c, 0x69, 0x63, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x47, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67,
+	0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x25, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b,
+	0x44, 0x61, 0x74, 0x61, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22,
+	0x3c, 0x0a, 0x1c, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12,
+	0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6f, 0x0a,
+	0x11, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x55,
+	0x0a, 0x17, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x3a, 0x0a, 0x0b, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x22, 0x54, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65,
+	0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x53, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x6a,
+	0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12,
 	0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x22, 0x6a, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65,
-	0x72, 0x4d, 0x73, 0x67, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
-	0x37, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67,
-	0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x25, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72,
-	0x4d, 0x73, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x38, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x73,
-	0x67, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x09, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x13,
-	0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x25, 0x0a,
-	0x07, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb7, 0x03, 0x0a, 0x11, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x22, 0x0a, 0x04, 0x72, 0x6f,
-	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x26, 0x0a, 0x0e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4f, 0x6b, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4f, 0x6b, 0x12, 0x1c, 0x0a, 0x09,
-	0x61, 0x64, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x61, 0x64, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x65, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x65, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x72, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x6f,
-	0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x22, 0x3d,
-	0x0a, 0x0d, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12,
-	0x2c, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x32, 0x73, 0x0a,
-	0x13, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a,
-	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x22,
-	0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x3a,
-	0x01, 0x2a, 0x32, 0x82, 0x0f, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8d, 0x02, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x4d, 0x73, 0x67, 0x22, 0xcf, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x1a, 0x15, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x74, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0xab, 0x01, 0x1a, 0x22, 0x41, 0x64, 0x64,
-	0x73, 0x20, 0x61, 0x20, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68,
-	0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0x4a,
-	0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0xa1, 0x02, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a,
-	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xe0, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26,
-	0x2a, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b,
-	0x6f, 0x64, 0x61, 0x74, 0x65, 0x7d, 0x92, 0x41, 0xb0, 0x01, 0x1a, 0x27, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x20, 0x66, 0x72, 0x6f,
-	0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x70,
-	0x6f, 0x6f, 0x6c, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b,
-	0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0x96, 0x02, 0x0a, 0x0b, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xd6, 0x01, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x2f, 0x7b, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x7d, 0x92, 0x41, 0xa6, 0x01, 0x1a, 0x1d, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x73, 0x20, 0x69, 0x66, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x74,
-	0x69, 0x63, 0x6b, 0x65, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x4a, 0x84, 0x01, 0x0a,
-	0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69,
-	0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x7d, 0x12, 0x8d, 0x02, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x1a, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xc7, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
-	0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0xa2, 0x01, 0x1a,
-	0x19, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20,
-	0x6f, 0x66, 0x20, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34,
-	0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67,
-	0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30,
-	0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20,
-	0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x7d, 0x30, 0x01, 0x12, 0x81, 0x02, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12,
-	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xc7, 0x01,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x1a, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x3a, 0x01, 0x2a, 0x92, 0x41,
-	0xa5, 0x01, 0x1a, 0x1c, 0x53, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x20,
-	0x69, 0x6e, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0xfc, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x74,
-	0x72, 0x6f, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x46, 0x6c, 0x61, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xbe, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x66,
-	0x6c, 0x61, 0x67, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x92, 0x41, 0x98, 0x01, 0x1a, 0x0f,
-	0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x73, 0x20, 0x61, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x4a,
+	0x74, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x37, 0x0a, 0x0d, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x26, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x22, 0x25, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x38, 0x0a, 0x0e, 0x55, 0x73,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x26, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x09, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x73,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x28,
+	0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x73, 0x67,
+	0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x25, 0x0a, 0x07, 0x52, 0x6f, 0x6c, 0x65,
+	0x4d, 0x73, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0xf5, 0x03, 0x0a, 0x11, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x22, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x4d, 0x73, 0x67, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4f, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x65, 0x74, 0x54, 0x6f, 0x4f, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x64, 0x64, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x74,
+	0x46, 0x6c, 0x61, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x65, 0x74, 0x46,
+	0x6c, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x72, 0x65,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x0d, 0x4e, 0x65, 0x77, 0x44, 0x61,
+	0x79, 0x50, 0x72, 0x6f, 0x63, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x22, 0x35,
+	0x0a, 0x09, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1f, 0x0a, 0x09, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4d,
+	0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb5, 0x03, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c,
+	0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x73, 0x6b,
+	0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x70,
+	0x75, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x70, 0x75,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x75, 0x73, 0x65, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x75, 0x73, 0x65, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6d, 0x65, 0x6d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x6b, 0x66, 0x72, 0x65, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x6b, 0x66, 0x72, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x45,
+	0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x2e, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x73, 0x67, 0x52, 0x07, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x73, 0x22, 0x6d, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x22, 0x4d, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x12,
+	0x32, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x06, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x22, 0x3d, 0x0a, 0x0d, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x4d, 0x73, 0x67, 0x12, 0x2c, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x32, 0x73, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x19, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x3a, 0x01, 0x2a, 0x32, 0xca, 0x26, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8d, 0x02, 0x0a, 0x09,
+	0x41, 0x64, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xcf, 0x01, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1a, 0x1a, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0xab, 0x01,
+	0x1a, 0x22, 0x41, 0x64, 0x64, 0x73, 0x20, 0x61, 0x20, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x20,
+	0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20,
+	0x70, 0x6f, 0x6f, 0x6c, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b,
+	0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0xa1, 0x02, 0x0a, 0x0c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xe0, 0x01, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x26, 0x2a, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x7d, 0x92, 0x41, 0xb0, 0x01, 0x1a,
+	0x27, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30,
+	0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e,
+	0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f,
+	0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12,
+	0x96, 0x02, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22,
+	0xd6, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x7d, 0x92, 0x41,
+	0xa6, 0x01, 0x1a, 0x1d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x20, 0x69, 0x66, 0x20, 0x67, 0x69,
+	0x76, 0x65, 0x6e, 0x20, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a,
+	0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0x8d, 0x02, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67,
+	0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xc7, 0x01, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x3a, 0x01, 0x2a,
+	0x92, 0x41, 0xa2, 0x01, 0x1a, 0x19, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20,
+	0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x4a,
 	0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65,
 	0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72,
 	0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
@@ -2628,34 +4018,128 @@ var file_services_proto_rawDesc = []byte{
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72,
 	0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0xf7, 0x01, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x46,
-	0x6c, 0x61, 0x67, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6c, 0x61,
-	0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x4d, 0x73, 0x67, 0x22, 0xb7, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x66, 0x6c,
-	0x61, 0x67, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x94, 0x01, 0x1a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
-	0x73, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12,
-	0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a,
-	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a,
-	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
-	0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x30, 0x01,
-	0x1a, 0x15, 0x92, 0x41, 0x12, 0x12, 0x10, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0xec, 0x25, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x98, 0x02, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61,
-	0x73, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x30, 0x01, 0x12, 0xc0, 0x02, 0x0a, 0x0a, 0x41, 0x64, 0x64,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x1a, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x82, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x1a,
+	0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x3a, 0x01, 0x2a,
+	0x92, 0x41, 0xd7, 0x01, 0x1a, 0x4e, 0x41, 0x64, 0x64, 0x73, 0x20, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x20, 0x61, 0x74, 0x20, 0x6f, 0x6e, 0x63, 0x65, 0x2c, 0x20, 0x69, 0x66, 0x20, 0x61,
+	0x6e, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x20, 0x63, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x20, 0x61, 0x64, 0x64, 0x65, 0x64, 0x2c, 0x20, 0x6e,
+	0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x6d, 0x20, 0x69, 0x73, 0x20, 0x61,
+	0x64, 0x64, 0x65, 0x64, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b,
+	0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0x8a, 0x03, 0x0a, 0x0d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x4d, 0x73, 0x67, 0x22, 0xc2, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41,
+	0x96, 0x02, 0x1a, 0x8c, 0x01, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x20,
+	0x6e, 0x61, 0x6d, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6f, 0x64, 0x61, 0x74, 0x65, 0x20, 0x70,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x2c, 0x20, 0x61, 0x20, 0x64, 0x72, 0x79, 0x20, 0x72,
+	0x75, 0x6e, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x69, 0x6e, 0x67, 0x20, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2c, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66,
+	0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a,
+	0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0x9d, 0x02, 0x0a, 0x0b, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67,
+	0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xd6, 0x01,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x61,
+	0x75, 0x64, 0x69, 0x74, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0xab, 0x01, 0x1a, 0x22, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x75, 0x64, 0x69, 0x74, 0x20, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x4a,
+	0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x30, 0x01, 0x12, 0x81, 0x02, 0x0a, 0x07, 0x53, 0x65, 0x74,
+	0x46, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6c, 0x61,
+	0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d,
-	0x73, 0x67, 0x22, 0xdd, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x1a, 0x0f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x92,
-	0x41, 0xbf, 0x01, 0x1a, 0x36, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x20, 0x61, 0x20, 0x6e, 0x65,
-	0x77, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x2c, 0x20, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x20, 0x69,
-	0x66, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6d, 0x65, 0x74, 0x4a, 0x84, 0x01, 0x0a, 0x03,
+	0x73, 0x67, 0x22, 0xc7, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x1a, 0x13, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67,
+	0x3a, 0x01, 0x2a, 0x92, 0x41, 0xa5, 0x01, 0x1a, 0x1c, 0x53, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20,
+	0x66, 0x6c, 0x61, 0x67, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a,
+	0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0xfc, 0x01, 0x0a,
+	0x0b, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xbe, 0x01, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1c, 0x2a, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x92,
+	0x41, 0x98, 0x01, 0x1a, 0x0f, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x73, 0x20, 0x61, 0x20,
+	0x66, 0x6c, 0x61, 0x67, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b,
+	0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0xac, 0x02, 0x0a, 0x11,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22,
+	0xe8, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x2a, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x68, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x7d, 0x92, 0x41, 0xb2, 0x01, 0x1a, 0x29, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x66,
+	0x75, 0x6c, 0x6c, 0x79, 0x20, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x20, 0x61, 0x20,
+	0x66, 0x6c, 0x61, 0x67, 0x20, 0x68, 0x65, 0x6c, 0x64, 0x20, 0x62, 0x79, 0x20, 0x61, 0x20, 0x74,
+	0x61, 0x73, 0x6b, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b,
+	0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0xf7, 0x01, 0x0a, 0x09, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x46, 0x6c, 0x61, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x18,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xb7, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x19, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x94, 0x01, 0x1a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x73, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x4a, 0x84, 0x01, 0x0a, 0x03,
 	0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e,
 	0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
 	0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f,
@@ -2664,70 +4148,125 @@ var file_services_proto_rawDesc = []byte{
 	0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a,
 	0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x7d, 0x12, 0x9e, 0x02, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x54, 0x61, 0x73, 0x6b,
-	0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xe3, 0x01,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x1a, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61, 0x73,
-	0x6b, 0x2f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0xc5, 0x01, 0x1a, 0x3c,
-	0x46, 0x6f, 0x72, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x73, 0x20, 0x61, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x2c, 0x20, 0x77, 0x69,
-	0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x20, 0x70,
-	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x84, 0x01, 0x0a,
-	0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69,
-	0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x7d, 0x12, 0xa4, 0x02, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x4d, 0x73, 0x67, 0x22, 0xe3, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x1a, 0x10, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x3a,
-	0x01, 0x2a, 0x92, 0x41, 0xc4, 0x01, 0x1a, 0x3b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x20, 0x61,
-	0x6e, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x72, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2c, 0x20,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x20, 0x69, 0x66, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x70, 0x72,
-	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20,
-	0x6d, 0x65, 0x74, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b,
-	0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0xaa, 0x02, 0x0a, 0x0a, 0x46,
-	0x6f, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x22, 0x7d, 0x30, 0x01, 0x12, 0xa1, 0x02, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xdf, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x1a,
+	0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0xb9, 0x01, 0x1a,
+	0x30, 0x53, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x2c, 0x20, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x20, 0x6f, 0x72, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x20, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a,
+	0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0x92, 0x02, 0x0a, 0x0e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xcd, 0x01,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x2a, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x2f,
+	0x7b, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x92, 0x41,
+	0x9b, 0x01, 0x1a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d,
+	0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0x8b, 0x02,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xbf, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
+	0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x2f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x98,
+	0x01, 0x1a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x20, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x30, 0x01, 0x12, 0xa8, 0x02, 0x0a, 0x0b,
+	0x53, 0x65, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xe6, 0x01,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x1a, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x3a,
+	0x01, 0x2a, 0x92, 0x41, 0xc0, 0x01, 0x1a, 0x37, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20,
+	0x61, 0x20, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x20, 0x69, 0x74, 0x73, 0x20, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x4a,
+	0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0x97, 0x02, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xe9, 0x01, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x15, 0x1a, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0xca, 0x01, 0x1a, 0x41, 0x46,
-	0x6f, 0x72, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
-	0x20, 0x61, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x72, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2c, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x69,
-	0x6e, 0x67, 0x20, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0x9b, 0x03, 0x0a, 0x0b, 0x45, 0x6e, 0x66, 0x6f,
-	0x72, 0x63, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x54, 0x61, 0x73, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xdd, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x1a, 0x1a,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x49,
-	0x44, 0x7d, 0x2f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x92, 0x41, 0xb7, 0x02, 0x1a, 0x18,
-	0x46, 0x6f, 0x72, 0x63, 0x69, 0x62, 0x6c, 0x79, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
-	0x73, 0x20, 0x61, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xd2, 0x01, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x20, 0x2a, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x92, 0x41, 0xa8, 0x01, 0x1a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x73, 0x20, 0x61, 0x20, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30,
+	0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a,
+	0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20,
+	0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d,
+	0x12, 0x9a, 0x02, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x1c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xcd, 0x01, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x3a,
+	0x01, 0x2a, 0x92, 0x41, 0xa5, 0x01, 0x1a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x20, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b,
+	0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x30, 0x01, 0x1a, 0x15, 0x92,
+	0x41, 0x12, 0x12, 0x10, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x32, 0xec, 0x25, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x98, 0x02, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22,
+	0xdd, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x1a, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74,
+	0x61, 0x73, 0x6b, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0xbf, 0x01,
+	0x1a, 0x36, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x20, 0x61, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x74,
+	0x61, 0x73, 0x6b, 0x2c, 0x20, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x20, 0x69, 0x66, 0x20, 0x61,
+	0x6c, 0x6c, 0x20, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x20, 0x61, 0x72, 0x65, 0x20, 0x6d, 0x65, 0x74, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30,
 	0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e,
 	0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
@@ -2735,170 +4274,121 @@ var file_services_proto_rawDesc = []byte{
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f,
 	0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
 	0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x4a,
-	0x93, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x8b, 0x01, 0x0a, 0x26, 0x54, 0x68, 0x65, 0x20,
-	0x74, 0x61, 0x73, 0x6b, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65,
-	0x6e, 0x20, 0x69, 0x64, 0x20, 0x77, 0x61, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75,
-	0x6e, 0x64, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0x8c, 0x03, 0x0a, 0x09, 0x52, 0x65, 0x72, 0x75, 0x6e, 0x54,
-	0x61, 0x73, 0x6b, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12,
+	0x9e, 0x02, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x13, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d,
+	0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xe3, 0x01, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x14, 0x1a, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0xc5, 0x01, 0x1a, 0x3c, 0x46, 0x6f, 0x72,
+	0x63, 0x65, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x20, 0x61,
+	0x20, 0x6e, 0x65, 0x77, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x2c, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f,
+	0x75, 0x74, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x20, 0x70, 0x72, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30,
+	0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a,
+	0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20,
+	0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d,
+	0x12, 0xa4, 0x02, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73,
-	0x67, 0x22, 0xd0, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x1a, 0x18, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x7d, 0x2f, 0x72,
-	0x65, 0x72, 0x75, 0x6e, 0x92, 0x41, 0xac, 0x02, 0x1a, 0x0d, 0x52, 0x65, 0x72, 0x75, 0x6e, 0x73,
-	0x20, 0x61, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12,
-	0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a,
-	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a,
-	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
-	0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x4a, 0x93,
-	0x01, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x8b, 0x01, 0x0a, 0x26, 0x54, 0x68, 0x65, 0x20, 0x74,
-	0x61, 0x73, 0x6b, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e,
-	0x20, 0x69, 0x64, 0x20, 0x77, 0x61, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e,
-	0x64, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72,
+	0x67, 0x22, 0xe3, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x1a, 0x10, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x92,
+	0x41, 0xc4, 0x01, 0x1a, 0x3b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x65,
+	0x6e, 0x74, 0x69, 0x72, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2c, 0x20, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x20, 0x69, 0x66, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x70, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6d, 0x65, 0x74,
+	0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0xaa, 0x02, 0x0a, 0x0a, 0x46, 0x6f, 0x72, 0x63,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67,
+	0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xe9, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x15, 0x1a, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0xca, 0x01, 0x1a, 0x41, 0x46, 0x6f, 0x72, 0x63,
+	0x65, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x20, 0x61, 0x6e,
+	0x20, 0x65, 0x6e, 0x74, 0x69, 0x72, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2c, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x20,
+	0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x84, 0x01,
+	0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72,
 	0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72,
 	0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x7d, 0x12, 0x9c, 0x03, 0x0a, 0x08, 0x48, 0x6f, 0x6c, 0x64, 0x54, 0x61, 0x73,
-	0x6b, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22,
-	0xe1, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x1a, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74,
-	0x61, 0x73, 0x6b, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x7d, 0x2f, 0x68, 0x6f, 0x6c,
-	0x64, 0x92, 0x41, 0xbe, 0x02, 0x1a, 0x1f, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x20, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65,
-	0x6e, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d,
-	0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x4a, 0x93, 0x01,
-	0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x8b, 0x01, 0x0a, 0x26, 0x54, 0x68, 0x65, 0x20, 0x74, 0x61,
-	0x73, 0x6b, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20,
-	0x69, 0x64, 0x20, 0x77, 0x61, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64,
-	0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x7d, 0x12, 0x8f, 0x03, 0x0a, 0x08, 0x46, 0x72, 0x65, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x67, 0x65, 0x22, 0x7d, 0x12, 0x9b, 0x03, 0x0a, 0x0b, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d,
+	0x73, 0x67, 0x22, 0xdd, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x1a, 0x1a, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x7d, 0x2f,
+	0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x92, 0x41, 0xb7, 0x02, 0x1a, 0x18, 0x46, 0x6f, 0x72,
+	0x63, 0x69, 0x62, 0x6c, 0x79, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x73, 0x20, 0x61,
+	0x20, 0x74, 0x61, 0x73, 0x6b, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a,
+	0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x4a, 0x93, 0x01, 0x0a,
+	0x03, 0x34, 0x30, 0x34, 0x12, 0x8b, 0x01, 0x0a, 0x26, 0x54, 0x68, 0x65, 0x20, 0x74, 0x61, 0x73,
+	0x6b, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x69,
+	0x64, 0x20, 0x77, 0x61, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a,
+	0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x7d, 0x12, 0x8c, 0x03, 0x0a, 0x09, 0x52, 0x65, 0x72, 0x75, 0x6e, 0x54, 0x61, 0x73, 0x6b,
 	0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x63, 0x74,
 	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xd4,
-	0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x1a, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61,
-	0x73, 0x6b, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x7d, 0x2f, 0x66, 0x72, 0x65, 0x65,
-	0x92, 0x41, 0xb1, 0x02, 0x1a, 0x12, 0x46, 0x72, 0x65, 0x65, 0x73, 0x20, 0x61, 0x20, 0x67, 0x69,
-	0x76, 0x65, 0x6e, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30,
-	0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e,
-	0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f,
-	0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
-	0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x4a,
-	0x93, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x8b, 0x01, 0x0a, 0x26, 0x54, 0x68, 0x65, 0x20,
-	0x74, 0x61, 0x73, 0x6b, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65,
-	0x6e, 0x20, 0x69, 0x64, 0x20, 0x77, 0x61, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75,
-	0x6e, 0x64, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0xa3, 0x03, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x4f,
-	0x6b, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22,
-	0xe9, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x1a, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74,
-	0x61, 0x73, 0x6b, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x7d, 0x2f, 0x73, 0x65, 0x74,
-	0x6f, 0x6b, 0x92, 0x41, 0xc5, 0x02, 0x1a, 0x26, 0x46, 0x6f, 0x72, 0x63, 0x69, 0x62, 0x6c, 0x79,
-	0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x61, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x27,
-	0x73, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x6f, 0x6b, 0x4a, 0x84,
-	0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x7d, 0x4a, 0x93, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x8b, 0x01,
-	0x0a, 0x26, 0x54, 0x68, 0x65, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
-	0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x69, 0x64, 0x20, 0x77, 0x61, 0x73, 0x20, 0x6e,
-	0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xd0,
+	0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x1a, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61,
+	0x73, 0x6b, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x72, 0x75,
+	0x6e, 0x92, 0x41, 0xac, 0x02, 0x1a, 0x0d, 0x52, 0x65, 0x72, 0x75, 0x6e, 0x73, 0x20, 0x61, 0x20,
+	0x74, 0x61, 0x73, 0x6b, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f,
 	0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70,
 	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b,
 	0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0x92, 0x03, 0x0a, 0x0b,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
-	0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xd4, 0x02, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1c, 0x1a, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x7b, 0x74,
-	0x61, 0x73, 0x6b, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x92, 0x41,
-	0xae, 0x02, 0x1a, 0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x73, 0x20, 0x61, 0x20, 0x74,
-	0x61, 0x73, 0x6b, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b,
-	0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x4a, 0x93, 0x01, 0x0a, 0x03, 0x34,
-	0x30, 0x34, 0x12, 0x8b, 0x01, 0x0a, 0x26, 0x54, 0x68, 0x65, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x20,
-	0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x69, 0x64, 0x20,
-	0x77, 0x61, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a,
-	0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
-	0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20,
-	0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d,
-	0x12, 0x85, 0x02, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x14,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x4d, 0x73, 0x67, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73,
-	0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xc5,
-	0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61,
-	0x73, 0x6b, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0xa7, 0x01, 0x1a,
-	0x1e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20,
-	0x6f, 0x66, 0x20, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x4a,
-	0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x30, 0x01, 0x12, 0xb5, 0x03, 0x0a, 0x0a, 0x54, 0x61, 0x73,
-	0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x54, 0x61, 0x73, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x1a, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xf4, 0x02, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x7b, 0x74,
-	0x61, 0x73, 0x6b, 0x49, 0x44, 0x7d, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x92, 0x41,
-	0xce, 0x02, 0x1a, 0x2f, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x20, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x74,
+	0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x4a, 0x93, 0x01, 0x0a, 0x03,
+	0x34, 0x30, 0x34, 0x12, 0x8b, 0x01, 0x0a, 0x26, 0x54, 0x68, 0x65, 0x20, 0x74, 0x61, 0x73, 0x6b,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x69, 0x64,
+	0x20, 0x77, 0x61, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30,
+	0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20,
+	0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x7d, 0x12, 0x9c, 0x03, 0x0a, 0x08, 0x48, 0x6f, 0x6c, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xe1, 0x02, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x1a, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b,
+	0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x7d, 0x2f, 0x68, 0x6f, 0x6c, 0x64, 0x92, 0x41,
+	0xbe, 0x02, 0x1a, 0x1f, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x74,
 	0x61, 0x73, 0x6b, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50,
 	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72,
@@ -2917,141 +4407,308 @@ var file_services_proto_rawDesc = []byte{
 	0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
 	0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20,
 	0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d,
-	0x12, 0x59, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14,
+	0x12, 0x8f, 0x03, 0x0a, 0x08, 0x46, 0x72, 0x65, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xd4, 0x02, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x19, 0x1a, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f,
+	0x7b, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x7d, 0x2f, 0x66, 0x72, 0x65, 0x65, 0x92, 0x41, 0xb1,
+	0x02, 0x1a, 0x12, 0x46, 0x72, 0x65, 0x65, 0x73, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e,
+	0x20, 0x74, 0x61, 0x73, 0x6b, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a,
+	0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x4a, 0x93, 0x01, 0x0a,
+	0x03, 0x34, 0x30, 0x34, 0x12, 0x8b, 0x01, 0x0a, 0x26, 0x54, 0x68, 0x65, 0x20, 0x74, 0x61, 0x73,
+	0x6b, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x69,
+	0x64, 0x20, 0x77, 0x61, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a,
+	0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x7d, 0x12, 0xa3, 0x03, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x4f, 0x6b, 0x12, 0x14,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73,
-	0x6b, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x73, 0x67, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
-	0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x7b, 0x74, 0x61, 0x73,
-	0x6b, 0x49, 0x44, 0x7d, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x98, 0x03, 0x0a, 0x07,
-	0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x54, 0x61, 0x73, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x12, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x73,
-	0x67, 0x22, 0xe2, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x7d, 0x2f, 0x6c,
-	0x6f, 0x67, 0x92, 0x41, 0xc0, 0x02, 0x1a, 0x21, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20,
-	0x61, 0x20, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x67,
-	0x69, 0x76, 0x65, 0x6e, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30,
-	0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a,
-	0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
-	0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20,
-	0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d,
-	0x4a, 0x93, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x8b, 0x01, 0x0a, 0x26, 0x54, 0x68, 0x65,
-	0x20, 0x74, 0x61, 0x73, 0x6b, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76,
-	0x65, 0x6e, 0x20, 0x69, 0x64, 0x20, 0x77, 0x61, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f,
-	0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x32, 0x83, 0x03, 0x0a, 0x11, 0x44, 0x65, 0x66, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x73, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x13, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x64, 0x65, 0x66, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x3a, 0x0d, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
-	0x67, 0x12, 0x65, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
-	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x73,
-	0x67, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x65, 0x66, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f,
-	0x7b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x7d, 0x12, 0x79, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
-	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x65, 0x66, 0x2f, 0x7b, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x1a, 0x17, 0x92, 0x41, 0x14, 0x12, 0x12, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0xe0, 0x05, 0x0a,
-	0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xe9, 0x02, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x1a, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b,
+	0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x6f, 0x6b, 0x92,
+	0x41, 0xc5, 0x02, 0x1a, 0x26, 0x46, 0x6f, 0x72, 0x63, 0x69, 0x62, 0x6c, 0x79, 0x20, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x61, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x27, 0x73, 0x20, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x6f, 0x6b, 0x4a, 0x84, 0x01, 0x0a, 0x03,
+	0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a,
+	0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x7d, 0x4a, 0x93, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x8b, 0x01, 0x0a, 0x26, 0x54,
+	0x68, 0x65, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x67,
+	0x69, 0x76, 0x65, 0x6e, 0x20, 0x69, 0x64, 0x20, 0x77, 0x61, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20,
+	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a,
+	0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0x92, 0x03, 0x0a, 0x0b, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xd4, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x1a,
+	0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b,
+	0x49, 0x44, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x92, 0x41, 0xae, 0x02, 0x1a,
+	0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x73, 0x20, 0x61, 0x20, 0x74, 0x61, 0x73, 0x6b,
+	0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x4a, 0x93, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12,
+	0x8b, 0x01, 0x0a, 0x26, 0x54, 0x68, 0x65, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x20, 0x77, 0x69, 0x74,
+	0x68, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x69, 0x64, 0x20, 0x77, 0x61, 0x73,
+	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0x85, 0x02,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x73,
+	0x67, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xc5, 0x01, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f,
+	0x74, 0x61, 0x73, 0x6b, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0xa7, 0x01, 0x1a, 0x1e, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x4a, 0x84, 0x01, 0x0a,
+	0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x7d, 0x30, 0x01, 0x12, 0xb5, 0x03, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xf4, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12,
+	0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b,
+	0x49, 0x44, 0x7d, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x92, 0x41, 0xce, 0x02, 0x1a,
+	0x2f, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x62,
+	0x6f, 0x75, 0x74, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x74, 0x61, 0x73, 0x6b,
+	0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x4a, 0x93, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12,
+	0x8b, 0x01, 0x0a, 0x26, 0x54, 0x68, 0x65, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x20, 0x77, 0x69, 0x74,
+	0x68, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x69, 0x64, 0x20, 0x77, 0x61, 0x73,
+	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x12, 0x59, 0x0a,
+	0x0a, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x67, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x61,
+	0x74, 0x61, 0x4d, 0x73, 0x67, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44,
+	0x7d, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x98, 0x03, 0x0a, 0x07, 0x54, 0x61, 0x73,
+	0x6b, 0x4c, 0x6f, 0x67, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x73, 0x67, 0x22, 0xe2,
+	0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61,
+	0x73, 0x6b, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x7d, 0x2f, 0x6c, 0x6f, 0x67, 0x92,
+	0x41, 0xc0, 0x02, 0x1a, 0x21, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65,
+	0x6e, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x4a, 0x84, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7d,
+	0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x4a, 0x93, 0x01,
+	0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x8b, 0x01, 0x0a, 0x26, 0x54, 0x68, 0x65, 0x20, 0x74, 0x61,
+	0x73, 0x6b, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20,
+	0x69, 0x64, 0x20, 0x77, 0x61, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x3a, 0x20, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x7d, 0x32, 0x83, 0x03, 0x0a, 0x11, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x73, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d,
+	0x73, 0x67, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x64, 0x65, 0x66, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x3a,
+	0x0d, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x65,
+	0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x10, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x23,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x4d, 0x73, 0x67, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x64, 0x65, 0x66, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x7d, 0x12, 0x79, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x65, 0x66, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x17, 0x92, 0x41, 0x14, 0x12, 0x12, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0xb6, 0x0b, 0x0a, 0x15, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22,
+	0x00, 0x12, 0x3c, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12,
+	0x36, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d,
+	0x73, 0x67, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0a, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73,
+	0x67, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d,
+	0x73, 0x67, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00,
+	0x12, 0x31, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73,
+	0x67, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x07, 0x51, 0x75, 0x69, 0x65, 0x73, 0x63, 0x65, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0f,
+	0x4e, 0x65, 0x77, 0x44, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x12,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x65, 0x77, 0x44, 0x61, 0x79, 0x50, 0x72,
+	0x6f, 0x63, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12,
+	0x37, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x10, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x1a, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d,
-	0x73, 0x67, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67,
-	0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67,
-	0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x09, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d,
-	0x73, 0x67, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0a, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0a,
-	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x36,
-	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
-	0x6c, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x73, 0x67, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74,
 	0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69,
 	0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73,
-	0x67, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0e,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x14,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x07, 0x51, 0x75, 0x69, 0x65, 0x73, 0x63,
-	0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73,
-	0x67, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x32,
-	0x53, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x42, 0x0a, 0x0e, 0x4f, 0x76, 0x65, 0x72, 0x73, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d,
-	0x73, 0x67, 0x22, 0x00, 0x42, 0xb1, 0x03, 0x5a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x92, 0x41, 0x9d, 0x03, 0x12, 0x1b, 0x0a, 0x14, 0x4f,
-	0x76, 0x65, 0x72, 0x73, 0x65, 0x65, 0x72, 0x20, 0x41, 0x50, 0x49, 0x20, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x02, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x52, 0x8d,
-	0x01, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x85, 0x01, 0x0a, 0x17, 0x41, 0x6e, 0x20, 0x75, 0x6e,
-	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x20, 0x75, 0x73,
-	0x65, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x24, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x75, 0x73, 0x65, 0x72, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x7d, 0x52, 0xa0,
-	0x01, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x98, 0x01, 0x0a, 0x16, 0x41, 0x6e, 0x20, 0x75, 0x6e,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x38, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x22, 0x3a, 0x20, 0x20, 0x22, 0x75, 0x73, 0x65, 0x72, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x65, 0x72, 0x66,
-	0x6f, 0x72, 0x6d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x7d, 0x5a, 0x23, 0x0a, 0x21, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74,
-	0x68, 0x12, 0x13, 0x08, 0x02, 0x1a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x02, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b,
-	0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12,
+	0x3d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x73, 0x67,
+	0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x39,
+	0x0a, 0x0b, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x10, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0d, 0x55, 0x6e, 0x64,
+	0x72, 0x61, 0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67,
+	0x22, 0x00, 0x32, 0x53, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x0e, 0x4f, 0x76, 0x65, 0x72, 0x73, 0x65, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x42, 0xb1, 0x03, 0x5a, 0x0e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x92, 0x41, 0x9d, 0x03, 0x12, 0x1b,
+	0x0a, 0x14, 0x4f, 0x76, 0x65, 0x72, 0x73, 0x65, 0x65, 0x72, 0x20, 0x41, 0x50, 0x49, 0x20, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x02, 0x01, 0x02, 0x32,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x52, 0x8d, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x85, 0x01, 0x0a, 0x17, 0x41, 0x6e,
+	0x20, 0x75, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x20, 0x75, 0x73, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a,
+	0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x24, 0x7b, 0x22, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x75, 0x73, 0x65, 0x72, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22,
+	0x7d, 0x52, 0xa0, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x98, 0x01, 0x0a, 0x16, 0x41, 0x6e,
+	0x20, 0x75, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x20, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x16,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x38, 0x7b, 0x22, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x20, 0x22, 0x75, 0x73, 0x65, 0x72, 0x20, 0x6e, 0x6f, 0x74,
+	0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x70,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x7d, 0x5a, 0x23, 0x0a, 0x21, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x41, 0x75, 0x74, 0x68, 0x12, 0x13, 0x08, 0x02, 0x1a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x02, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41,
+	0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3066,7 +4723,7 @@ func file_services_proto_rawDescGZIP() []byte {
 	return file_services_proto_rawDescData
 }
 
-var file_services_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_services_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_services_proto_goTypes = []interface{}{
 	(*ErrorResponse)(nil),                // 0: proto.ErrorResponse
 	(*GroupNameMsg)(nil),                 // 1: proto.GroupNameMsg
@@ -3074,136 +4731,202 @@ var file_services_proto_goTypes = []interface{}{
 	(*AuthorizeActionMsg)(nil),           // 3: proto.AuthorizeActionMsg
 	(*TicketActionMsg)(nil),              // 4: proto.TicketActionMsg
 	(*FlagActionMsg)(nil),                // 5: proto.FlagActionMsg
-	(*TaskActionMsg)(nil),                // 6: proto.TaskActionMsg
-	(*TaskFilterMsg)(nil),                // 7: proto.TaskFilterMsg
-	(*TaskOrderMsg)(nil),                 // 8: proto.TaskOrderMsg
-	(*TaskOrderGroupMsg)(nil),            // 9: proto.TaskOrderGroupMsg
-	(*DefinitionMsg)(nil),                // 10: proto.DefinitionMsg
-	(*DefinitionActionMsg)(nil),          // 11: proto.DefinitionActionMsg
-	(*DefinitionDetails)(nil),            // 12: proto.DefinitionDetails
-	(*DefinitionResultMsg)(nil),          // 13: proto.DefinitionResultMsg
-	(*ActionResultMsg)(nil),              // 14: proto.ActionResultMsg
-	(*FlagListResultMsg)(nil),            // 15: proto.FlagListResultMsg
-	(*TicketListResultMsg)(nil),          // 16: proto.TicketListResultMsg
-	(*TaskListResultMsg)(nil),            // 17: proto.TaskListResultMsg
-	(*TaskResourcesMsg)(nil),             // 18: proto.TaskResourcesMsg
-	(*TaskCyclicResultMsg)(nil),          // 19: proto.TaskCyclicResultMsg
-	(*TaskDetailResultMsg)(nil),          // 20: proto.TaskDetailResultMsg
-	(*TaskDataMsg)(nil),                  // 21: proto.TaskDataMsg
-	(*DefinitionListGroupResultMsg)(nil), // 22: proto.DefinitionListGroupResultMsg
-	(*DefinitionListMsg)(nil),            // 23: proto.DefinitionListMsg
-	(*DefinitionListResultMsg)(nil),      // 24: proto.DefinitionListResultMsg
-	(*UserAccount)(nil),                  // 25: proto.UserAccount
-	(*ChangePassword)(nil),               // 26: proto.ChangePassword
-	(*CreateUserMsg)(nil),                // 27: proto.CreateUserMsg
-	(*ModifyUserMsg)(nil),                // 28: proto.ModifyUserMsg
-	(*UserResultMsg)(nil),                // 29: proto.UserResultMsg
-	(*UserMsg)(nil),                      // 30: proto.UserMsg
-	(*UserAccountMsg)(nil),               // 31: proto.UserAccountMsg
-	(*EntityMsg)(nil),                    // 32: proto.EntityMsg
-	(*ListEntityResultMsg)(nil),          // 33: proto.ListEntityResultMsg
-	(*RoleMsg)(nil),                      // 34: proto.RoleMsg
-	(*RoleDefinitionMsg)(nil),            // 35: proto.RoleDefinitionMsg
-	(*RoleResultMsg)(nil),                // 36: proto.RoleResultMsg
-	(*emptypb.Empty)(nil),                // 37: google.protobuf.Empty
+	(*FlagHolderMsg)(nil),                // 6: proto.FlagHolderMsg
+	(*VariableActionMsg)(nil),            // 7: proto.VariableActionMsg
+	(*QuantityActionMsg)(nil),            // 8: proto.QuantityActionMsg
+	(*TaskActionMsg)(nil),                // 9: proto.TaskActionMsg
+	(*TaskFilterMsg)(nil),                // 10: proto.TaskFilterMsg
+	(*TaskOrderMsg)(nil),                 // 11: proto.TaskOrderMsg
+	(*TaskOrderGroupMsg)(nil),            // 12: proto.TaskOrderGroupMsg
+	(*DefinitionMsg)(nil),                // 13: proto.DefinitionMsg
+	(*DefinitionActionMsg)(nil),          // 14: proto.DefinitionActionMsg
+	(*DefinitionDetails)(nil),            // 15: proto.DefinitionDetails
+	(*DefinitionResultMsg)(nil),          // 16: proto.DefinitionResultMsg
+	(*ActionResultMsg)(nil),              // 17: proto.ActionResultMsg
+	(*FlagListResultMsg)(nil),            // 18: proto.FlagListResultMsg
+	(*TicketListResultMsg)(nil),          // 19: proto.TicketListResultMsg
+	(*TicketBatchMsg)(nil),               // 20: proto.TicketBatchMsg
+	(*TicketDeleteMsg)(nil),              // 21: proto.TicketDeleteMsg
+	(*TicketDeleteResultMsg)(nil),        // 22: proto.TicketDeleteResultMsg
+	(*TicketAuditResultMsg)(nil),         // 23: proto.TicketAuditResultMsg
+	(*VariableListResultMsg)(nil),        // 24: proto.VariableListResultMsg
+	(*QuantityListResultMsg)(nil),        // 25: proto.QuantityListResultMsg
+	(*TaskListResultMsg)(nil),            // 26: proto.TaskListResultMsg
+	(*TaskResourcesMsg)(nil),             // 27: proto.TaskResourcesMsg
+	(*TaskCyclicResultMsg)(nil),          // 28: proto.TaskCyclicResultMsg
+	(*TaskDetailResultMsg)(nil),          // 29: proto.TaskDetailResultMsg
+	(*TaskDataMsg)(nil),                  // 30: proto.TaskDataMsg
+	(*DefinitionListGroupResultMsg)(nil), // 31: proto.DefinitionListGroupResultMsg
+	(*DefinitionListMsg)(nil),            // 32: proto.DefinitionListMsg
+	(*DefinitionListResultMsg)(nil),      // 33: proto.DefinitionListResultMsg
+	(*UserAccount)(nil),                  // 34: proto.UserAccount
+	(*ChangePassword)(nil),               // 35: proto.ChangePassword
+	(*CreateUserMsg)(nil),                // 36: proto.CreateUserMsg
+	(*ModifyUserMsg)(nil),                // 37: proto.ModifyUserMsg
+	(*UserResultMsg)(nil),                // 38: proto.UserResultMsg
+	(*UserMsg)(nil),                      // 39: proto.UserMsg
+	(*UserAccountMsg)(nil),               // 40: proto.UserAccountMsg
+	(*EntityMsg)(nil),                    // 41: proto.EntityMsg
+	(*ListEntityResultMsg)(nil),          // 42: proto.ListEntityResultMsg
+	(*RoleMsg)(nil),                      // 43: proto.RoleMsg
+	(*RoleDefinitionMsg)(nil),            // 44: proto.RoleDefinitionMsg
+	(*NewDayProcMsg)(nil),                // 45: proto.NewDayProcMsg
+	(*SecretMsg)(nil),                    // 46: proto.SecretMsg
+	(*WorkerMsg)(nil),                    // 47: proto.WorkerMsg
+	(*WorkerInfoMsg)(nil),                // 48: proto.WorkerInfoMsg
+	(*WorkerListResultMsg)(nil),          // 49: proto.WorkerListResultMsg
+	(*ConcurrencyLimitMsg)(nil),          // 50: proto.ConcurrencyLimitMsg
+	(*ConcurrencyLimitListMsg)(nil),      // 51: proto.ConcurrencyLimitListMsg
+	(*RoleResultMsg)(nil),                // 52: proto.RoleResultMsg
+	nil,                                  // 53: proto.TaskOrderMsg.VariablesEntry
+	nil,                                  // 54: proto.TaskDetailResultMsg.VariablesEntry
+	(*emptypb.Empty)(nil),                // 55: google.protobuf.Empty
 }
 var file_services_proto_depIdxs = []int32{
-	10, // 0: proto.DefinitionActionMsg.definitionMsg:type_name -> proto.DefinitionMsg
-	12, // 1: proto.DefinitionResultMsg.definitionMsg:type_name -> proto.DefinitionDetails
-	14, // 2: proto.TaskDetailResultMsg.result:type_name -> proto.ActionResultMsg
-	17, // 3: proto.TaskDetailResultMsg.baseData:type_name -> proto.TaskListResultMsg
-	18, // 4: proto.TaskDetailResultMsg.resources:type_name -> proto.TaskResourcesMsg
-	19, // 5: proto.TaskDetailResultMsg.cyclicData:type_name -> proto.TaskCyclicResultMsg
-	23, // 6: proto.DefinitionListResultMsg.definitions:type_name -> proto.DefinitionListMsg
-	25, // 7: proto.CreateUserMsg.user:type_name -> proto.UserAccount
-	25, // 8: proto.ModifyUserMsg.user:type_name -> proto.UserAccount
-	26, // 9: proto.ModifyUserMsg.password:type_name -> proto.ChangePassword
-	25, // 10: proto.UserResultMsg.user:type_name -> proto.UserAccount
-	25, // 11: proto.UserAccountMsg.user:type_name -> proto.UserAccount
-	32, // 12: proto.ListEntityResultMsg.entity:type_name -> proto.EntityMsg
-	34, // 13: proto.RoleDefinitionMsg.role:type_name -> proto.RoleMsg
-	35, // 14: proto.RoleResultMsg.role:type_name -> proto.RoleDefinitionMsg
-	3,  // 15: proto.AuthenticateService.Authenticate:input_type -> proto.AuthorizeActionMsg
-	4,  // 16: proto.ResourceService.AddTicket:input_type -> proto.TicketActionMsg
-	4,  // 17: proto.ResourceService.DeleteTicket:input_type -> proto.TicketActionMsg
-	4,  // 18: proto.ResourceService.CheckTicket:input_type -> proto.TicketActionMsg
-	4,  // 19: proto.ResourceService.ListTickets:input_type -> proto.TicketActionMsg
-	5,  // 20: proto.ResourceService.SetFlag:input_type -> proto.FlagActionMsg
-	5,  // 21: proto.ResourceService.DestroyFlag:input_type -> proto.FlagActionMsg
-	5,  // 22: proto.ResourceService.ListFlags:input_type -> proto.FlagActionMsg
-	8,  // 23: proto.TaskService.OrderTask:input_type -> proto.TaskOrderMsg
-	8,  // 24: proto.TaskService.ForceTask:input_type -> proto.TaskOrderMsg
-	9,  // 25: proto.TaskService.OrderGroup:input_type -> proto.TaskOrderGroupMsg
-	9,  // 26: proto.TaskService.ForceGroup:input_type -> proto.TaskOrderGroupMsg
-	6,  // 27: proto.TaskService.EnforceTask:input_type -> proto.TaskActionMsg
-	6,  // 28: proto.TaskService.RerunTask:input_type -> proto.TaskActionMsg
-	6,  // 29: proto.TaskService.HoldTask:input_type -> proto.TaskActionMsg
-	6,  // 30: proto.TaskService.FreeTask:input_type -> proto.TaskActionMsg
-	6,  // 31: proto.TaskService.SetToOk:input_type -> proto.TaskActionMsg
-	6,  // 32: proto.TaskService.ConfirmTask:input_type -> proto.TaskActionMsg
-	7,  // 33: proto.TaskService.ListTasks:input_type -> proto.TaskFilterMsg
-	6,  // 34: proto.TaskService.TaskDetail:input_type -> proto.TaskActionMsg
-	6,  // 35: proto.TaskService.TaskOutput:input_type -> proto.TaskActionMsg
-	6,  // 36: proto.TaskService.TaskLog:input_type -> proto.TaskActionMsg
-	11, // 37: proto.DefinitionService.GetDefinition:input_type -> proto.DefinitionActionMsg
-	2,  // 38: proto.DefinitionService.ListGroups:input_type -> proto.FilterMsg
-	1,  // 39: proto.DefinitionService.ListDefinitionsFromGroup:input_type -> proto.GroupNameMsg
-	27, // 40: proto.AdministrationService.CreateUser:input_type -> proto.CreateUserMsg
-	27, // 41: proto.AdministrationService.ModifyUser:input_type -> proto.CreateUserMsg
-	30, // 42: proto.AdministrationService.DeleteUser:input_type -> proto.UserMsg
-	2,  // 43: proto.AdministrationService.ListUsers:input_type -> proto.FilterMsg
-	30, // 44: proto.AdministrationService.GetUser:input_type -> proto.UserMsg
-	35, // 45: proto.AdministrationService.CreateRole:input_type -> proto.RoleDefinitionMsg
-	35, // 46: proto.AdministrationService.ModifyRole:input_type -> proto.RoleDefinitionMsg
-	34, // 47: proto.AdministrationService.DeleteRole:input_type -> proto.RoleMsg
-	2,  // 48: proto.AdministrationService.ListRoles:input_type -> proto.FilterMsg
-	34, // 49: proto.AdministrationService.GetRole:input_type -> proto.RoleMsg
-	37, // 50: proto.AdministrationService.Quiesce:input_type -> google.protobuf.Empty
-	37, // 51: proto.AdministrationService.Resume:input_type -> google.protobuf.Empty
-	37, // 52: proto.StatusService.OverseerStatus:input_type -> google.protobuf.Empty
-	14, // 53: proto.AuthenticateService.Authenticate:output_type -> proto.ActionResultMsg
-	14, // 54: proto.ResourceService.AddTicket:output_type -> proto.ActionResultMsg
-	14, // 55: proto.ResourceService.DeleteTicket:output_type -> proto.ActionResultMsg
-	14, // 56: proto.ResourceService.CheckTicket:output_type -> proto.ActionResultMsg
-	16, // 57: proto.ResourceService.ListTickets:output_type -> proto.TicketListResultMsg
-	14, // 58: proto.ResourceService.SetFlag:output_type -> proto.ActionResultMsg
-	14, // 59: proto.ResourceService.DestroyFlag:output_type -> proto.ActionResultMsg
-	15, // 60: proto.ResourceService.ListFlags:output_type -> proto.FlagListResultMsg
-	14, // 61: proto.TaskService.OrderTask:output_type -> proto.ActionResultMsg
-	14, // 62: proto.TaskService.ForceTask:output_type -> proto.ActionResultMsg
-	14, // 63: proto.TaskService.OrderGroup:output_type -> proto.ActionResultMsg
-	14, // 64: proto.TaskService.ForceGroup:output_type -> proto.ActionResultMsg
-	14, // 65: proto.TaskService.EnforceTask:output_type -> proto.ActionResultMsg
-	14, // 66: proto.TaskService.RerunTask:output_type -> proto.ActionResultMsg
-	14, // 67: proto.TaskService.HoldTask:output_type -> proto.ActionResultMsg
-	14, // 68: proto.TaskService.FreeTask:output_type -> proto.ActionResultMsg
-	14, // 69: proto.TaskService.SetToOk:output_type -> proto.ActionResultMsg
-	14, // 70: proto.TaskService.ConfirmTask:output_type -> proto.ActionResultMsg
-	17, // 71: proto.TaskService.ListTasks:output_type -> proto.TaskListResultMsg
-	20, // 72: proto.TaskService.TaskDetail:output_type -> proto.TaskDetailResultMsg
-	21, // 73: proto.TaskService.TaskOutput:output_type -> proto.TaskDataMsg
-	21, // 74: proto.TaskService.TaskLog:output_type -> proto.TaskDataMsg
-	13, // 75: proto.DefinitionService.GetDefinition:output_type -> proto.DefinitionResultMsg
-	22, // 76: proto.DefinitionService.ListGroups:output_type -> proto.DefinitionListGroupResultMsg
-	24, // 77: proto.DefinitionService.ListDefinitionsFromGroup:output_type -> proto.DefinitionListResultMsg
-	14, // 78: proto.AdministrationService.CreateUser:output_type -> proto.ActionResultMsg
-	14, // 79: proto.AdministrationService.ModifyUser:output_type -> proto.ActionResultMsg
-	14, // 80: proto.AdministrationService.DeleteUser:output_type -> proto.ActionResultMsg
-	33, // 81: proto.AdministrationService.ListUsers:output_type -> proto.ListEntityResultMsg
-	29, // 82: proto.AdministrationService.GetUser:output_type -> proto.UserResultMsg
-	14, // 83: proto.AdministrationService.CreateRole:output_type -> proto.ActionResultMsg
-	14, // 84: proto.AdministrationService.ModifyRole:output_type -> proto.ActionResultMsg
-	14, // 85: proto.AdministrationService.DeleteRole:output_type -> proto.ActionResultMsg
-	33, // 86: proto.AdministrationService.ListRoles:output_type -> proto.ListEntityResultMsg
-	36, // 87: proto.AdministrationService.GetRole:output_type -> proto.RoleResultMsg
-	14, // 88: proto.AdministrationService.Quiesce:output_type -> proto.ActionResultMsg
-	14, // 89: proto.AdministrationService.Resume:output_type -> proto.ActionResultMsg
-	14, // 90: proto.StatusService.OverseerStatus:output_type -> proto.ActionResultMsg
-	53, // [53:91] is the sub-list for method output_type
-	15, // [15:53] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	53, // 0: proto.TaskOrderMsg.variables:type_name -> proto.TaskOrderMsg.VariablesEntry
+	13, // 1: proto.DefinitionActionMsg.definitionMsg:type_name -> proto.DefinitionMsg
+	15, // 2: proto.DefinitionResultMsg.definitionMsg:type_name -> proto.DefinitionDetails
+	4,  // 3: proto.TicketBatchMsg.tickets:type_name -> proto.TicketActionMsg
+	19, // 4: proto.TicketDeleteResultMsg.tickets:type_name -> proto.TicketListResultMsg
+	17, // 5: proto.TaskDetailResultMsg.result:type_name -> proto.ActionResultMsg
+	26, // 6: proto.TaskDetailResultMsg.baseData:type_name -> proto.TaskListResultMsg
+	27, // 7: proto.TaskDetailResultMsg.resources:type_name -> proto.TaskResourcesMsg
+	28, // 8: proto.TaskDetailResultMsg.cyclicData:type_name -> proto.TaskCyclicResultMsg
+	54, // 9: proto.TaskDetailResultMsg.variables:type_name -> proto.TaskDetailResultMsg.VariablesEntry
+	32, // 10: proto.DefinitionListResultMsg.definitions:type_name -> proto.DefinitionListMsg
+	34, // 11: proto.CreateUserMsg.user:type_name -> proto.UserAccount
+	34, // 12: proto.ModifyUserMsg.user:type_name -> proto.UserAccount
+	35, // 13: proto.ModifyUserMsg.password:type_name -> proto.ChangePassword
+	34, // 14: proto.UserResultMsg.user:type_name -> proto.UserAccount
+	34, // 15: proto.UserAccountMsg.user:type_name -> proto.UserAccount
+	41, // 16: proto.ListEntityResultMsg.entity:type_name -> proto.EntityMsg
+	43, // 17: proto.RoleDefinitionMsg.role:type_name -> proto.RoleMsg
+	48, // 18: proto.WorkerListResultMsg.workers:type_name -> proto.WorkerInfoMsg
+	50, // 19: proto.ConcurrencyLimitListMsg.limits:type_name -> proto.ConcurrencyLimitMsg
+	44, // 20: proto.RoleResultMsg.role:type_name -> proto.RoleDefinitionMsg
+	3,  // 21: proto.AuthenticateService.Authenticate:input_type -> proto.AuthorizeActionMsg
+	4,  // 22: proto.ResourceService.AddTicket:input_type -> proto.TicketActionMsg
+	4,  // 23: proto.ResourceService.DeleteTicket:input_type -> proto.TicketActionMsg
+	4,  // 24: proto.ResourceService.CheckTicket:input_type -> proto.TicketActionMsg
+	4,  // 25: proto.ResourceService.ListTickets:input_type -> proto.TicketActionMsg
+	20, // 26: proto.ResourceService.AddTickets:input_type -> proto.TicketBatchMsg
+	21, // 27: proto.ResourceService.DeleteTickets:input_type -> proto.TicketDeleteMsg
+	4,  // 28: proto.ResourceService.TicketAudit:input_type -> proto.TicketActionMsg
+	5,  // 29: proto.ResourceService.SetFlag:input_type -> proto.FlagActionMsg
+	5,  // 30: proto.ResourceService.DestroyFlag:input_type -> proto.FlagActionMsg
+	6,  // 31: proto.ResourceService.ReleaseFlagHolder:input_type -> proto.FlagHolderMsg
+	5,  // 32: proto.ResourceService.ListFlags:input_type -> proto.FlagActionMsg
+	7,  // 33: proto.ResourceService.SetVariable:input_type -> proto.VariableActionMsg
+	7,  // 34: proto.ResourceService.DeleteVariable:input_type -> proto.VariableActionMsg
+	7,  // 35: proto.ResourceService.ListVariables:input_type -> proto.VariableActionMsg
+	8,  // 36: proto.ResourceService.SetQuantity:input_type -> proto.QuantityActionMsg
+	8,  // 37: proto.ResourceService.DeleteQuantity:input_type -> proto.QuantityActionMsg
+	8,  // 38: proto.ResourceService.ListQuantities:input_type -> proto.QuantityActionMsg
+	11, // 39: proto.TaskService.OrderTask:input_type -> proto.TaskOrderMsg
+	11, // 40: proto.TaskService.ForceTask:input_type -> proto.TaskOrderMsg
+	12, // 41: proto.TaskService.OrderGroup:input_type -> proto.TaskOrderGroupMsg
+	12, // 42: proto.TaskService.ForceGroup:input_type -> proto.TaskOrderGroupMsg
+	9,  // 43: proto.TaskService.EnforceTask:input_type -> proto.TaskActionMsg
+	9,  // 44: proto.TaskService.RerunTask:input_type -> proto.TaskActionMsg
+	9,  // 45: proto.TaskService.HoldTask:input_type -> proto.TaskActionMsg
+	9,  // 46: proto.TaskService.FreeTask:input_type -> proto.TaskActionMsg
+	9,  // 47: proto.TaskService.SetToOk:input_type -> proto.TaskActionMsg
+	9,  // 48: proto.TaskService.ConfirmTask:input_type -> proto.TaskActionMsg
+	10, // 49: proto.TaskService.ListTasks:input_type -> proto.TaskFilterMsg
+	9,  // 50: proto.TaskService.TaskDetail:input_type -> proto.TaskActionMsg
+	9,  // 51: proto.TaskService.TaskOutput:input_type -> proto.TaskActionMsg
+	9,  // 52: proto.TaskService.TaskLog:input_type -> proto.TaskActionMsg
+	14, // 53: proto.DefinitionService.GetDefinition:input_type -> proto.DefinitionActionMsg
+	2,  // 54: proto.DefinitionService.ListGroups:input_type -> proto.FilterMsg
+	1,  // 55: proto.DefinitionService.ListDefinitionsFromGroup:input_type -> proto.GroupNameMsg
+	36, // 56: proto.AdministrationService.CreateUser:input_type -> proto.CreateUserMsg
+	36, // 57: proto.AdministrationService.ModifyUser:input_type -> proto.CreateUserMsg
+	39, // 58: proto.AdministrationService.DeleteUser:input_type -> proto.UserMsg
+	2,  // 59: proto.AdministrationService.ListUsers:input_type -> proto.FilterMsg
+	39, // 60: proto.AdministrationService.GetUser:input_type -> proto.UserMsg
+	44, // 61: proto.AdministrationService.CreateRole:input_type -> proto.RoleDefinitionMsg
+	44, // 62: proto.AdministrationService.ModifyRole:input_type -> proto.RoleDefinitionMsg
+	43, // 63: proto.AdministrationService.DeleteRole:input_type -> proto.RoleMsg
+	2,  // 64: proto.AdministrationService.ListRoles:input_type -> proto.FilterMsg
+	43, // 65: proto.AdministrationService.GetRole:input_type -> proto.RoleMsg
+	55, // 66: proto.AdministrationService.Quiesce:input_type -> google.protobuf.Empty
+	55, // 67: proto.AdministrationService.Resume:input_type -> google.protobuf.Empty
+	45, // 68: proto.AdministrationService.NewDayProcedure:input_type -> proto.NewDayProcMsg
+	46, // 69: proto.AdministrationService.SetSecret:input_type -> proto.SecretMsg
+	46, // 70: proto.AdministrationService.DeleteSecret:input_type -> proto.SecretMsg
+	2,  // 71: proto.AdministrationService.ListSecrets:input_type -> proto.FilterMsg
+	55, // 72: proto.AdministrationService.ReconcileResources:input_type -> google.protobuf.Empty
+	2,  // 73: proto.AdministrationService.ListWorkers:input_type -> proto.FilterMsg
+	47, // 74: proto.AdministrationService.DrainWorker:input_type -> proto.WorkerMsg
+	47, // 75: proto.AdministrationService.UndrainWorker:input_type -> proto.WorkerMsg
+	47, // 76: proto.AdministrationService.RemoveWorker:input_type -> proto.WorkerMsg
+	50, // 77: proto.AdministrationService.SetConcurrencyLimit:input_type -> proto.ConcurrencyLimitMsg
+	55, // 78: proto.AdministrationService.ListConcurrencyLimits:input_type -> google.protobuf.Empty
+	55, // 79: proto.StatusService.OverseerStatus:input_type -> google.protobuf.Empty
+	17, // 80: proto.AuthenticateService.Authenticate:output_type -> proto.ActionResultMsg
+	17, // 81: proto.ResourceService.AddTicket:output_type -> proto.ActionResultMsg
+	17, // 82: proto.ResourceService.DeleteTicket:output_type -> proto.ActionResultMsg
+	17, // 83: proto.ResourceService.CheckTicket:output_type -> proto.ActionResultMsg
+	19, // 84: proto.ResourceService.ListTickets:output_type -> proto.TicketListResultMsg
+	17, // 85: proto.ResourceService.AddTickets:output_type -> proto.ActionResultMsg
+	22, // 86: proto.ResourceService.DeleteTickets:output_type -> proto.TicketDeleteResultMsg
+	23, // 87: proto.ResourceService.TicketAudit:output_type -> proto.TicketAuditResultMsg
+	17, // 88: proto.ResourceService.SetFlag:output_type -> proto.ActionResultMsg
+	17, // 89: proto.ResourceService.DestroyFlag:output_type -> proto.ActionResultMsg
+	17, // 90: proto.ResourceService.ReleaseFlagHolder:output_type -> proto.ActionResultMsg
+	18, // 91: proto.ResourceService.ListFlags:output_type -> proto.FlagListResultMsg
+	17, // 92: proto.ResourceService.SetVariable:output_type -> proto.ActionResultMsg
+	17, // 93: proto.ResourceService.DeleteVariable:output_type -> proto.ActionResultMsg
+	24, // 94: proto.ResourceService.ListVariables:output_type -> proto.VariableListResultMsg
+	17, // 95: proto.ResourceService.SetQuantity:output_type -> proto.ActionResultMsg
+	17, // 96: proto.ResourceService.DeleteQuantity:output_type -> proto.ActionResultMsg
+	25, // 97: proto.ResourceService.ListQuantities:output_type -> proto.QuantityListResultMsg
+	17, // 98: proto.TaskService.OrderTask:output_type -> proto.ActionResultMsg
+	17, // 99: proto.TaskService.ForceTask:output_type -> proto.ActionResultMsg
+	17, // 100: proto.TaskService.OrderGroup:output_type -> proto.ActionResultMsg
+	17, // 101: proto.TaskService.ForceGroup:output_type -> proto.ActionResultMsg
+	17, // 102: proto.TaskService.EnforceTask:output_type -> proto.ActionResultMsg
+	17, // 103: proto.TaskService.RerunTask:output_type -> proto.ActionResultMsg
+	17, // 104: proto.TaskService.HoldTask:output_type -> proto.ActionResultMsg
+	17, // 105: proto.TaskService.FreeTask:output_type -> proto.ActionResultMsg
+	17, // 106: proto.TaskService.SetToOk:output_type -> proto.ActionResultMsg
+	17, // 107: proto.TaskService.ConfirmTask:output_type -> proto.ActionResultMsg
+	26, // 108: proto.TaskService.ListTasks:output_type -> proto.TaskListResultMsg
+	29, // 109: proto.TaskService.TaskDetail:output_type -> proto.TaskDetailResultMsg
+	30, // 110: proto.TaskService.TaskOutput:output_type -> proto.TaskDataMsg
+	30, // 111: proto.TaskService.TaskLog:output_type -> proto.TaskDataMsg
+	16, // 112: proto.DefinitionService.GetDefinition:output_type -> proto.DefinitionResultMsg
+	31, // 113: proto.DefinitionService.ListGroups:output_type -> proto.DefinitionListGroupResultMsg
+	33, // 114: proto.DefinitionService.ListDefinitionsFromGroup:output_type -> proto.DefinitionListResultMsg
+	17, // 115: proto.AdministrationService.CreateUser:output_type -> proto.ActionResultMsg
+	17, // 116: proto.AdministrationService.ModifyUser:output_type -> proto.ActionResultMsg
+	17, // 117: proto.AdministrationService.DeleteUser:output_type -> proto.ActionResultMsg
+	42, // 118: proto.AdministrationService.ListUsers:output_type -> proto.ListEntityResultMsg
+	38, // 119: proto.AdministrationService.GetUser:output_type -> proto.UserResultMsg
+	17, // 120: proto.AdministrationService.CreateRole:output_type -> proto.ActionResultMsg
+	17, // 121: proto.AdministrationService.ModifyRole:output_type -> proto.ActionResultMsg
+	17, // 122: proto.AdministrationService.DeleteRole:output_type -> proto.ActionResultMsg
+	42, // 123: proto.AdministrationService.ListRoles:output_type -> proto.ListEntityResultMsg
+	52, // 124: proto.AdministrationService.GetRole:output_type -> proto.RoleResultMsg
+	17, // 125: proto.AdministrationService.Quiesce:output_type -> proto.ActionResultMsg
+	17, // 126: proto.AdministrationService.Resume:output_type -> proto.ActionResultMsg
+	17, // 127: proto.AdministrationService.NewDayProcedure:output_type -> proto.ActionResultMsg
+	17, // 128: proto.AdministrationService.SetSecret:output_type -> proto.ActionResultMsg
+	17, // 129: proto.AdministrationService.DeleteSecret:output_type -> proto.ActionResultMsg
+	42, // 130: proto.AdministrationService.ListSecrets:output_type -> proto.ListEntityResultMsg
+	42, // 131: proto.AdministrationService.ReconcileResources:output_type -> proto.ListEntityResultMsg
+	49, // 132: proto.AdministrationService.ListWorkers:output_type -> proto.WorkerListResultMsg
+	17, // 133: proto.AdministrationService.DrainWorker:output_type -> proto.ActionResultMsg
+	17, // 134: proto.AdministrationService.UndrainWorker:output_type -> proto.ActionResultMsg
+	17, // 135: proto.AdministrationService.RemoveWorker:output_type -> proto.ActionResultMsg
+	17, // 136: proto.AdministrationService.SetConcurrencyLimit:output_type -> proto.ActionResultMsg
+	51, // 137: proto.AdministrationService.ListConcurrencyLimits:output_type -> proto.ConcurrencyLimitListMsg
+	17, // 138: proto.StatusService.OverseerStatus:output_type -> proto.ActionResultMsg
+	80, // [80:139] is the sub-list for method output_type
+	21, // [21:80] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_services_proto_init() }
@@ -3285,7 +5008,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskActionMsg); i {
+			switch v := v.(*FlagHolderMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3297,7 +5020,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskFilterMsg); i {
+			switch v := v.(*VariableActionMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3309,7 +5032,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskOrderMsg); i {
+			switch v := v.(*QuantityActionMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3321,7 +5044,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskOrderGroupMsg); i {
+			switch v := v.(*TaskActionMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3333,7 +5056,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefinitionMsg); i {
+			switch v := v.(*TaskFilterMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3345,7 +5068,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefinitionActionMsg); i {
+			switch v := v.(*TaskOrderMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3357,7 +5080,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefinitionDetails); i {
+			switch v := v.(*TaskOrderGroupMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3369,7 +5092,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefinitionResultMsg); i {
+			switch v := v.(*DefinitionMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3381,7 +5104,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActionResultMsg); i {
+			switch v := v.(*DefinitionActionMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3393,7 +5116,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlagListResultMsg); i {
+			switch v := v.(*DefinitionDetails); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3405,7 +5128,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TicketListResultMsg); i {
+			switch v := v.(*DefinitionResultMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3417,7 +5140,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskListResultMsg); i {
+			switch v := v.(*ActionResultMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3429,7 +5152,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskResourcesMsg); i {
+			switch v := v.(*FlagListResultMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3441,7 +5164,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskCyclicResultMsg); i {
+			switch v := v.(*TicketListResultMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3453,7 +5176,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskDetailResultMsg); i {
+			switch v := v.(*TicketBatchMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3465,7 +5188,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskDataMsg); i {
+			switch v := v.(*TicketDeleteMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3477,7 +5200,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefinitionListGroupResultMsg); i {
+			switch v := v.(*TicketDeleteResultMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3489,7 +5212,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefinitionListMsg); i {
+			switch v := v.(*TicketAuditResultMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3501,7 +5224,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefinitionListResultMsg); i {
+			switch v := v.(*VariableListResultMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3513,7 +5236,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAccount); i {
+			switch v := v.(*QuantityListResultMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3525,7 +5248,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangePassword); i {
+			switch v := v.(*TaskListResultMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3537,7 +5260,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserMsg); i {
+			switch v := v.(*TaskResourcesMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3549,7 +5272,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifyUserMsg); i {
+			switch v := v.(*TaskCyclicResultMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3561,7 +5284,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserResultMsg); i {
+			switch v := v.(*TaskDetailResultMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3573,7 +5296,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserMsg); i {
+			switch v := v.(*TaskDataMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3585,7 +5308,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAccountMsg); i {
+			switch v := v.(*DefinitionListGroupResultMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3597,7 +5320,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EntityMsg); i {
+			switch v := v.(*DefinitionListMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3609,7 +5332,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListEntityResultMsg); i {
+			switch v := v.(*DefinitionListResultMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3621,7 +5344,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoleMsg); i {
+			switch v := v.(*UserAccount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3633,7 +5356,7 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoleDefinitionMsg); i {
+			switch v := v.(*ChangePassword); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3645,6 +5368,198 @@ func file_services_proto_init() {
 			}
 		}
 		file_services_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyUserMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserResultMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAccountMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEntityResultMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleDefinitionMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewDayProcMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerInfoMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerListResultMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConcurrencyLimitMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConcurrencyLimitListMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RoleResultMsg); i {
 			case 0:
 				return &v.state
@@ -3663,7 +5578,7 @@ func file_services_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_services_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   37,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   6,
 		},
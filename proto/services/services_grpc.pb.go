@@ -113,9 +113,19 @@ type ResourceServiceClient interface {
 	DeleteTicket(ctx context.Context, in *TicketActionMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
 	CheckTicket(ctx context.Context, in *TicketActionMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
 	ListTickets(ctx context.Context, in *TicketActionMsg, opts ...grpc.CallOption) (ResourceService_ListTicketsClient, error)
+	AddTickets(ctx context.Context, in *TicketBatchMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
+	DeleteTickets(ctx context.Context, in *TicketDeleteMsg, opts ...grpc.CallOption) (*TicketDeleteResultMsg, error)
+	TicketAudit(ctx context.Context, in *TicketActionMsg, opts ...grpc.CallOption) (ResourceService_TicketAuditClient, error)
 	SetFlag(ctx context.Context, in *FlagActionMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
 	DestroyFlag(ctx context.Context, in *FlagActionMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
+	ReleaseFlagHolder(ctx context.Context, in *FlagHolderMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
 	ListFlags(ctx context.Context, in *FlagActionMsg, opts ...grpc.CallOption) (ResourceService_ListFlagsClient, error)
+	SetVariable(ctx context.Context, in *VariableActionMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
+	DeleteVariable(ctx context.Context, in *VariableActionMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
+	ListVariables(ctx context.Context, in *VariableActionMsg, opts ...grpc.CallOption) (ResourceService_ListVariablesClient, error)
+	SetQuantity(ctx context.Context, in *QuantityActionMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
+	DeleteQuantity(ctx context.Context, in *QuantityActionMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
+	ListQuantities(ctx context.Context, in *QuantityActionMsg, opts ...grpc.CallOption) (ResourceService_ListQuantitiesClient, error)
 }
 
 type resourceServiceClient struct {
@@ -185,6 +195,56 @@ func (x *resourceServiceListTicketsClient) Recv() (*TicketListResultMsg, error)
 	return m, nil
 }
 
+func (c *resourceServiceClient) AddTickets(ctx context.Context, in *TicketBatchMsg, opts ...grpc.CallOption) (*ActionResultMsg, error) {
+	out := new(ActionResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.ResourceService/AddTickets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) DeleteTickets(ctx context.Context, in *TicketDeleteMsg, opts ...grpc.CallOption) (*TicketDeleteResultMsg, error) {
+	out := new(TicketDeleteResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.ResourceService/DeleteTickets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) TicketAudit(ctx context.Context, in *TicketActionMsg, opts ...grpc.CallOption) (ResourceService_TicketAuditClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ResourceService_ServiceDesc.Streams[1], "/proto.ResourceService/TicketAudit", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &resourceServiceTicketAuditClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ResourceService_TicketAuditClient interface {
+	Recv() (*TicketAuditResultMsg, error)
+	grpc.ClientStream
+}
+
+type resourceServiceTicketAuditClient struct {
+	grpc.ClientStream
+}
+
+func (x *resourceServiceTicketAuditClient) Recv() (*TicketAuditResultMsg, error) {
+	m := new(TicketAuditResultMsg)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *resourceServiceClient) SetFlag(ctx context.Context, in *FlagActionMsg, opts ...grpc.CallOption) (*ActionResultMsg, error) {
 	out := new(ActionResultMsg)
 	err := c.cc.Invoke(ctx, "/proto.ResourceService/SetFlag", in, out, opts...)
@@ -203,8 +263,17 @@ func (c *resourceServiceClient) DestroyFlag(ctx context.Context, in *FlagActionM
 	return out, nil
 }
 
+func (c *resourceServiceClient) ReleaseFlagHolder(ctx context.Context, in *FlagHolderMsg, opts ...grpc.CallOption) (*ActionResultMsg, error) {
+	out := new(ActionResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.ResourceService/ReleaseFlagHolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resourceServiceClient) ListFlags(ctx context.Context, in *FlagActionMsg, opts ...grpc.CallOption) (ResourceService_ListFlagsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ResourceService_ServiceDesc.Streams[1], "/proto.ResourceService/ListFlags", opts...)
+	stream, err := c.cc.NewStream(ctx, &ResourceService_ServiceDesc.Streams[2], "/proto.ResourceService/ListFlags", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -235,6 +304,106 @@ func (x *resourceServiceListFlagsClient) Recv() (*FlagListResultMsg, error) {
 	return m, nil
 }
 
+func (c *resourceServiceClient) SetVariable(ctx context.Context, in *VariableActionMsg, opts ...grpc.CallOption) (*ActionResultMsg, error) {
+	out := new(ActionResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.ResourceService/SetVariable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) DeleteVariable(ctx context.Context, in *VariableActionMsg, opts ...grpc.CallOption) (*ActionResultMsg, error) {
+	out := new(ActionResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.ResourceService/DeleteVariable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ListVariables(ctx context.Context, in *VariableActionMsg, opts ...grpc.CallOption) (ResourceService_ListVariablesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ResourceService_ServiceDesc.Streams[3], "/proto.ResourceService/ListVariables", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &resourceServiceListVariablesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ResourceService_ListVariablesClient interface {
+	Recv() (*VariableListResultMsg, error)
+	grpc.ClientStream
+}
+
+type resourceServiceListVariablesClient struct {
+	grpc.ClientStream
+}
+
+func (x *resourceServiceListVariablesClient) Recv() (*VariableListResultMsg, error) {
+	m := new(VariableListResultMsg)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *resourceServiceClient) SetQuantity(ctx context.Context, in *QuantityActionMsg, opts ...grpc.CallOption) (*ActionResultMsg, error) {
+	out := new(ActionResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.ResourceService/SetQuantity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) DeleteQuantity(ctx context.Context, in *QuantityActionMsg, opts ...grpc.CallOption) (*ActionResultMsg, error) {
+	out := new(ActionResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.ResourceService/DeleteQuantity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ListQuantities(ctx context.Context, in *QuantityActionMsg, opts ...grpc.CallOption) (ResourceService_ListQuantitiesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ResourceService_ServiceDesc.Streams[4], "/proto.ResourceService/ListQuantities", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &resourceServiceListQuantitiesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ResourceService_ListQuantitiesClient interface {
+	Recv() (*QuantityListResultMsg, error)
+	grpc.ClientStream
+}
+
+type resourceServiceListQuantitiesClient struct {
+	grpc.ClientStream
+}
+
+func (x *resourceServiceListQuantitiesClient) Recv() (*QuantityListResultMsg, error) {
+	m := new(QuantityListResultMsg)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ResourceServiceServer is the server API for ResourceService service.
 // All implementations must embed UnimplementedResourceServiceServer
 // for forward compatibility
@@ -243,9 +412,19 @@ type ResourceServiceServer interface {
 	DeleteTicket(context.Context, *TicketActionMsg) (*ActionResultMsg, error)
 	CheckTicket(context.Context, *TicketActionMsg) (*ActionResultMsg, error)
 	ListTickets(*TicketActionMsg, ResourceService_ListTicketsServer) error
+	AddTickets(context.Context, *TicketBatchMsg) (*ActionResultMsg, error)
+	DeleteTickets(context.Context, *TicketDeleteMsg) (*TicketDeleteResultMsg, error)
+	TicketAudit(*TicketActionMsg, ResourceService_TicketAuditServer) error
 	SetFlag(context.Context, *FlagActionMsg) (*ActionResultMsg, error)
 	DestroyFlag(context.Context, *FlagActionMsg) (*ActionResultMsg, error)
+	ReleaseFlagHolder(context.Context, *FlagHolderMsg) (*ActionResultMsg, error)
 	ListFlags(*FlagActionMsg, ResourceService_ListFlagsServer) error
+	SetVariable(context.Context, *VariableActionMsg) (*ActionResultMsg, error)
+	DeleteVariable(context.Context, *VariableActionMsg) (*ActionResultMsg, error)
+	ListVariables(*VariableActionMsg, ResourceService_ListVariablesServer) error
+	SetQuantity(context.Context, *QuantityActionMsg) (*ActionResultMsg, error)
+	DeleteQuantity(context.Context, *QuantityActionMsg) (*ActionResultMsg, error)
+	ListQuantities(*QuantityActionMsg, ResourceService_ListQuantitiesServer) error
 	mustEmbedUnimplementedResourceServiceServer()
 }
 
@@ -265,15 +444,45 @@ func (UnimplementedResourceServiceServer) CheckTicket(context.Context, *TicketAc
 func (UnimplementedResourceServiceServer) ListTickets(*TicketActionMsg, ResourceService_ListTicketsServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListTickets not implemented")
 }
+func (UnimplementedResourceServiceServer) AddTickets(context.Context, *TicketBatchMsg) (*ActionResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTickets not implemented")
+}
+func (UnimplementedResourceServiceServer) DeleteTickets(context.Context, *TicketDeleteMsg) (*TicketDeleteResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTickets not implemented")
+}
+func (UnimplementedResourceServiceServer) TicketAudit(*TicketActionMsg, ResourceService_TicketAuditServer) error {
+	return status.Errorf(codes.Unimplemented, "method TicketAudit not implemented")
+}
 func (UnimplementedResourceServiceServer) SetFlag(context.Context, *FlagActionMsg) (*ActionResultMsg, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetFlag not implemented")
 }
 func (UnimplementedResourceServiceServer) DestroyFlag(context.Context, *FlagActionMsg) (*ActionResultMsg, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyFlag not implemented")
 }
+func (UnimplementedResourceServiceServer) ReleaseFlagHolder(context.Context, *FlagHolderMsg) (*ActionResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseFlagHolder not implemented")
+}
 func (UnimplementedResourceServiceServer) ListFlags(*FlagActionMsg, ResourceService_ListFlagsServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListFlags not implemented")
 }
+func (UnimplementedResourceServiceServer) SetVariable(context.Context, *VariableActionMsg) (*ActionResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVariable not implemented")
+}
+func (UnimplementedResourceServiceServer) DeleteVariable(context.Context, *VariableActionMsg) (*ActionResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVariable not implemented")
+}
+func (UnimplementedResourceServiceServer) ListVariables(*VariableActionMsg, ResourceService_ListVariablesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListVariables not implemented")
+}
+func (UnimplementedResourceServiceServer) SetQuantity(context.Context, *QuantityActionMsg) (*ActionResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuantity not implemented")
+}
+func (UnimplementedResourceServiceServer) DeleteQuantity(context.Context, *QuantityActionMsg) (*ActionResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuantity not implemented")
+}
+func (UnimplementedResourceServiceServer) ListQuantities(*QuantityActionMsg, ResourceService_ListQuantitiesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListQuantities not implemented")
+}
 func (UnimplementedResourceServiceServer) mustEmbedUnimplementedResourceServiceServer() {}
 
 // UnsafeResourceServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -362,6 +571,63 @@ func (x *resourceServiceListTicketsServer) Send(m *TicketListResultMsg) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ResourceService_AddTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TicketBatchMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).AddTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ResourceService/AddTickets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).AddTickets(ctx, req.(*TicketBatchMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_DeleteTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TicketDeleteMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).DeleteTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ResourceService/DeleteTickets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).DeleteTickets(ctx, req.(*TicketDeleteMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_TicketAudit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TicketActionMsg)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceServiceServer).TicketAudit(m, &resourceServiceTicketAuditServer{stream})
+}
+
+type ResourceService_TicketAuditServer interface {
+	Send(*TicketAuditResultMsg) error
+	grpc.ServerStream
+}
+
+type resourceServiceTicketAuditServer struct {
+	grpc.ServerStream
+}
+
+func (x *resourceServiceTicketAuditServer) Send(m *TicketAuditResultMsg) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ResourceService_SetFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FlagActionMsg)
 	if err := dec(in); err != nil {
@@ -398,6 +664,24 @@ func _ResourceService_DestroyFlag_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceService_ReleaseFlagHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlagHolderMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ReleaseFlagHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ResourceService/ReleaseFlagHolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ReleaseFlagHolder(ctx, req.(*FlagHolderMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResourceService_ListFlags_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(FlagActionMsg)
 	if err := stream.RecvMsg(m); err != nil {
@@ -419,6 +703,120 @@ func (x *resourceServiceListFlagsServer) Send(m *FlagListResultMsg) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ResourceService_SetVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VariableActionMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).SetVariable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ResourceService/SetVariable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).SetVariable(ctx, req.(*VariableActionMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_DeleteVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VariableActionMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).DeleteVariable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ResourceService/DeleteVariable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).DeleteVariable(ctx, req.(*VariableActionMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ListVariables_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VariableActionMsg)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceServiceServer).ListVariables(m, &resourceServiceListVariablesServer{stream})
+}
+
+type ResourceService_ListVariablesServer interface {
+	Send(*VariableListResultMsg) error
+	grpc.ServerStream
+}
+
+type resourceServiceListVariablesServer struct {
+	grpc.ServerStream
+}
+
+func (x *resourceServiceListVariablesServer) Send(m *VariableListResultMsg) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ResourceService_SetQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuantityActionMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).SetQuantity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ResourceService/SetQuantity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).SetQuantity(ctx, req.(*QuantityActionMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_DeleteQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuantityActionMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).DeleteQuantity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ResourceService/DeleteQuantity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).DeleteQuantity(ctx, req.(*QuantityActionMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ListQuantities_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QuantityActionMsg)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceServiceServer).ListQuantities(m, &resourceServiceListQuantitiesServer{stream})
+}
+
+type ResourceService_ListQuantitiesServer interface {
+	Send(*QuantityListResultMsg) error
+	grpc.ServerStream
+}
+
+type resourceServiceListQuantitiesServer struct {
+	grpc.ServerStream
+}
+
+func (x *resourceServiceListQuantitiesServer) Send(m *QuantityListResultMsg) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ResourceService_ServiceDesc is the grpc.ServiceDesc for ResourceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -438,6 +836,14 @@ var ResourceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckTicket",
 			Handler:    _ResourceService_CheckTicket_Handler,
 		},
+		{
+			MethodName: "AddTickets",
+			Handler:    _ResourceService_AddTickets_Handler,
+		},
+		{
+			MethodName: "DeleteTickets",
+			Handler:    _ResourceService_DeleteTickets_Handler,
+		},
 		{
 			MethodName: "SetFlag",
 			Handler:    _ResourceService_SetFlag_Handler,
@@ -446,6 +852,26 @@ var ResourceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyFlag",
 			Handler:    _ResourceService_DestroyFlag_Handler,
 		},
+		{
+			MethodName: "ReleaseFlagHolder",
+			Handler:    _ResourceService_ReleaseFlagHolder_Handler,
+		},
+		{
+			MethodName: "SetVariable",
+			Handler:    _ResourceService_SetVariable_Handler,
+		},
+		{
+			MethodName: "DeleteVariable",
+			Handler:    _ResourceService_DeleteVariable_Handler,
+		},
+		{
+			MethodName: "SetQuantity",
+			Handler:    _ResourceService_SetQuantity_Handler,
+		},
+		{
+			MethodName: "DeleteQuantity",
+			Handler:    _ResourceService_DeleteQuantity_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -453,11 +879,26 @@ var ResourceService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ResourceService_ListTickets_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "TicketAudit",
+			Handler:       _ResourceService_TicketAudit_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListFlags",
 			Handler:       _ResourceService_ListFlags_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListVariables",
+			Handler:       _ResourceService_ListVariables_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListQuantities",
+			Handler:       _ResourceService_ListQuantities_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "services.proto",
 }
@@ -1218,6 +1659,17 @@ type AdministrationServiceClient interface {
 	GetRole(ctx context.Context, in *RoleMsg, opts ...grpc.CallOption) (*RoleResultMsg, error)
 	Quiesce(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ActionResultMsg, error)
 	Resume(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ActionResultMsg, error)
+	NewDayProcedure(ctx context.Context, in *NewDayProcMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
+	SetSecret(ctx context.Context, in *SecretMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
+	DeleteSecret(ctx context.Context, in *SecretMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
+	ListSecrets(ctx context.Context, in *FilterMsg, opts ...grpc.CallOption) (*ListEntityResultMsg, error)
+	ReconcileResources(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListEntityResultMsg, error)
+	ListWorkers(ctx context.Context, in *FilterMsg, opts ...grpc.CallOption) (*WorkerListResultMsg, error)
+	DrainWorker(ctx context.Context, in *WorkerMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
+	UndrainWorker(ctx context.Context, in *WorkerMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
+	RemoveWorker(ctx context.Context, in *WorkerMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
+	SetConcurrencyLimit(ctx context.Context, in *ConcurrencyLimitMsg, opts ...grpc.CallOption) (*ActionResultMsg, error)
+	ListConcurrencyLimits(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ConcurrencyLimitListMsg, error)
 }
 
 type administrationServiceClient struct {
@@ -1336,6 +1788,105 @@ func (c *administrationServiceClient) Resume(ctx context.Context, in *emptypb.Em
 	return out, nil
 }
 
+func (c *administrationServiceClient) NewDayProcedure(ctx context.Context, in *NewDayProcMsg, opts ...grpc.CallOption) (*ActionResultMsg, error) {
+	out := new(ActionResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.AdministrationService/NewDayProcedure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *administrationServiceClient) SetSecret(ctx context.Context, in *SecretMsg, opts ...grpc.CallOption) (*ActionResultMsg, error) {
+	out := new(ActionResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.AdministrationService/SetSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *administrationServiceClient) DeleteSecret(ctx context.Context, in *SecretMsg, opts ...grpc.CallOption) (*ActionResultMsg, error) {
+	out := new(ActionResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.AdministrationService/DeleteSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *administrationServiceClient) ListSecrets(ctx context.Context, in *FilterMsg, opts ...grpc.CallOption) (*ListEntityResultMsg, error) {
+	out := new(ListEntityResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.AdministrationService/ListSecrets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *administrationServiceClient) ReconcileResources(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListEntityResultMsg, error) {
+	out := new(ListEntityResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.AdministrationService/ReconcileResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *administrationServiceClient) ListWorkers(ctx context.Context, in *FilterMsg, opts ...grpc.CallOption) (*WorkerListResultMsg, error) {
+	out := new(WorkerListResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.AdministrationService/ListWorkers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *administrationServiceClient) DrainWorker(ctx context.Context, in *WorkerMsg, opts ...grpc.CallOption) (*ActionResultMsg, error) {
+	out := new(ActionResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.AdministrationService/DrainWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *administrationServiceClient) UndrainWorker(ctx context.Context, in *WorkerMsg, opts ...grpc.CallOption) (*ActionResultMsg, error) {
+	out := new(ActionResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.AdministrationService/UndrainWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *administrationServiceClient) RemoveWorker(ctx context.Context, in *WorkerMsg, opts ...grpc.CallOption) (*ActionResultMsg, error) {
+	out := new(ActionResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.AdministrationService/RemoveWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *administrationServiceClient) SetConcurrencyLimit(ctx context.Context, in *ConcurrencyLimitMsg, opts ...grpc.CallOption) (*ActionResultMsg, error) {
+	out := new(ActionResultMsg)
+	err := c.cc.Invoke(ctx, "/proto.AdministrationService/SetConcurrencyLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *administrationServiceClient) ListConcurrencyLimits(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ConcurrencyLimitListMsg, error) {
+	out := new(ConcurrencyLimitListMsg)
+	err := c.cc.Invoke(ctx, "/proto.AdministrationService/ListConcurrencyLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdministrationServiceServer is the server API for AdministrationService service.
 // All implementations must embed UnimplementedAdministrationServiceServer
 // for forward compatibility
@@ -1352,6 +1903,17 @@ type AdministrationServiceServer interface {
 	GetRole(context.Context, *RoleMsg) (*RoleResultMsg, error)
 	Quiesce(context.Context, *emptypb.Empty) (*ActionResultMsg, error)
 	Resume(context.Context, *emptypb.Empty) (*ActionResultMsg, error)
+	NewDayProcedure(context.Context, *NewDayProcMsg) (*ActionResultMsg, error)
+	SetSecret(context.Context, *SecretMsg) (*ActionResultMsg, error)
+	DeleteSecret(context.Context, *SecretMsg) (*ActionResultMsg, error)
+	ListSecrets(context.Context, *FilterMsg) (*ListEntityResultMsg, error)
+	ReconcileResources(context.Context, *emptypb.Empty) (*ListEntityResultMsg, error)
+	ListWorkers(context.Context, *FilterMsg) (*WorkerListResultMsg, error)
+	DrainWorker(context.Context, *WorkerMsg) (*ActionResultMsg, error)
+	UndrainWorker(context.Context, *WorkerMsg) (*ActionResultMsg, error)
+	RemoveWorker(context.Context, *WorkerMsg) (*ActionResultMsg, error)
+	SetConcurrencyLimit(context.Context, *ConcurrencyLimitMsg) (*ActionResultMsg, error)
+	ListConcurrencyLimits(context.Context, *emptypb.Empty) (*ConcurrencyLimitListMsg, error)
 	mustEmbedUnimplementedAdministrationServiceServer()
 }
 
@@ -1395,6 +1957,39 @@ func (UnimplementedAdministrationServiceServer) Quiesce(context.Context, *emptyp
 func (UnimplementedAdministrationServiceServer) Resume(context.Context, *emptypb.Empty) (*ActionResultMsg, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
 }
+func (UnimplementedAdministrationServiceServer) NewDayProcedure(context.Context, *NewDayProcMsg) (*ActionResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewDayProcedure not implemented")
+}
+func (UnimplementedAdministrationServiceServer) SetSecret(context.Context, *SecretMsg) (*ActionResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSecret not implemented")
+}
+func (UnimplementedAdministrationServiceServer) DeleteSecret(context.Context, *SecretMsg) (*ActionResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
+}
+func (UnimplementedAdministrationServiceServer) ListSecrets(context.Context, *FilterMsg) (*ListEntityResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecrets not implemented")
+}
+func (UnimplementedAdministrationServiceServer) ReconcileResources(context.Context, *emptypb.Empty) (*ListEntityResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileResources not implemented")
+}
+func (UnimplementedAdministrationServiceServer) ListWorkers(context.Context, *FilterMsg) (*WorkerListResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkers not implemented")
+}
+func (UnimplementedAdministrationServiceServer) DrainWorker(context.Context, *WorkerMsg) (*ActionResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainWorker not implemented")
+}
+func (UnimplementedAdministrationServiceServer) UndrainWorker(context.Context, *WorkerMsg) (*ActionResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndrainWorker not implemented")
+}
+func (UnimplementedAdministrationServiceServer) RemoveWorker(context.Context, *WorkerMsg) (*ActionResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWorker not implemented")
+}
+func (UnimplementedAdministrationServiceServer) SetConcurrencyLimit(context.Context, *ConcurrencyLimitMsg) (*ActionResultMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConcurrencyLimit not implemented")
+}
+func (UnimplementedAdministrationServiceServer) ListConcurrencyLimits(context.Context, *emptypb.Empty) (*ConcurrencyLimitListMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConcurrencyLimits not implemented")
+}
 func (UnimplementedAdministrationServiceServer) mustEmbedUnimplementedAdministrationServiceServer() {}
 
 // UnsafeAdministrationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1624,6 +2219,204 @@ func _AdministrationService_Resume_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdministrationService_NewDayProcedure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewDayProcMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdministrationServiceServer).NewDayProcedure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AdministrationService/NewDayProcedure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdministrationServiceServer).NewDayProcedure(ctx, req.(*NewDayProcMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdministrationService_SetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdministrationServiceServer).SetSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AdministrationService/SetSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdministrationServiceServer).SetSecret(ctx, req.(*SecretMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdministrationService_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdministrationServiceServer).DeleteSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AdministrationService/DeleteSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdministrationServiceServer).DeleteSecret(ctx, req.(*SecretMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdministrationService_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdministrationServiceServer).ListSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AdministrationService/ListSecrets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdministrationServiceServer).ListSecrets(ctx, req.(*FilterMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdministrationService_ReconcileResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdministrationServiceServer).ReconcileResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AdministrationService/ReconcileResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdministrationServiceServer).ReconcileResources(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdministrationService_ListWorkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdministrationServiceServer).ListWorkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AdministrationService/ListWorkers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdministrationServiceServer).ListWorkers(ctx, req.(*FilterMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdministrationService_DrainWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkerMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdministrationServiceServer).DrainWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AdministrationService/DrainWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdministrationServiceServer).DrainWorker(ctx, req.(*WorkerMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdministrationService_UndrainWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkerMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdministrationServiceServer).UndrainWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AdministrationService/UndrainWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdministrationServiceServer).UndrainWorker(ctx, req.(*WorkerMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdministrationService_RemoveWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkerMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdministrationServiceServer).RemoveWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AdministrationService/RemoveWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdministrationServiceServer).RemoveWorker(ctx, req.(*WorkerMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdministrationService_SetConcurrencyLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConcurrencyLimitMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdministrationServiceServer).SetConcurrencyLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AdministrationService/SetConcurrencyLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdministrationServiceServer).SetConcurrencyLimit(ctx, req.(*ConcurrencyLimitMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdministrationService_ListConcurrencyLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdministrationServiceServer).ListConcurrencyLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AdministrationService/ListConcurrencyLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdministrationServiceServer).ListConcurrencyLimits(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdministrationService_ServiceDesc is the grpc.ServiceDesc for AdministrationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1679,6 +2472,50 @@ var AdministrationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Resume",
 			Handler:    _AdministrationService_Resume_Handler,
 		},
+		{
+			MethodName: "NewDayProcedure",
+			Handler:    _AdministrationService_NewDayProcedure_Handler,
+		},
+		{
+			MethodName: "SetSecret",
+			Handler:    _AdministrationService_SetSecret_Handler,
+		},
+		{
+			MethodName: "DeleteSecret",
+			Handler:    _AdministrationService_DeleteSecret_Handler,
+		},
+		{
+			MethodName: "ListSecrets",
+			Handler:    _AdministrationService_ListSecrets_Handler,
+		},
+		{
+			MethodName: "ReconcileResources",
+			Handler:    _AdministrationService_ReconcileResources_Handler,
+		},
+		{
+			MethodName: "ListWorkers",
+			Handler:    _AdministrationService_ListWorkers_Handler,
+		},
+		{
+			MethodName: "DrainWorker",
+			Handler:    _AdministrationService_DrainWorker_Handler,
+		},
+		{
+			MethodName: "UndrainWorker",
+			Handler:    _AdministrationService_UndrainWorker_Handler,
+		},
+		{
+			MethodName: "RemoveWorker",
+			Handler:    _AdministrationService_RemoveWorker_Handler,
+		},
+		{
+			MethodName: "SetConcurrencyLimit",
+			Handler:    _AdministrationService_SetConcurrencyLimit_Handler,
+		},
+		{
+			MethodName: "ListConcurrencyLimits",
+			Handler:    _AdministrationService_ListConcurrencyLimits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services.proto",
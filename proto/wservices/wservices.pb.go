@@ -312,6 +312,61 @@ func (x *TaskExecutionResponseMsg) GetTasksLimit() int32 {
 	return 0
 }
 
+type TaskStatusUpdateMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskID *TaskIdMsg                `protobuf:"bytes,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	Status *TaskExecutionResponseMsg `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *TaskStatusUpdateMsg) Reset() {
+	*x = TaskStatusUpdateMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wservices_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskStatusUpdateMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskStatusUpdateMsg) ProtoMessage() {}
+
+func (x *TaskStatusUpdateMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_wservices_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskStatusUpdateMsg.ProtoReflect.Descriptor instead.
+func (*TaskStatusUpdateMsg) Descriptor() ([]byte, []int) {
+	return file_wservices_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TaskStatusUpdateMsg) GetTaskID() *TaskIdMsg {
+	if x != nil {
+		return x.TaskID
+	}
+	return nil
+}
+
+func (x *TaskStatusUpdateMsg) GetStatus() *TaskExecutionResponseMsg {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
 type WorkerActionMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -326,7 +381,7 @@ type WorkerActionMsg struct {
 func (x *WorkerActionMsg) Reset() {
 	*x = WorkerActionMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wservices_proto_msgTypes[3]
+		mi := &file_wservices_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -339,7 +394,7 @@ func (x *WorkerActionMsg) String() string {
 func (*WorkerActionMsg) ProtoMessage() {}
 
 func (x *WorkerActionMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_wservices_proto_msgTypes[3]
+	mi := &file_wservices_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,7 +407,7 @@ func (x *WorkerActionMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkerActionMsg.ProtoReflect.Descriptor instead.
 func (*WorkerActionMsg) Descriptor() ([]byte, []int) {
-	return file_wservices_proto_rawDescGZIP(), []int{3}
+	return file_wservices_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *WorkerActionMsg) GetSuccess() bool {
@@ -394,7 +449,7 @@ type TaskOutputMsg struct {
 func (x *TaskOutputMsg) Reset() {
 	*x = TaskOutputMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wservices_proto_msgTypes[4]
+		mi := &file_wservices_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -407,7 +462,7 @@ func (x *TaskOutputMsg) String() string {
 func (*TaskOutputMsg) ProtoMessage() {}
 
 func (x *TaskOutputMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_wservices_proto_msgTypes[4]
+	mi := &file_wservices_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,7 +475,7 @@ func (x *TaskOutputMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskOutputMsg.ProtoReflect.Descriptor instead.
 func (*TaskOutputMsg) Descriptor() ([]byte, []int) {
-	return file_wservices_proto_rawDescGZIP(), []int{4}
+	return file_wservices_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TaskOutputMsg) GetData() string {
@@ -437,15 +492,19 @@ type WorkerStatusResponseMsg struct {
 
 	Tasks      int32 `protobuf:"varint,1,opt,name=tasks,proto3" json:"tasks,omitempty"`
 	TasksLimit int32 `protobuf:"varint,2,opt,name=tasksLimit,proto3" json:"tasksLimit,omitempty"`
-	Cpuload    int32 `protobuf:"varint,3,opt,name=cpuload,proto3" json:"cpuload,omitempty"`
-	Memused    int32 `protobuf:"varint,4,opt,name=memused,proto3" json:"memused,omitempty"`
-	Memtotal   int32 `protobuf:"varint,5,opt,name=memtotal,proto3" json:"memtotal,omitempty"`
+	//load average from the last minute as a percentage of available CPUs
+	Cpuload int32 `protobuf:"varint,3,opt,name=cpuload,proto3" json:"cpuload,omitempty"`
+	//used and total memory in MB
+	Memused  int32 `protobuf:"varint,4,opt,name=memused,proto3" json:"memused,omitempty"`
+	Memtotal int32 `protobuf:"varint,5,opt,name=memtotal,proto3" json:"memtotal,omitempty"`
+	//free space in MB of a filesystem of the sysout directory
+	Diskfree int64 `protobuf:"varint,6,opt,name=diskfree,proto3" json:"diskfree,omitempty"`
 }
 
 func (x *WorkerStatusResponseMsg) Reset() {
 	*x = WorkerStatusResponseMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wservices_proto_msgTypes[5]
+		mi := &file_wservices_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -458,7 +517,7 @@ func (x *WorkerStatusResponseMsg) String() string {
 func (*WorkerStatusResponseMsg) ProtoMessage() {}
 
 func (x *WorkerStatusResponseMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_wservices_proto_msgTypes[5]
+	mi := &file_wservices_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,7 +530,7 @@ func (x *WorkerStatusResponseMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkerStatusResponseMsg.ProtoReflect.Descriptor instead.
 func (*WorkerStatusResponseMsg) Descriptor() ([]byte, []int) {
-	return file_wservices_proto_rawDescGZIP(), []int{5}
+	return file_wservices_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *WorkerStatusResponseMsg) GetTasks() int32 {
@@ -509,6 +568,294 @@ func (x *WorkerStatusResponseMsg) GetMemtotal() int32 {
 	return 0
 }
 
+func (x *WorkerStatusResponseMsg) GetDiskfree() int64 {
+	if x != nil {
+		return x.Diskfree
+	}
+	return 0
+}
+
+type WorkerRegistrationMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Host       string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port       int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	TasksLimit int32  `protobuf:"varint,4,opt,name=tasksLimit,proto3" json:"tasksLimit,omitempty"`
+	//task types supported by a worker
+	Capabilities []string `protobuf:"bytes,5,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Pool         string   `protobuf:"bytes,6,opt,name=pool,proto3" json:"pool,omitempty"`
+	Tags         []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	//relative weight used by the weighted placement strategy
+	Weight int32 `protobuf:"varint,9,opt,name=weight,proto3" json:"weight,omitempty"`
+	//a join token, not required if a worker is authenticated with a client certificate
+	Token string `protobuf:"bytes,8,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *WorkerRegistrationMsg) Reset() {
+	*x = WorkerRegistrationMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wservices_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerRegistrationMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerRegistrationMsg) ProtoMessage() {}
+
+func (x *WorkerRegistrationMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_wservices_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerRegistrationMsg.ProtoReflect.Descriptor instead.
+func (*WorkerRegistrationMsg) Descriptor() ([]byte, []int) {
+	return file_wservices_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WorkerRegistrationMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkerRegistrationMsg) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *WorkerRegistrationMsg) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *WorkerRegistrationMsg) GetTasksLimit() int32 {
+	if x != nil {
+		return x.TasksLimit
+	}
+	return 0
+}
+
+func (x *WorkerRegistrationMsg) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *WorkerRegistrationMsg) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *WorkerRegistrationMsg) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *WorkerRegistrationMsg) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *WorkerRegistrationMsg) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type WorkerRegistrationResponseMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	//interval in seconds between heartbeats
+	Heartbeat int32 `protobuf:"varint,3,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+}
+
+func (x *WorkerRegistrationResponseMsg) Reset() {
+	*x = WorkerRegistrationResponseMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wservices_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerRegistrationResponseMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerRegistrationResponseMsg) ProtoMessage() {}
+
+func (x *WorkerRegistrationResponseMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_wservices_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerRegistrationResponseMsg.ProtoReflect.Descriptor instead.
+func (*WorkerRegistrationResponseMsg) Descriptor() ([]byte, []int) {
+	return file_wservices_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WorkerRegistrationResponseMsg) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *WorkerRegistrationResponseMsg) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WorkerRegistrationResponseMsg) GetHeartbeat() int32 {
+	if x != nil {
+		return x.Heartbeat
+	}
+	return 0
+}
+
+type WorkerHeartbeatMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *WorkerHeartbeatMsg) Reset() {
+	*x = WorkerHeartbeatMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wservices_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerHeartbeatMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerHeartbeatMsg) ProtoMessage() {}
+
+func (x *WorkerHeartbeatMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_wservices_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerHeartbeatMsg.ProtoReflect.Descriptor instead.
+func (*WorkerHeartbeatMsg) Descriptor() ([]byte, []int) {
+	return file_wservices_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WorkerHeartbeatMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkerHeartbeatMsg) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type WorkerHeartbeatResponseMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//false means that a worker is not known and it has to register again
+	Registered bool `protobuf:"varint,1,opt,name=registered,proto3" json:"registered,omitempty"`
+}
+
+func (x *WorkerHeartbeatResponseMsg) Reset() {
+	*x = WorkerHeartbeatResponseMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wservices_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerHeartbeatResponseMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerHeartbeatResponseMsg) ProtoMessage() {}
+
+func (x *WorkerHeartbeatResponseMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_wservices_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerHeartbeatResponseMsg.ProtoReflect.Descriptor instead.
+func (*WorkerHeartbeatResponseMsg) Descriptor() ([]byte, []int) {
+	return file_wservices_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WorkerHeartbeatResponseMsg) GetRegistered() bool {
+	if x != nil {
+		return x.Registered
+	}
+	return false
+}
+
 var File_wservices_proto protoreflect.FileDescriptor
 
 var file_wservices_proto_rawDesc = []byte{
@@ -559,55 +906,111 @@ var file_wservices_proto_rawDesc = []byte{
 	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a,
 	0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x49,
 	0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x44, 0x4c, 0x45, 0x10, 0x05,
-	0x12, 0x0c, 0x0a, 0x08, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x22, 0x7b,
-	0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
-	0x67, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
-	0x61, 0x73, 0x6b, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x23, 0x0a, 0x0d, 0x54,
-	0x61, 0x73, 0x6b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x9f, 0x01, 0x0a, 0x17, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x61, 0x73,
-	0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x70, 0x75, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x70, 0x75, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x6d, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d,
-	0x65, 0x6d, 0x75, 0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x32, 0x9b, 0x03, 0x0a, 0x14, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x73, 0x67, 0x1a, 0x1f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x73, 0x67, 0x22, 0x00,
-	0x12, 0x3b, 0x0a, 0x0d, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73,
-	0x6b, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64,
-	0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
-	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x3a, 0x0a,
-	0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x4d, 0x73, 0x67, 0x1a,
-	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x54, 0x61, 0x73,
-	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0a,
-	0x54, 0x61, 0x73, 0x6b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x14, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d,
-	0x73, 0x67, 0x22, 0x00, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x73, 0x67, 0x22, 0x00,
-	0x42, 0x11, 0x5a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x0c, 0x0a, 0x08, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x22, 0x78,
+	0x0a, 0x13, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61,
+	0x73, 0x6b, 0x49, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12,
+	0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x73, 0x67,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7b, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x73,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x23, 0x0a, 0x0d, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbb, 0x01, 0x0a, 0x17, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x74, 0x61, 0x73, 0x6b, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x70, 0x75, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63,
+	0x70, 0x75, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x75, 0x73, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x75, 0x73, 0x65, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x6b, 0x66, 0x72, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x6b, 0x66, 0x72, 0x65, 0x65, 0x22, 0xed, 0x01, 0x0a, 0x15, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x71, 0x0a, 0x1d, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x22, 0x3e, 0x0a, 0x12, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x4d, 0x73,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3c, 0x0a, 0x1a, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x32, 0xe8, 0x03, 0x0a, 0x14, 0x54, 0x61,
+	0x73, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73,
+	0x6b, 0x4d, 0x73, 0x67, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0d, 0x54, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x67, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x49, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x22, 0x00,
+	0x12, 0x41, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x4d, 0x73, 0x67,
+	0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x73,
+	0x67, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64,
+	0x4d, 0x73, 0x67, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73, 0x6b,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x30, 0x01, 0x12, 0x48, 0x0a,
+	0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x4d, 0x73, 0x67, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x11, 0x54, 0x61, 0x73, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67,
+	0x22, 0x00, 0x30, 0x01, 0x32, 0xba, 0x01, 0x0a, 0x19, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x50, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x24, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d,
+	0x73, 0x67, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x4d, 0x73, 0x67, 0x1a, 0x21, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x73, 0x67, 0x22,
+	0x00, 0x42, 0x11, 0x5a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -623,41 +1026,54 @@ func file_wservices_proto_rawDescGZIP() []byte {
 }
 
 var file_wservices_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_wservices_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_wservices_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_wservices_proto_goTypes = []interface{}{
 	(TaskExecutionResponseMsg_TaskStatus)(0), // 0: proto.TaskExecutionResponseMsg.TaskStatus
 	(*TaskIdMsg)(nil),                        // 1: proto.TaskIdMsg
 	(*StartTaskMsg)(nil),                     // 2: proto.StartTaskMsg
 	(*TaskExecutionResponseMsg)(nil),         // 3: proto.TaskExecutionResponseMsg
-	(*WorkerActionMsg)(nil),                  // 4: proto.WorkerActionMsg
-	(*TaskOutputMsg)(nil),                    // 5: proto.TaskOutputMsg
-	(*WorkerStatusResponseMsg)(nil),          // 6: proto.WorkerStatusResponseMsg
-	nil,                                      // 7: proto.StartTaskMsg.VariablesEntry
-	(*anypb.Any)(nil),                        // 8: google.protobuf.Any
-	(*emptypb.Empty)(nil),                    // 9: google.protobuf.Empty
+	(*TaskStatusUpdateMsg)(nil),              // 4: proto.TaskStatusUpdateMsg
+	(*WorkerActionMsg)(nil),                  // 5: proto.WorkerActionMsg
+	(*TaskOutputMsg)(nil),                    // 6: proto.TaskOutputMsg
+	(*WorkerStatusResponseMsg)(nil),          // 7: proto.WorkerStatusResponseMsg
+	(*WorkerRegistrationMsg)(nil),            // 8: proto.WorkerRegistrationMsg
+	(*WorkerRegistrationResponseMsg)(nil),    // 9: proto.WorkerRegistrationResponseMsg
+	(*WorkerHeartbeatMsg)(nil),               // 10: proto.WorkerHeartbeatMsg
+	(*WorkerHeartbeatResponseMsg)(nil),       // 11: proto.WorkerHeartbeatResponseMsg
+	nil,                                      // 12: proto.StartTaskMsg.VariablesEntry
+	(*anypb.Any)(nil),                        // 13: google.protobuf.Any
+	(*emptypb.Empty)(nil),                    // 14: google.protobuf.Empty
 }
 var file_wservices_proto_depIdxs = []int32{
 	1,  // 0: proto.StartTaskMsg.taskID:type_name -> proto.TaskIdMsg
-	7,  // 1: proto.StartTaskMsg.variables:type_name -> proto.StartTaskMsg.VariablesEntry
-	8,  // 2: proto.StartTaskMsg.Command:type_name -> google.protobuf.Any
+	12, // 1: proto.StartTaskMsg.variables:type_name -> proto.StartTaskMsg.VariablesEntry
+	13, // 2: proto.StartTaskMsg.Command:type_name -> google.protobuf.Any
 	0,  // 3: proto.TaskExecutionResponseMsg.status:type_name -> proto.TaskExecutionResponseMsg.TaskStatus
-	2,  // 4: proto.TaskExecutionService.StartTask:input_type -> proto.StartTaskMsg
-	1,  // 5: proto.TaskExecutionService.TerminateTask:input_type -> proto.TaskIdMsg
-	1,  // 6: proto.TaskExecutionService.CompleteTask:input_type -> proto.TaskIdMsg
-	1,  // 7: proto.TaskExecutionService.TaskStatus:input_type -> proto.TaskIdMsg
-	1,  // 8: proto.TaskExecutionService.TaskOutput:input_type -> proto.TaskIdMsg
-	9,  // 9: proto.TaskExecutionService.WorkerStatus:input_type -> google.protobuf.Empty
-	3,  // 10: proto.TaskExecutionService.StartTask:output_type -> proto.TaskExecutionResponseMsg
-	4,  // 11: proto.TaskExecutionService.TerminateTask:output_type -> proto.WorkerActionMsg
-	4,  // 12: proto.TaskExecutionService.CompleteTask:output_type -> proto.WorkerActionMsg
-	3,  // 13: proto.TaskExecutionService.TaskStatus:output_type -> proto.TaskExecutionResponseMsg
-	5,  // 14: proto.TaskExecutionService.TaskOutput:output_type -> proto.TaskOutputMsg
-	6,  // 15: proto.TaskExecutionService.WorkerStatus:output_type -> proto.WorkerStatusResponseMsg
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	1,  // 4: proto.TaskStatusUpdateMsg.taskID:type_name -> proto.TaskIdMsg
+	3,  // 5: proto.TaskStatusUpdateMsg.status:type_name -> proto.TaskExecutionResponseMsg
+	2,  // 6: proto.TaskExecutionService.StartTask:input_type -> proto.StartTaskMsg
+	1,  // 7: proto.TaskExecutionService.TerminateTask:input_type -> proto.TaskIdMsg
+	1,  // 8: proto.TaskExecutionService.CompleteTask:input_type -> proto.TaskIdMsg
+	1,  // 9: proto.TaskExecutionService.TaskStatus:input_type -> proto.TaskIdMsg
+	1,  // 10: proto.TaskExecutionService.TaskOutput:input_type -> proto.TaskIdMsg
+	14, // 11: proto.TaskExecutionService.WorkerStatus:input_type -> google.protobuf.Empty
+	14, // 12: proto.TaskExecutionService.TaskStatusUpdates:input_type -> google.protobuf.Empty
+	8,  // 13: proto.WorkerRegistrationService.Register:input_type -> proto.WorkerRegistrationMsg
+	10, // 14: proto.WorkerRegistrationService.Heartbeat:input_type -> proto.WorkerHeartbeatMsg
+	3,  // 15: proto.TaskExecutionService.StartTask:output_type -> proto.TaskExecutionResponseMsg
+	5,  // 16: proto.TaskExecutionService.TerminateTask:output_type -> proto.WorkerActionMsg
+	5,  // 17: proto.TaskExecutionService.CompleteTask:output_type -> proto.WorkerActionMsg
+	3,  // 18: proto.TaskExecutionService.TaskStatus:output_type -> proto.TaskExecutionResponseMsg
+	6,  // 19: proto.TaskExecutionService.TaskOutput:output_type -> proto.TaskOutputMsg
+	7,  // 20: proto.TaskExecutionService.WorkerStatus:output_type -> proto.WorkerStatusResponseMsg
+	4,  // 21: proto.TaskExecutionService.TaskStatusUpdates:output_type -> proto.TaskStatusUpdateMsg
+	9,  // 22: proto.WorkerRegistrationService.Register:output_type -> proto.WorkerRegistrationResponseMsg
+	11, // 23: proto.WorkerRegistrationService.Heartbeat:output_type -> proto.WorkerHeartbeatResponseMsg
+	15, // [15:24] is the sub-list for method output_type
+	6,  // [6:15] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_wservices_proto_init() }
@@ -703,7 +1119,7 @@ func file_wservices_proto_init() {
 			}
 		}
 		file_wservices_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkerActionMsg); i {
+			switch v := v.(*TaskStatusUpdateMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -715,7 +1131,7 @@ func file_wservices_proto_init() {
 			}
 		}
 		file_wservices_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskOutputMsg); i {
+			switch v := v.(*WorkerActionMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -727,6 +1143,18 @@ func file_wservices_proto_init() {
 			}
 		}
 		file_wservices_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskOutputMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wservices_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WorkerStatusResponseMsg); i {
 			case 0:
 				return &v.state
@@ -738,6 +1166,54 @@ func file_wservices_proto_init() {
 				return nil
 			}
 		}
+		file_wservices_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerRegistrationMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wservices_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerRegistrationResponseMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wservices_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerHeartbeatMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wservices_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerHeartbeatResponseMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -745,9 +1221,9 @@ func file_wservices_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_wservices_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   7,
+			NumMessages:   12,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_wservices_proto_goTypes,
 		DependencyIndexes: file_wservices_proto_depIdxs,
@@ -29,6 +29,8 @@ type TaskExecutionServiceClient interface {
 	TaskStatus(ctx context.Context, in *TaskIdMsg, opts ...grpc.CallOption) (*TaskExecutionResponseMsg, error)
 	TaskOutput(ctx context.Context, in *TaskIdMsg, opts ...grpc.CallOption) (TaskExecutionService_TaskOutputClient, error)
 	WorkerStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WorkerStatusResponseMsg, error)
+	//TaskStatusUpdates - a worker pushes changes of statuses of executions as they happen
+	TaskStatusUpdates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (TaskExecutionService_TaskStatusUpdatesClient, error)
 }
 
 type taskExecutionServiceClient struct {
@@ -116,6 +118,38 @@ func (c *taskExecutionServiceClient) WorkerStatus(ctx context.Context, in *empty
 	return out, nil
 }
 
+func (c *taskExecutionServiceClient) TaskStatusUpdates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (TaskExecutionService_TaskStatusUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TaskExecutionService_ServiceDesc.Streams[1], "/proto.TaskExecutionService/TaskStatusUpdates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taskExecutionServiceTaskStatusUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TaskExecutionService_TaskStatusUpdatesClient interface {
+	Recv() (*TaskStatusUpdateMsg, error)
+	grpc.ClientStream
+}
+
+type taskExecutionServiceTaskStatusUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *taskExecutionServiceTaskStatusUpdatesClient) Recv() (*TaskStatusUpdateMsg, error) {
+	m := new(TaskStatusUpdateMsg)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // TaskExecutionServiceServer is the server API for TaskExecutionService service.
 // All implementations must embed UnimplementedTaskExecutionServiceServer
 // for forward compatibility
@@ -126,6 +160,8 @@ type TaskExecutionServiceServer interface {
 	TaskStatus(context.Context, *TaskIdMsg) (*TaskExecutionResponseMsg, error)
 	TaskOutput(*TaskIdMsg, TaskExecutionService_TaskOutputServer) error
 	WorkerStatus(context.Context, *emptypb.Empty) (*WorkerStatusResponseMsg, error)
+	//TaskStatusUpdates - a worker pushes changes of statuses of executions as they happen
+	TaskStatusUpdates(*emptypb.Empty, TaskExecutionService_TaskStatusUpdatesServer) error
 	mustEmbedUnimplementedTaskExecutionServiceServer()
 }
 
@@ -151,6 +187,9 @@ func (UnimplementedTaskExecutionServiceServer) TaskOutput(*TaskIdMsg, TaskExecut
 func (UnimplementedTaskExecutionServiceServer) WorkerStatus(context.Context, *emptypb.Empty) (*WorkerStatusResponseMsg, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WorkerStatus not implemented")
 }
+func (UnimplementedTaskExecutionServiceServer) TaskStatusUpdates(*emptypb.Empty, TaskExecutionService_TaskStatusUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method TaskStatusUpdates not implemented")
+}
 func (UnimplementedTaskExecutionServiceServer) mustEmbedUnimplementedTaskExecutionServiceServer() {}
 
 // UnsafeTaskExecutionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -275,6 +314,27 @@ func _TaskExecutionService_WorkerStatus_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskExecutionService_TaskStatusUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskExecutionServiceServer).TaskStatusUpdates(m, &taskExecutionServiceTaskStatusUpdatesServer{stream})
+}
+
+type TaskExecutionService_TaskStatusUpdatesServer interface {
+	Send(*TaskStatusUpdateMsg) error
+	grpc.ServerStream
+}
+
+type taskExecutionServiceTaskStatusUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *taskExecutionServiceTaskStatusUpdatesServer) Send(m *TaskStatusUpdateMsg) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // TaskExecutionService_ServiceDesc is the grpc.ServiceDesc for TaskExecutionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -309,6 +369,134 @@ var TaskExecutionService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _TaskExecutionService_TaskOutput_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "TaskStatusUpdates",
+			Handler:       _TaskExecutionService_TaskStatusUpdates_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "wservices.proto",
+}
+
+// WorkerRegistrationServiceClient is the client API for WorkerRegistrationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WorkerRegistrationServiceClient interface {
+	Register(ctx context.Context, in *WorkerRegistrationMsg, opts ...grpc.CallOption) (*WorkerRegistrationResponseMsg, error)
+	Heartbeat(ctx context.Context, in *WorkerHeartbeatMsg, opts ...grpc.CallOption) (*WorkerHeartbeatResponseMsg, error)
+}
+
+type workerRegistrationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkerRegistrationServiceClient(cc grpc.ClientConnInterface) WorkerRegistrationServiceClient {
+	return &workerRegistrationServiceClient{cc}
+}
+
+func (c *workerRegistrationServiceClient) Register(ctx context.Context, in *WorkerRegistrationMsg, opts ...grpc.CallOption) (*WorkerRegistrationResponseMsg, error) {
+	out := new(WorkerRegistrationResponseMsg)
+	err := c.cc.Invoke(ctx, "/proto.WorkerRegistrationService/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerRegistrationServiceClient) Heartbeat(ctx context.Context, in *WorkerHeartbeatMsg, opts ...grpc.CallOption) (*WorkerHeartbeatResponseMsg, error) {
+	out := new(WorkerHeartbeatResponseMsg)
+	err := c.cc.Invoke(ctx, "/proto.WorkerRegistrationService/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkerRegistrationServiceServer is the server API for WorkerRegistrationService service.
+// All implementations must embed UnimplementedWorkerRegistrationServiceServer
+// for forward compatibility
+type WorkerRegistrationServiceServer interface {
+	Register(context.Context, *WorkerRegistrationMsg) (*WorkerRegistrationResponseMsg, error)
+	Heartbeat(context.Context, *WorkerHeartbeatMsg) (*WorkerHeartbeatResponseMsg, error)
+	mustEmbedUnimplementedWorkerRegistrationServiceServer()
+}
+
+// UnimplementedWorkerRegistrationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWorkerRegistrationServiceServer struct {
+}
+
+func (UnimplementedWorkerRegistrationServiceServer) Register(context.Context, *WorkerRegistrationMsg) (*WorkerRegistrationResponseMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedWorkerRegistrationServiceServer) Heartbeat(context.Context, *WorkerHeartbeatMsg) (*WorkerHeartbeatResponseMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedWorkerRegistrationServiceServer) mustEmbedUnimplementedWorkerRegistrationServiceServer() {
+}
+
+// UnsafeWorkerRegistrationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkerRegistrationServiceServer will
+// result in compilation errors.
+type UnsafeWorkerRegistrationServiceServer interface {
+	mustEmbedUnimplementedWorkerRegistrationServiceServer()
+}
+
+func RegisterWorkerRegistrationServiceServer(s grpc.ServiceRegistrar, srv WorkerRegistrationServiceServer) {
+	s.RegisterService(&WorkerRegistrationService_ServiceDesc, srv)
+}
+
+func _WorkerRegistrationService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkerRegistrationMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerRegistrationServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.WorkerRegistrationService/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerRegistrationServiceServer).Register(ctx, req.(*WorkerRegistrationMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerRegistrationService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkerHeartbeatMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerRegistrationServiceServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.WorkerRegistrationService/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerRegistrationServiceServer).Heartbeat(ctx, req.(*WorkerHeartbeatMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorkerRegistrationService_ServiceDesc is the grpc.ServiceDesc for WorkerRegistrationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorkerRegistrationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.WorkerRegistrationService",
+	HandlerType: (*WorkerRegistrationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _WorkerRegistrationService_Register_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _WorkerRegistrationService_Heartbeat_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "wservices.proto",
 }